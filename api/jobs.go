@@ -0,0 +1,221 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+//Only the last lines of the logs are kept for the subscribers that come later
+const maxJobLines = 10000
+
+const jobSubscriptionSize = 100
+
+//Last line sent to the subscribers that cannot keep up with the logs before they are disconnected
+const jobSubscriptionDropped = "\x00dropped"
+
+type JobFn func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error)
+
+type JobState struct {
+	Id      string
+	Kind    string
+	Status  string
+	Started time.Time
+	Ended   time.Time
+	Errors  []string
+	Result  interface{}
+}
+
+type Job struct {
+	state       JobState
+	lines       []string
+	subscribers map[chan string]bool
+	cancel      chan struct{}
+	cancelled   bool
+	mutex       sync.Mutex
+}
+
+//Jobs are used as the output of their log source so that their logs can be streamed
+func (j *Job) Write(p []byte) (int, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		if len(j.lines) >= maxJobLines {
+			j.lines = j.lines[1:]
+		}
+		j.lines = append(j.lines, line)
+
+		//The last place of the channel is kept for the marker so that dropped subscribers know they missed lines
+		for sub, _ := range j.subscribers {
+			if len(sub) >= cap(sub)-1 {
+				sub <- jobSubscriptionDropped
+				delete(j.subscribers, sub)
+				close(sub)
+				continue
+			}
+			sub <- line
+		}
+	}
+
+	return len(p), nil
+}
+
+func (j *Job) GetState() JobState {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	return j.state
+}
+
+func (j *Job) IsRunning() bool {
+	return j.GetState().Status == JobRunning
+}
+
+//Returns the log lines emitted so far and a channel on which further lines will be sent.
+//The channel is closed when the job ends or after the dropped marker if the subscriber falls behind.
+func (j *Job) Subscribe() ([]string, chan string) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	lines := make([]string, len(j.lines))
+	copy(lines, j.lines)
+
+	sub := make(chan string, jobSubscriptionSize)
+	if j.state.Status == JobRunning {
+		j.subscribers[sub] = true
+	} else {
+		close(sub)
+	}
+
+	return lines, sub
+}
+
+func (j *Job) Unsubscribe(sub chan string) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if _, ok := j.subscribers[sub]; ok {
+		delete(j.subscribers, sub)
+		close(sub)
+	}
+}
+
+func (j *Job) Cancel() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.state.Status == JobRunning && (!j.cancelled) {
+		j.cancelled = true
+		close(j.cancel)
+	}
+}
+
+func (j *Job) end(result interface{}, errs []error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.state.Ended = time.Now()
+	j.state.Result = result
+	j.state.Errors = make([]string, len(errs))
+	for idx, err := range errs {
+		j.state.Errors[idx] = err.Error()
+	}
+
+	if j.cancelled {
+		j.state.Status = JobCancelled
+	} else if len(errs) > 0 {
+		j.state.Status = JobFailed
+	} else {
+		j.state.Status = JobSucceeded
+	}
+
+	for sub, _ := range j.subscribers {
+		delete(j.subscribers, sub)
+		close(sub)
+	}
+}
+
+type JobRunner struct {
+	jobs     map[string]*Job
+	jobsList []string
+	nextId   int64
+	logLevel string
+	mutex    sync.Mutex
+}
+
+func NewJobRunner(logLevel string) *JobRunner {
+	return &JobRunner{
+		jobs:     make(map[string]*Job),
+		jobsList: []string{},
+		nextId:   1,
+		logLevel: logLevel,
+	}
+}
+
+//Only one job can run at a time as jobs operate on the same storage
+func (r *JobRunner) Start(kind string, fn JobFn) (*Job, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, id := range r.jobsList {
+		if r.jobs[id].IsRunning() {
+			msg := fmt.Sprintf("Start(kind=%s, ...) -> Job %s is already running", kind, id)
+			return nil, errors.New(msg)
+		}
+	}
+
+	id := strconv.FormatInt(r.nextId, 10)
+	r.nextId++
+
+	job := &Job{
+		state: JobState{
+			Id:      id,
+			Kind:    kind,
+			Status:  JobRunning,
+			Started: time.Now(),
+			Errors:  []string{},
+		},
+		lines:       []string{},
+		subscribers: make(map[chan string]bool),
+		cancel:      make(chan struct{}),
+	}
+	r.jobs[id] = job
+	r.jobsList = append(r.jobsList, id)
+
+	logSource := logging.CreateTeeSource(r.logLevel, job)
+	go func() {
+		result, errs := fn(logSource, job.cancel)
+		job.end(result, errs)
+	}()
+
+	return job, nil
+}
+
+func (r *JobRunner) Get(id string) (*Job, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	job, ok := r.jobs[id]
+	return job, ok
+}
+
+func (r *JobRunner) List() []JobState {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	states := make([]JobState, len(r.jobsList))
+	for idx, id := range r.jobsList {
+		states[idx] = r.jobs[id].GetState()
+	}
+	return states
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"io"
+	"testing"
+	"time"
+)
+
+func waitForJob(t *testing.T, job *Job) JobState {
+	for i := 0; i < 500; i++ {
+		if !job.IsRunning() {
+			return job.GetState()
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Job %s did not end in time", job.GetState().Id)
+	return job.GetState()
+}
+
+func TestJobRunnerSingleJob(t *testing.T) {
+	runner := NewJobRunner("info")
+	release := make(chan struct{})
+
+	first, err := runner.Start("test", func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		<-release
+		return "done", []error{}
+	})
+	if err != nil {
+		t.Fatalf("Could not start the first job: %s", err.Error())
+	}
+
+	_, err = runner.Start("test", func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		return nil, []error{}
+	})
+	if err == nil {
+		t.Errorf("Expected a second job to be refused while the first one is running")
+	}
+
+	close(release)
+	state := waitForJob(t, first)
+	if state.Status != JobSucceeded || state.Result != "done" {
+		t.Errorf("Expected the first job to succeed and got %v", state)
+	}
+
+	second, err := runner.Start("test", func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		return nil, []error{errors.New("failure")}
+	})
+	if err != nil {
+		t.Fatalf("Expected a job to start once the previous one ended: %s", err.Error())
+	}
+	state = waitForJob(t, second)
+	if state.Status != JobFailed || len(state.Errors) != 1 || state.Errors[0] != "failure" {
+		t.Errorf("Expected the second job to fail and got %v", state)
+	}
+
+	if len(runner.List()) != 2 {
+		t.Errorf("Expected the runner to list both jobs")
+	}
+}
+
+func TestJobCancelAndLogs(t *testing.T) {
+	runner := NewJobRunner("info")
+
+	job, _ := runner.Start("test", func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		logger := logSource.CreateLogger(io.Discard, "[test] ", 0)
+		logger.Info("waiting")
+		<-cancel
+		return nil, []error{errors.New("cancelled")}
+	})
+
+	lines, sub := job.Subscribe()
+	for i := 0; i < 500 && len(lines) == 0; i++ {
+		job.Unsubscribe(sub)
+		time.Sleep(10 * time.Millisecond)
+		lines, sub = job.Subscribe()
+	}
+	job.Cancel()
+	job.Cancel()
+
+	state := waitForJob(t, job)
+	if state.Status != JobCancelled {
+		t.Errorf("Expected the job to be cancelled and got %s", state.Status)
+	}
+	if len(lines) != 1 || lines[0] != "[test] waiting" {
+		t.Errorf("Expected the logs of the job to be recorded and got %v", lines)
+	}
+	if _, more := <-sub; more {
+		t.Errorf("Expected the subscription to be closed when the job ends")
+	}
+}
+
+func newTestRunningJob() *Job {
+	return &Job{
+		state:       JobState{Id: "1", Kind: "test", Status: JobRunning},
+		lines:       []string{},
+		subscribers: make(map[chan string]bool),
+		cancel:      make(chan struct{}),
+	}
+}
+
+func TestJobLinesAreCapped(t *testing.T) {
+	job := newTestRunningJob()
+	for i := 0; i < maxJobLines+10; i++ {
+		fmt.Fprintf(job, "line %d\n", i)
+	}
+
+	lines, _ := job.Subscribe()
+	if len(lines) != maxJobLines || lines[0] != "line 10" || lines[len(lines)-1] != fmt.Sprintf("line %d", maxJobLines+9) {
+		t.Errorf("Expected only the last %d lines to be kept and got %d lines starting with %s", maxJobLines, len(lines), lines[0])
+	}
+}
+
+func TestJobDropsSlowSubscribers(t *testing.T) {
+	job := newTestRunningJob()
+	_, slow := job.Subscribe()
+	_, fast := job.Subscribe()
+
+	received := []string{}
+	for i := 0; i < jobSubscriptionSize+10; i++ {
+		fmt.Fprintf(job, "line %d\n", i)
+		received = append(received, <-fast)
+	}
+
+	lines := []string{}
+	for line := range slow {
+		lines = append(lines, line)
+	}
+	if len(lines) != jobSubscriptionSize || lines[len(lines)-1] != jobSubscriptionDropped || lines[0] != "line 0" {
+		t.Errorf("Expected the slow subscriber to get the lines that fit in its channel followed by the dropped marker and got %d lines", len(lines))
+	}
+	if len(received) != jobSubscriptionSize+10 || received[len(received)-1] != fmt.Sprintf("line %d", jobSubscriptionSize+9) {
+		t.Errorf("Expected the subscriber that keeps up to get all the lines and got %d lines", len(received))
+	}
+
+	job.Unsubscribe(slow)
+	job.end(nil, []error{})
+	if _, more := <-fast; more {
+		t.Errorf("Expected the subscription to be closed when the job ends")
+	}
+}
@@ -0,0 +1,397 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"gogcli/storage"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+type DownloaderGetter func(source storage.Source, logSource *logging.Source) (storage.Downloader, error)
+
+//Jobs get their own storage instance so that the logs of the storage are streamed with the logs of the job
+type StorageGetter func(logSource *logging.Source) storage.Storage
+
+type StorageStatus struct {
+	HasManifest bool
+	HasActions  bool
+	HasSource   bool
+	ActionsLeft int
+	Source      *storage.Source
+}
+
+type Server struct {
+	storage       storage.Storage
+	getStorage    StorageGetter
+	getDownloader DownloaderGetter
+	token         string
+	jobs          *JobRunner
+	logger        *logging.Logger
+}
+
+func NewServer(getStorage StorageGetter, getDownloader DownloaderGetter, token string, logLevel string, logSource *logging.Source) *Server {
+	return &Server{
+		storage:       getStorage(logSource),
+		getStorage:    getStorage,
+		getDownloader: getDownloader,
+		token:         token,
+		jobs:          NewJobRunner(logLevel),
+		logger:        logSource.CreateLogger(os.Stdout, "[api] ", log.Lmsgprefix),
+	}
+}
+
+func (s *Server) ListenAndServe(address string) error {
+	defer storage.CloseStorage(s.storage)
+	s.logger.Info(fmt.Sprintf("Listening on %s", address))
+	return http.ListenAndServe(address, s.Handler())
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/manifest/summary", s.handleManifestSummary)
+	mux.HandleFunc("/manifest/search", s.handleManifestSearch)
+	mux.HandleFunc("/storage/status", s.handleStorageStatus)
+	mux.HandleFunc("/jobs", s.handleJobs)
+	mux.HandleFunc("/jobs/", s.handleJob)
+	return s.authenticate(mux)
+}
+
+//The token can also be passed as a query parameter as browsers cannot set headers on event streams
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("token")
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			token = strings.TrimPrefix(auth, "Bearer ")
+		}
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			s.writeError(w, http.StatusUnauthorized, errors.New("Invalid or missing token"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(body)
+	if err != nil {
+		s.logger.Warning(fmt.Sprintf("writeJson(...) -> Error occured while writing response: %s", err.Error()))
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, err error) {
+	s.writeErrors(w, status, []error{err})
+}
+
+func (s *Server) writeErrors(w http.ResponseWriter, status int, errs []error) {
+	body := struct{ Errors []string }{Errors: make([]string, len(errs))}
+	for idx, err := range errs {
+		body.Errors[idx] = err.Error()
+	}
+	s.writeJson(w, status, body)
+}
+
+func (s *Server) allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		msg := fmt.Sprintf("Method %s is not allowed on %s", r.Method, r.URL.Path)
+		s.writeError(w, http.StatusMethodNotAllowed, errors.New(msg))
+		return false
+	}
+	return true
+}
+
+func (s *Server) loadManifest(w http.ResponseWriter) (*manifest.Manifest, bool) {
+	has, err := s.storage.HasManifest()
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	if !has {
+		s.writeError(w, http.StatusNotFound, errors.New("Storage does not have a manifest"))
+		return nil, false
+	}
+
+	//The journal is not recovered as a job could be executing actions on the storage
+	m, err := storage.LoadManifestWithJournal(s.storage)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	return m, true
+}
+
+//Storages that can search their manifest by title only load the matching games
+func (s *Server) searchManifest(w http.ResponseWriter, titleTerms []string) (*manifest.Manifest, bool) {
+	searcher, ok := s.storage.(storage.ManifestSearcher)
+	if (!ok) || len(titleTerms) == 0 {
+		return s.loadManifest(w)
+	}
+
+	has, err := s.storage.HasManifest()
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	if !has {
+		s.writeError(w, http.StatusNotFound, errors.New("Storage does not have a manifest"))
+		return nil, false
+	}
+
+	m, err := searcher.SearchManifest(titleTerms)
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+
+	return m, true
+}
+
+func (s *Server) handleManifestSummary(w http.ResponseWriter, r *http.Request) {
+	if !s.allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	m, ok := s.loadManifest(w)
+	if !ok {
+		return
+	}
+
+	if len((*m).Games) == 0 {
+		s.writeError(w, http.StatusNotFound, errors.New("Manifest does not have any games"))
+		return
+	}
+
+	s.writeJson(w, http.StatusOK, m.GetSummary())
+}
+
+func (s *Server) handleManifestSearch(w http.ResponseWriter, r *http.Request) {
+	if !s.allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	query := r.URL.Query()
+	m, ok := s.searchManifest(w, query["title"])
+	if !ok {
+		return
+	}
+
+	installers, err := getBoolParam(query, "installers", true)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	extras, err := getBoolParam(query, "extras", true)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	depots, err := getBoolParam(query, "depots", true)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	f := manifest.NewManifestFilter(
+		query["title"],
+		query["os"],
+		query["lang"],
+		query["tag"],
+		installers,
+		extras,
+		depots,
+		query["extra-type"],
+		query["skip-url"],
+		query["has-url"],
+	)
+	(*m).Filter = *(f.Intersect((*m).Filter))
+	m.Trim()
+	m.Finalize()
+
+	s.writeJson(w, http.StatusOK, m)
+}
+
+func (s *Server) handleStorageStatus(w http.ResponseWriter, r *http.Request) {
+	if !s.allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	var status StorageStatus
+	var err error
+
+	status.HasManifest, err = s.storage.HasManifest()
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	status.HasActions, err = s.storage.HasActions()
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if status.HasActions {
+		actions, err := s.storage.LoadActions()
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		status.ActionsLeft = actions.ActionsLeft()
+	}
+
+	status.HasSource, err = s.storage.HasSource()
+	if err != nil {
+		s.writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if status.HasSource {
+		status.Source, err = s.storage.LoadSource()
+		if err != nil {
+			s.writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	s.writeJson(w, http.StatusOK, status)
+}
+
+func (s *Server) handleJobs(w http.ResponseWriter, r *http.Request) {
+	if !s.allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	s.writeJson(w, http.StatusOK, s.jobs.List())
+}
+
+//Handles /jobs/<kind> to start jobs, /jobs/<id> to get or cancel them and /jobs/<id>/events to stream their progress
+func (s *Server) handleJob(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/jobs/"), "/"), "/")
+
+	if len(parts) == 1 && r.Method == http.MethodPost {
+		s.startJob(w, r, parts[0])
+		return
+	}
+
+	job, ok := s.jobs.Get(parts[0])
+	if !ok {
+		msg := fmt.Sprintf("Job %s not found", parts[0])
+		s.writeError(w, http.StatusNotFound, errors.New(msg))
+		return
+	}
+
+	if len(parts) == 2 && parts[1] == "events" {
+		if s.allowMethod(w, r, http.MethodGet) {
+			s.streamJobEvents(w, r, job)
+		}
+		return
+	} else if len(parts) > 1 {
+		msg := fmt.Sprintf("Path %s not found", r.URL.Path)
+		s.writeError(w, http.StatusNotFound, errors.New(msg))
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		job.Cancel()
+		s.writeJson(w, http.StatusAccepted, job.GetState())
+	} else if s.allowMethod(w, r, http.MethodGet) {
+		s.writeJson(w, http.StatusOK, job.GetState())
+	}
+}
+
+func (s *Server) startJob(w http.ResponseWriter, r *http.Request, kind string) {
+	var fn JobFn
+	var err error
+
+	if kind == "plan" {
+		fn, err = s.getPlanJob(r)
+	} else if kind == "execute" {
+		fn, err = s.getExecuteJob(r)
+	} else if kind == "validate" {
+		fn, err = s.getValidateJob(r)
+	} else {
+		msg := fmt.Sprintf("Job kind %s is not supported. Can be 'plan', 'execute' or 'validate'", kind)
+		s.writeError(w, http.StatusNotFound, errors.New(msg))
+		return
+	}
+
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	job, err := s.jobs.Start(kind, fn)
+	if err != nil {
+		s.writeError(w, http.StatusConflict, err)
+		return
+	}
+
+	s.logger.Info(fmt.Sprintf("Started %s job %s", kind, job.GetState().Id))
+	s.writeJson(w, http.StatusAccepted, job.GetState())
+}
+
+func (s *Server) streamJobEvents(w http.ResponseWriter, r *http.Request, job *Job) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.writeError(w, http.StatusInternalServerError, errors.New("Streaming is not supported"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	lines, sub := job.Subscribe()
+	defer job.Unsubscribe(sub)
+
+	for _, line := range lines {
+		writeEvent(w, "log", line)
+	}
+	flusher.Flush()
+
+	for true {
+		select {
+		case line, more := <-sub:
+			if !more {
+				state, _ := json.Marshal(job.GetState())
+				writeEvent(w, "end", string(state))
+				flusher.Flush()
+				return
+			}
+			if line == jobSubscriptionDropped {
+				writeEvent(w, "dropped", "The logs were emitted faster than they could be sent, reconnect to get the latest ones")
+				flusher.Flush()
+				return
+			}
+			writeEvent(w, "log", line)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event string, data string) {
+	fmt.Fprintf(w, "event: %s\n", event)
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(w, "data: %s\n", line)
+	}
+	fmt.Fprint(w, "\n")
+}
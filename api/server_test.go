@@ -0,0 +1,279 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"gogcli/storage"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func getTestServer(t *testing.T, m *manifest.Manifest) (*Server, string) {
+	dir := filepath.Join(t.TempDir(), "games")
+	getStorage := func(logSource *logging.Source) storage.Storage {
+		return storage.GetFileSystem(dir, logSource, "")
+	}
+	getDownloader := func(source storage.Source, logSource *logging.Source) (storage.Downloader, error) {
+		return nil, errors.New("No downloader in tests")
+	}
+
+	if m != nil {
+		s := getStorage(logging.CreateSource("error"))
+		err := storage.EnsureInitialization(s)
+		if err != nil {
+			t.Fatalf("Could not initialize the storage: %s", err.Error())
+		}
+		err = s.StoreManifest(m)
+		if err != nil {
+			t.Fatalf("Could not store the manifest: %s", err.Error())
+		}
+	}
+
+	return NewServer(getStorage, getDownloader, "secret", "debug", logging.CreateSource("error")), dir
+}
+
+func getTestApiManifest() *manifest.Manifest {
+	return &manifest.Manifest{
+		Games: []manifest.ManifestGame{
+			manifest.ManifestGame{
+				Id:    1,
+				Slug:  "first",
+				Title: "First Game",
+				Installers: []manifest.ManifestGameInstaller{
+					manifest.ManifestGameInstaller{Name: "first.exe", Os: "windows", Languages: []string{"english"}, VerifiedSize: 10, Checksum: "abc"},
+				},
+				VerifiedSize: 10,
+			},
+			manifest.ManifestGame{
+				Id:    2,
+				Slug:  "second",
+				Title: "Second Game",
+				Installers: []manifest.ManifestGameInstaller{
+					manifest.ManifestGameInstaller{Name: "second.sh", Os: "linux", Languages: []string{"english"}, VerifiedSize: 20, Checksum: "def"},
+				},
+				VerifiedSize: 20,
+			},
+		},
+		VerifiedSize: 30,
+		Filter:       manifest.ManifestFilter{Installers: true, Extras: true},
+	}
+}
+
+func doRequest(s *Server, method string, target string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServerAuthentication(t *testing.T) {
+	s, _ := getTestServer(t, getTestApiManifest())
+
+	rec := httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/storage/status", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected a request without a token to be refused and got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/storage/status?token=wrong", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected a request with the wrong token to be refused and got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/storage/status?token=secret", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected a request with the token as a parameter to be accepted and got %d", rec.Code)
+	}
+}
+
+func TestServerStorageStatusAndManifest(t *testing.T) {
+	s, _ := getTestServer(t, getTestApiManifest())
+
+	rec := doRequest(s, http.MethodGet, "/storage/status", nil)
+	var status StorageStatus
+	json.Unmarshal(rec.Body.Bytes(), &status)
+	if rec.Code != http.StatusOK || (!status.HasManifest) || status.HasActions || status.HasSource {
+		t.Errorf("Unexpected storage status %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = doRequest(s, http.MethodGet, "/manifest/search?title=Second", nil)
+	var m manifest.Manifest
+	json.Unmarshal(rec.Body.Bytes(), &m)
+	if rec.Code != http.StatusOK || len(m.Games) != 1 || m.Games[0].Id != 2 {
+		t.Errorf("Expected the search to only return the second game and got %s", rec.Body.String())
+	}
+
+	rec = doRequest(s, http.MethodPost, "/manifest/summary", nil)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected the method to be refused and got %d", rec.Code)
+	}
+
+	empty, _ := getTestServer(t, nil)
+	rec = doRequest(empty, http.MethodGet, "/manifest/summary", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected a storage without a manifest to return a 404 and got %d", rec.Code)
+	}
+}
+
+func TestServerManifestIncludesJournal(t *testing.T) {
+	m := getTestApiManifest()
+	(*m).Games[1].Installers[0].Checksum = ""
+	s, dir := getTestServer(t, m)
+
+	//An execution is interrupted or still running after uploading the installer of the second game
+	fileAction := manifest.FileAction{Name: "second.sh", Kind: "installer", Action: "add"}
+	fs := storage.GetFileSystem(dir, logging.CreateSource("error"), "")
+	err := fs.AppendJournal(manifest.JournalEntry{Game: manifest.GameInfo{Id: 2}, FileAction: &fileAction, FileSize: 20, FileChecksum: "def"})
+	if err != nil {
+		t.Fatalf("Could not append to the journal: %s", err.Error())
+	}
+
+	rec := doRequest(s, http.MethodGet, "/manifest/search?title=Second", nil)
+	var searched manifest.Manifest
+	json.Unmarshal(rec.Body.Bytes(), &searched)
+	if rec.Code != http.StatusOK || len(searched.Games) != 1 || searched.Games[0].Installers[0].Checksum != "def" {
+		t.Errorf("Expected the manifest to include the checksum of the journal and got %s", rec.Body.String())
+	}
+
+	entries, _ := fs.LoadJournal()
+	if len(entries) != 1 {
+		t.Errorf("Expected the journal to be left to the execution")
+	}
+}
+
+func TestServerPlanJob(t *testing.T) {
+	s, _ := getTestServer(t, nil)
+	body, _ := json.Marshal(getTestApiManifest())
+
+	rec := doRequest(s, http.MethodPost, "/jobs/plan?apply=true", body)
+	var state JobState
+	json.Unmarshal(rec.Body.Bytes(), &state)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("Expected the plan job to start and got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	job, _ := s.jobs.Get(state.Id)
+	state = waitForJob(t, job)
+	if state.Status != JobSucceeded {
+		t.Fatalf("Expected the plan job to succeed and got %v", state)
+	}
+
+	rec = doRequest(s, http.MethodGet, "/storage/status", nil)
+	var status StorageStatus
+	json.Unmarshal(rec.Body.Bytes(), &status)
+	if (!status.HasManifest) || (!status.HasActions) || status.ActionsLeft == 0 || status.Source == nil || (*status.Source).Type != "gog" {
+		t.Errorf("Expected the plan to be applied to the storage and got %s", rec.Body.String())
+	}
+
+	rec = doRequest(s, http.MethodPost, "/jobs/plan", []byte("not a manifest"))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected an invalid manifest to be refused and got %d", rec.Code)
+	}
+}
+
+func TestServerJobLogsIncludeStorageLogs(t *testing.T) {
+	s, _ := getTestServer(t, getTestApiManifest())
+
+	rec := doRequest(s, http.MethodPost, "/jobs/validate", nil)
+	var state JobState
+	json.Unmarshal(rec.Body.Bytes(), &state)
+
+	job, ok := s.jobs.Get(state.Id)
+	if !ok {
+		t.Fatalf("Validate job was not started: %s", rec.Body.String())
+	}
+	state = waitForJob(t, job)
+	if state.Status != JobFailed || len(state.Errors) != 2 {
+		t.Errorf("Expected the validation of the missing files to fail and got %v", state)
+	}
+
+	lines, _ := job.Subscribe()
+	found := false
+	for _, line := range lines {
+		if strings.HasPrefix(line, "[fs] ") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected the logs of the storage to be part of the logs of the job and got %v", lines)
+	}
+
+	rec = doRequest(s, http.MethodGet, "/jobs/"+state.Id+"/events", nil)
+	if (!strings.Contains(rec.Body.String(), "event: log\ndata: [fs] ")) || (!strings.Contains(rec.Body.String(), "event: end\n")) {
+		t.Errorf("Expected the events of the job to stream its logs and its end: %s", rec.Body.String())
+	}
+}
+
+//Recorder whose first flush waits until it is released, like a client that does not read the stream
+type blockingTestRecorder struct {
+	*httptest.ResponseRecorder
+	release chan struct{}
+	blocked bool
+}
+
+func (r *blockingTestRecorder) Flush() {
+	if !r.blocked {
+		r.blocked = true
+		<-r.release
+	}
+	r.ResponseRecorder.Flush()
+}
+
+func TestServerJobEventsDropSlowClients(t *testing.T) {
+	s, _ := getTestServer(t, nil)
+	job := newTestRunningJob()
+	rec := &blockingTestRecorder{ResponseRecorder: httptest.NewRecorder(), release: make(chan struct{})}
+
+	done := make(chan struct{})
+	go func() {
+		s.streamJobEvents(rec, httptest.NewRequest(http.MethodGet, "/jobs/1/events", nil), job)
+		close(done)
+	}()
+	for i := 0; i < 500; i++ {
+		job.mutex.Lock()
+		subscribed := len(job.subscribers) > 0
+		job.mutex.Unlock()
+		if subscribed {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := 0; i < jobSubscriptionSize; i++ {
+		fmt.Fprintf(job, "line %d\n", i)
+	}
+	close(rec.release)
+	<-done
+
+	if !strings.Contains(rec.Body.String(), "event: log\ndata: line 0\n") || !strings.Contains(rec.Body.String(), "event: dropped\n") {
+		t.Errorf("Expected the client to get the lines that were kept for it followed by a dropped event: %s", rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), "event: end\n") {
+		t.Errorf("Expected the dropped client not to get the end of the job")
+	}
+}
+
+func TestServerUnknownJobs(t *testing.T) {
+	s, _ := getTestServer(t, nil)
+
+	rec := doRequest(s, http.MethodPost, "/jobs/unknown", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected an unknown job kind to return a 404 and got %d", rec.Code)
+	}
+
+	rec = doRequest(s, http.MethodGet, "/jobs/42", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected an unknown job to return a 404 and got %d", rec.Code)
+	}
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"gogcli/storage"
+	"log"
+	"net/http"
+	"os"
+)
+
+//Expects the manifest to plan against in the request body. If the apply parameter is true, the manifest is also applied to the storage.
+func (s *Server) getPlanJob(r *http.Request) (JobFn, error) {
+	var m manifest.Manifest
+
+	query := r.URL.Query()
+	emptyChecksum, err := getBoolParam(query, "empty-checksum", false)
+	if err != nil {
+		return nil, err
+	}
+	useStorageFilter, err := getBoolParam(query, "storage-filter", false)
+	if err != nil {
+		return nil, err
+	}
+	apply, err := getBoolParam(query, "apply", false)
+	if err != nil {
+		return nil, err
+	}
+	allowGameDeletions, err := getBoolParam(query, "allow-game-deletions", false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&m)
+	if err != nil {
+		msg := fmt.Sprintf("getPlanJob(...) -> Request body is not a valid manifest: %s", err.Error())
+		return nil, errors.New(msg)
+	}
+
+	return func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		gamesStorage := s.getStorage(logSource)
+		defer storage.CloseStorage(gamesStorage)
+		err := storage.EnsureInitialization(gamesStorage)
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		err = storage.ImprintProtectedFiles(&m, gamesStorage)
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		if useStorageFilter {
+			err = storage.ImprintFilter(&m, gamesStorage)
+			if err != nil {
+				return nil, []error{err}
+			}
+		}
+
+		checksumValidation := manifest.ChecksumValidation
+		if emptyChecksum {
+			checksumValidation = manifest.ChecksumValidationIfPresent
+		}
+
+		actions, err := storage.PlanManifest(&m, gamesStorage, checksumValidation)
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		if !apply {
+			return actions, []error{}
+		}
+
+		summary := actions.GetSummary()
+		if summary.GameDeletions > 0 && (!allowGameDeletions) {
+			msg := fmt.Sprintf("Executing the action would result in the deletion of %d games, aborting.", summary.GameDeletions)
+			return actions, []error{errors.New(msg)}
+		}
+
+		select {
+		case <-cancel:
+			return actions, []error{errors.New("Plan was cancelled before being applied")}
+		default:
+		}
+
+		err = storage.ApplyManifest(&m, gamesStorage, storage.Source{Type: "gog"}, emptyChecksum)
+		if err != nil {
+			return actions, []error{err}
+		}
+
+		return actions, []error{}
+	}, nil
+}
+
+func (s *Server) getExecuteJob(r *http.Request) (JobFn, error) {
+	query := r.URL.Query()
+	concurrency, err := getIntParam(query, "concurrency", 4)
+	if err != nil {
+		return nil, err
+	}
+	retries, err := getIntParam(query, "download-retries", 2)
+	if err != nil {
+		return nil, err
+	}
+	gamesMax, err := getIntParam(query, "maximum", -1)
+	if err != nil {
+		return nil, err
+	}
+	ascending, err := getBoolParam(query, "ascending", true)
+	if err != nil {
+		return nil, err
+	}
+	sortCriterion := query.Get("sort-criterion")
+	if sortCriterion == "" {
+		sortCriterion = "none"
+	}
+
+	return func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		gamesStorage := s.getStorage(logSource)
+		defer storage.CloseStorage(gamesStorage)
+		source, err := gamesStorage.LoadSource()
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		downloader, err := s.getDownloader(*source, logSource)
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		sort := manifest.NewActionIteratorSort([]int64{}, sortCriterion, ascending)
+		proc := storage.GetActionsProcessor(concurrency, retries, gamesMax, sort, logSource).WithCancel(cancel)
+		return nil, storage.ExecuteActions(gamesStorage, downloader, proc)
+	}, nil
+}
+
+func (s *Server) getValidateJob(r *http.Request) (JobFn, error) {
+	query := r.URL.Query()
+	concurrency, err := getIntParam(query, "concurrency", 4)
+	if err != nil {
+		return nil, err
+	}
+	verifyChecksum, err := getBoolParam(query, "verify-checksum", true)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(logSource *logging.Source, cancel <-chan struct{}) (interface{}, []error) {
+		gamesStorage := s.getStorage(logSource)
+		defer storage.CloseStorage(gamesStorage)
+		errs := storage.ValidateManifestWithCancel(gamesStorage, concurrency, verifyChecksum, cancel)
+		if len(errs) == 0 {
+			err := storage.RecordValidation(gamesStorage)
+			if err != nil {
+				logSource.CreateLogger(os.Stdout, "[api] ", log.Lmsgprefix).Warning(fmt.Sprintf("Could not record the validation time: %s", err.Error()))
+			}
+		}
+		return nil, errs
+	}, nil
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+func getBoolParam(query url.Values, name string, defaultValue bool) (bool, error) {
+	value := query.Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		msg := fmt.Sprintf("Parameter %s has invalid boolean value %s", name, value)
+		return false, errors.New(msg)
+	}
+	return result, nil
+}
+
+func getIntParam(query url.Values, name string, defaultValue int) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		msg := fmt.Sprintf("Parameter %s has invalid integer value %s", name, value)
+		return 0, errors.New(msg)
+	}
+	return result, nil
+}
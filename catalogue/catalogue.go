@@ -0,0 +1,195 @@
+package catalogue
+
+import (
+	"gogcli/manifest"
+	"gogcli/metadata"
+	"html/template"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type FileLinkGetter func(file manifest.FileInfo) (string, error)
+type ImageGetter func(image metadata.GameMetadataImage) (io.ReadCloser, int64, error)
+
+type CatalogueImage struct {
+	Image metadata.GameMetadataImage
+	Src   string
+}
+
+type CatalogueScreenshot struct {
+	List CatalogueImage
+	Main CatalogueImage
+}
+
+type CatalogueFile struct {
+	Title     string
+	Name      string
+	Kind      string
+	Os        string
+	Languages string
+	Version   string
+	Type      string
+	Size      string
+	Checksum  string
+	//Storage links can have schemes like file:// which html/template would otherwise reject
+	Link template.URL
+}
+
+type CatalogueGame struct {
+	Id          int64
+	Slug        string
+	Title       string
+	CdKey       string
+	Tags        []string
+	Size        string
+	Page        string
+	ReleaseDate string
+	Category    string
+	Rating      int
+	Listing     CatalogueImage
+	Logo        CatalogueImage
+	Background  CatalogueImage
+	Screenshots []CatalogueScreenshot
+	Summary     template.HTML
+	Description template.HTML
+	Changelog   template.HTML
+	Installers  []CatalogueFile
+	Extras      []CatalogueFile
+}
+
+type Catalogue struct {
+	Games []CatalogueGame
+	Size  string
+}
+
+func getGamePage(gameId int64) string {
+	return path.Join("games", strconv.FormatInt(gameId, 10)+".html")
+}
+
+func getImagePath(image metadata.GameMetadataImage) string {
+	return path.Join("images", image.Tag, image.Name)
+}
+
+//Images start pointing to their original url and get pointed to a local copy once one is exported
+func newCatalogueImage(image metadata.GameMetadataImage) CatalogueImage {
+	return CatalogueImage{Image: image, Src: image.Url}
+}
+
+func NewCatalogue(m *manifest.Manifest, meta *metadata.Metadata, getLink FileLinkGetter) (Catalogue, []error) {
+	errs := make([]error, 0)
+	metaGames := make(map[int64]metadata.MetadataGame)
+	if meta != nil {
+		for _, game := range (*meta).Games {
+			metaGames[game.Id] = game
+		}
+	}
+
+	games := make([]CatalogueGame, 0, len((*m).Games))
+	for _, game := range (*m).Games {
+		gameInfo := manifest.GameInfo{Id: game.Id, Slug: game.Slug, Title: game.Title}
+		catGame := CatalogueGame{
+			Id:          game.Id,
+			Slug:        game.Slug,
+			Title:       game.Title,
+			CdKey:       game.CdKey,
+			Tags:        game.Tags,
+			Size:        manifest.GetBytesToEstimate(game.VerifiedSize),
+			Page:        getGamePage(game.Id),
+			Installers:  make([]CatalogueFile, 0, len(game.Installers)),
+			Extras:      make([]CatalogueFile, 0, len(game.Extras)),
+			Screenshots: []CatalogueScreenshot{},
+		}
+
+		for _, inst := range game.Installers {
+			link, err := getLink(manifest.FileInfo{
+				Game:     gameInfo,
+				Kind:     "installer",
+				Name:     inst.Name,
+				Checksum: inst.Checksum,
+				Size:     inst.VerifiedSize,
+				Url:      inst.Url,
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			catGame.Installers = append(catGame.Installers, CatalogueFile{
+				Title:     inst.Title,
+				Name:      inst.Name,
+				Kind:      "installer",
+				Os:        inst.Os,
+				Languages: strings.Join(inst.Languages, ", "),
+				Version:   inst.Version,
+				Size:      manifest.GetBytesToEstimate(inst.VerifiedSize),
+				Checksum:  inst.Checksum,
+				Link:      template.URL(link),
+			})
+		}
+
+		for _, extra := range game.Extras {
+			link, err := getLink(manifest.FileInfo{
+				Game:     gameInfo,
+				Kind:     "extra",
+				Name:     extra.Name,
+				Checksum: extra.Checksum,
+				Size:     extra.VerifiedSize,
+				Url:      extra.Url,
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			catGame.Extras = append(catGame.Extras, CatalogueFile{
+				Title:    extra.Title,
+				Name:     extra.Name,
+				Kind:     "extra",
+				Type:     extra.Type,
+				Size:     manifest.GetBytesToEstimate(extra.VerifiedSize),
+				Checksum: extra.Checksum,
+				Link:     template.URL(link),
+			})
+		}
+
+		if metaGame, ok := metaGames[game.Id]; ok {
+			catGame.ReleaseDate = metaGame.ReleaseDate
+			catGame.Category = metaGame.Category
+			catGame.Rating = metaGame.Rating
+			catGame.Listing = newCatalogueImage(metaGame.ListingImage)
+			catGame.Logo = newCatalogueImage(metaGame.ProductImages.Logo)
+			catGame.Background = newCatalogueImage(metaGame.ProductImages.Background)
+			for _, screenshot := range metaGame.Screenshots {
+				catGame.Screenshots = append(catGame.Screenshots, CatalogueScreenshot{
+					List: newCatalogueImage(screenshot.List),
+					Main: newCatalogueImage(screenshot.Main),
+				})
+			}
+			//Descriptions and changelogs are html fragments provided by the GOG api
+			catGame.Summary = template.HTML(metaGame.Description.Summary)
+			catGame.Description = template.HTML(metaGame.Description.Full)
+			catGame.Changelog = template.HTML(metaGame.Changelog)
+		}
+
+		games = append(games, catGame)
+	}
+
+	sort.SliceStable(games, func(i, j int) bool {
+		return strings.ToLower(games[i].Title) < strings.ToLower(games[j].Title)
+	})
+
+	return Catalogue{Games: games, Size: manifest.GetBytesToEstimate((*m).VerifiedSize)}, errs
+}
+
+func (c *Catalogue) GetImagesPointers() []*CatalogueImage {
+	result := []*CatalogueImage{}
+	for idx, _ := range (*c).Games {
+		game := &(*c).Games[idx]
+		result = append(result, &game.Listing, &game.Logo, &game.Background)
+		for sIdx, _ := range game.Screenshots {
+			result = append(result, &game.Screenshots[sIdx].List, &game.Screenshots[sIdx].Main)
+		}
+	}
+	return result
+}
@@ -0,0 +1,117 @@
+package catalogue
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"gogcli/metadata"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+//Keeps the images of a storage in memory and links files to a fake location
+type memoryStore struct {
+	images map[string][]byte
+}
+
+func (s *memoryStore) GetFileLink(file manifest.FileInfo) (string, error) {
+	if file.Name == "broken.exe" {
+		return "", errors.New("No link for broken.exe")
+	}
+	return fmt.Sprintf("file:///games/%d/%s", file.Game.Id, file.Name), nil
+}
+
+func (s *memoryStore) DownloadImage(image metadata.GameMetadataImage) (io.ReadCloser, int64, error) {
+	content, ok := (*s).images[image.Tag+"/"+image.Name]
+	if !ok {
+		return nil, 0, errors.New("Image not found")
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), int64(len(content)), nil
+}
+
+func getTestCatalogueSources() (*manifest.Manifest, *metadata.Metadata) {
+	m := manifest.Manifest{
+		Games: []manifest.ManifestGame{
+			manifest.ManifestGame{
+				Id:    2,
+				Title: "zeta Game",
+				Installers: []manifest.ManifestGameInstaller{
+					manifest.ManifestGameInstaller{Name: "setup_zeta.exe", Title: "Zeta", Os: "windows", Languages: []string{"english", "french"}, VerifiedSize: 1000},
+				},
+				Extras: []manifest.ManifestGameExtra{
+					manifest.ManifestGameExtra{Name: "manual.pdf", Title: "Manual", Type: "manuals", VerifiedSize: 10},
+				},
+				VerifiedSize: 1010,
+			},
+			manifest.ManifestGame{
+				Id:    1,
+				Title: "Alpha Game",
+				Installers: []manifest.ManifestGameInstaller{
+					manifest.ManifestGameInstaller{Name: "broken.exe", Title: "Broken", Os: "windows", VerifiedSize: 10},
+				},
+				VerifiedSize: 10,
+			},
+		},
+		VerifiedSize: 1020,
+	}
+	meta := metadata.Metadata{
+		Games: []metadata.MetadataGame{
+			metadata.MetadataGame{
+				Id:           2,
+				Category:     "Strategy",
+				ReleaseDate:  "1998-10-31",
+				ListingImage: metadata.GameMetadataImage{Name: "listing.jpg", Tag: "2", Url: "https://images.gog.com/listing.jpg"},
+				ProductImages: metadata.GameMetadataProductImages{
+					Logo: metadata.GameMetadataImage{Name: "logo.png", Tag: "2", Url: "https://images.gog.com/logo.png"},
+				},
+				Description: metadata.GameMetadataDescription{Summary: "<b>Great</b> game"},
+			},
+		},
+	}
+	return &m, &meta
+}
+
+func TestNewCatalogue(t *testing.T) {
+	m, meta := getTestCatalogueSources()
+	store := memoryStore{}
+	c, errs := NewCatalogue(m, meta, store.GetFileLink)
+
+	if len(errs) != 1 {
+		t.Errorf("Expected the link error of broken.exe to be reported and got %v", errs)
+	}
+	if len(c.Games) != 2 || c.Games[0].Title != "Alpha Game" || c.Games[1].Title != "zeta Game" {
+		t.Fatalf("Expected the games to be sorted by title regardless of case")
+	}
+
+	zeta := c.Games[1]
+	if zeta.Installers[0].Link != "file:///games/2/setup_zeta.exe" || zeta.Installers[0].Languages != "english, french" {
+		t.Errorf("Installer of zeta Game was not converted as expected: %v", zeta.Installers[0])
+	}
+	if zeta.Extras[0].Link != "file:///games/2/manual.pdf" || zeta.Extras[0].Type != "manuals" {
+		t.Errorf("Extra of zeta Game was not converted as expected: %v", zeta.Extras[0])
+	}
+	if zeta.Category != "Strategy" || zeta.Listing.Src != "https://images.gog.com/listing.jpg" || zeta.Page != "games/2.html" {
+		t.Errorf("Metadata of zeta Game was not added as expected")
+	}
+	if c.Games[0].Installers[0].Link != "" || c.Games[0].Listing.Src != "" {
+		t.Errorf("Expected Alpha Game to have no link to its installer and no metadata")
+	}
+}
+
+func TestCatalogueGetImagesPointers(t *testing.T) {
+	m, meta := getTestCatalogueSources()
+	store := memoryStore{}
+	c, _ := NewCatalogue(m, meta, store.GetFileLink)
+
+	pointers := c.GetImagesPointers()
+	if len(pointers) != 6 {
+		t.Fatalf("Expected 3 images per game and got %d", len(pointers))
+	}
+
+	(*pointers[3]).Src = "images/2/listing.jpg"
+	if c.Games[1].Listing.Src != "images/2/listing.jpg" {
+		t.Errorf("Expected the pointers to update the images of the catalogue")
+	}
+}
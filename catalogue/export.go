@@ -0,0 +1,141 @@
+package catalogue
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func isRemote(src string) bool {
+	return strings.HasPrefix(src, "//") || strings.Contains(src, "://")
+}
+
+func getTemplates() (*template.Template, *template.Template, error) {
+	funcs := template.FuncMap{
+		"join": strings.Join,
+		"imageSrc": func(root string, image CatalogueImage) string {
+			if image.Src == "" || isRemote(image.Src) {
+				return image.Src
+			}
+			return root + image.Src
+		},
+	}
+
+	index, err := template.New("index").Funcs(funcs).Parse(indexTemplate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	game, err := template.New("game").Funcs(funcs).Parse(gameTemplate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return index, game, nil
+}
+
+func exportImage(img *CatalogueImage, out string, getImage ImageGetter) error {
+	imgPath := getImagePath((*img).Image)
+	fPath := filepath.Join(out, filepath.FromSlash(imgPath))
+
+	handle, _, err := getImage((*img).Image)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	err = os.MkdirAll(filepath.Dir(fPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	dest, err := os.Create(fPath)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	_, err = io.Copy(dest, handle)
+	if err != nil {
+		return err
+	}
+
+	(*img).Src = imgPath
+	return nil
+}
+
+func renderPage(tmpl *template.Template, fPath string, data interface{}) error {
+	err := os.MkdirAll(filepath.Dir(fPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	dest, err := os.Create(fPath)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	return tmpl.Execute(dest, data)
+}
+
+//Images that cannot be retrieved from the storage keep pointing to their original url
+func Export(c *Catalogue, out string, getImage ImageGetter, logSource *logging.Source) []error {
+	errs := make([]error, 0)
+	logger := logSource.CreateLogger(os.Stdout, "[catalogue] ", log.Lmsgprefix)
+
+	index, game, err := getTemplates()
+	if err != nil {
+		return []error{err}
+	}
+
+	err = os.MkdirAll(out, 0755)
+	if err != nil {
+		msg := fmt.Sprintf("Export(out=%s) -> Error occured while creating output directory: %s", out, err.Error())
+		return []error{errors.New(msg)}
+	}
+
+	if getImage != nil {
+		for _, img := range (*c).GetImagesPointers() {
+			if (*img).Image.Name == "" {
+				continue
+			}
+
+			imgErr := exportImage(img, out, getImage)
+			if imgErr != nil {
+				logger.Warning(fmt.Sprintf("Export(out=%s) -> Could not export image %s/%s, falling back to its url: %s", out, (*img).Image.Tag, (*img).Image.Name, imgErr.Error()))
+			} else {
+				logger.Debug(fmt.Sprintf("Export(out=%s) -> Exported image %s/%s", out, (*img).Image.Tag, (*img).Image.Name))
+			}
+		}
+	}
+
+	err = ioutil.WriteFile(filepath.Join(out, "style.css"), []byte(styleTemplate), 0644)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = renderPage(index, filepath.Join(out, "index.html"), c)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for idx, _ := range (*c).Games {
+		g := &(*c).Games[idx]
+		err = renderPage(game, filepath.Join(out, filepath.FromSlash((*g).Page)), g)
+		if err != nil {
+			msg := fmt.Sprintf("Export(out=%s) -> Error occured while rendering page of game %d: %s", out, (*g).Id, err.Error())
+			errs = append(errs, errors.New(msg))
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Export(out=%s) -> Exported catalogue of %d games", out, len((*c).Games)))
+	return errs
+}
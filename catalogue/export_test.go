@@ -0,0 +1,75 @@
+package catalogue
+
+import (
+	"gogcli/logging"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	m, meta := getTestCatalogueSources()
+	store := memoryStore{images: map[string][]byte{"2/listing.jpg": []byte("jpg")}}
+	c, _ := NewCatalogue(m, meta, store.GetFileLink)
+
+	out := t.TempDir()
+	errs := Export(&c, out, store.DownloadImage, logging.CreateSource("error"))
+	if len(errs) > 0 {
+		t.Fatalf("Export failed: %v", errs)
+	}
+
+	for _, fPath := range []string{"index.html", "style.css", filepath.Join("games", "1.html"), filepath.Join("games", "2.html")} {
+		if _, err := os.Stat(filepath.Join(out, fPath)); err != nil {
+			t.Errorf("Expected %s to be exported", fPath)
+		}
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(out, "images", "2", "listing.jpg"))
+	if err != nil || string(content) != "jpg" {
+		t.Errorf("Expected the listing image to be copied from the storage")
+	}
+
+	index, _ := ioutil.ReadFile(filepath.Join(out, "index.html"))
+	if !strings.Contains(string(index), `src="images/2/listing.jpg"`) {
+		t.Errorf("Expected the index to point to the exported listing image")
+	}
+	if strings.Index(string(index), "Alpha Game") > strings.Index(string(index), "zeta Game") {
+		t.Errorf("Expected the index to list the games in order")
+	}
+
+	page, _ := ioutil.ReadFile(filepath.Join(out, "games", "2.html"))
+	if !strings.Contains(string(page), "https://images.gog.com/logo.png") {
+		t.Errorf("Expected the image missing from the storage to keep its original url")
+	}
+	if !strings.Contains(string(page), `href="file:///games/2/setup_zeta.exe"`) || !strings.Contains(string(page), "<b>Great</b> game") {
+		t.Errorf("Expected the game page to link the files and keep the html of the description")
+	}
+
+	page, _ = ioutil.ReadFile(filepath.Join(out, "games", "1.html"))
+	if strings.Contains(string(page), "<a href") && strings.Contains(string(page), "broken.exe</a>") {
+		t.Errorf("Expected the file without a link to be listed without an anchor")
+	}
+}
+
+func TestExportWithoutImages(t *testing.T) {
+	m, meta := getTestCatalogueSources()
+	store := memoryStore{}
+	c, _ := NewCatalogue(m, meta, store.GetFileLink)
+
+	out := t.TempDir()
+	errs := Export(&c, out, nil, logging.CreateSource("error"))
+	if len(errs) > 0 {
+		t.Fatalf("Export failed: %v", errs)
+	}
+
+	if _, err := os.Stat(filepath.Join(out, "images")); err == nil {
+		t.Errorf("Expected no image to be exported")
+	}
+
+	index, _ := ioutil.ReadFile(filepath.Join(out, "index.html"))
+	if !strings.Contains(string(index), "https://images.gog.com/listing.jpg") {
+		t.Errorf("Expected the index to point to the original url of the listing image")
+	}
+}
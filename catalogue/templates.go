@@ -0,0 +1,107 @@
+package catalogue
+
+const styleTemplate = `
+body { font-family: sans-serif; margin: 0; background: #1e1e1e; color: #ddd; }
+a { color: #b98bf1; }
+header { padding: 1em 2em; background: #2b2b2b; }
+main { padding: 1em 2em; }
+.grid { display: grid; grid-template-columns: repeat(auto-fill, minmax(200px, 1fr)); gap: 1em; }
+.card { background: #2b2b2b; text-decoration: none; color: #ddd; }
+.card img { width: 100%; display: block; }
+.card div { padding: 0.5em; }
+.screenshots img { height: 120px; margin: 0.2em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1em; }
+th, td { text-align: left; padding: 0.3em 0.6em; border-bottom: 1px solid #444; }
+td.checksum { font-family: monospace; font-size: 0.85em; }
+`
+
+const indexTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Game Library</title>
+<link rel="stylesheet" href="style.css">
+</head>
+<body>
+<header>
+<h1>Game Library</h1>
+<p>{{len .Games}} games, {{.Size}}</p>
+</header>
+<main>
+<div class="grid">
+{{- range .Games}}
+<a class="card" href="{{.Page}}">
+{{- if .Listing.Src}}
+<img src="{{imageSrc "" .Listing}}" alt="{{.Title}}">
+{{- end}}
+<div>{{.Title}}<br><small>{{.Size}}</small></div>
+</a>
+{{- end}}
+</div>
+</main>
+</body>
+</html>
+`
+
+const gameTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="../style.css">
+</head>
+<body>
+<header>
+<a href="../index.html">Library</a>
+{{- if .Logo.Src}}
+<p><img src="{{imageSrc "../" .Logo}}" alt="{{.Title}}"></p>
+{{- end}}
+<h1>{{.Title}}</h1>
+<p>
+Id: {{.Id}} | Slug: {{.Slug}} | Size: {{.Size}}
+{{- if .ReleaseDate}} | Released: {{.ReleaseDate}}{{end}}
+{{- if .Category}} | Category: {{.Category}}{{end}}
+{{- if .Tags}} | Tags: {{join .Tags ", "}}{{end}}
+</p>
+{{- if .CdKey}}
+<p>CD Key: <code>{{.CdKey}}</code></p>
+{{- end}}
+</header>
+<main>
+{{- if .Summary}}
+<section>{{.Summary}}</section>
+{{- end}}
+{{- if .Description}}
+<h2>Description</h2>
+<section>{{.Description}}</section>
+{{- end}}
+{{- if .Screenshots}}
+<h2>Screenshots</h2>
+<section class="screenshots">
+{{- range .Screenshots}}
+<a href="{{imageSrc "../" .Main}}"><img src="{{imageSrc "../" .List}}"></a>
+{{- end}}
+</section>
+{{- end}}
+<h2>Installers</h2>
+<table>
+<tr><th>Title</th><th>Name</th><th>Os</th><th>Languages</th><th>Version</th><th>Size</th><th>Checksum</th></tr>
+{{- range .Installers}}
+<tr><td>{{.Title}}</td><td>{{if .Link}}<a href="{{.Link}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}</td><td>{{.Os}}</td><td>{{.Languages}}</td><td>{{.Version}}</td><td>{{.Size}}</td><td class="checksum">{{.Checksum}}</td></tr>
+{{- end}}
+</table>
+<h2>Extras</h2>
+<table>
+<tr><th>Title</th><th>Name</th><th>Type</th><th>Size</th><th>Checksum</th></tr>
+{{- range .Extras}}
+<tr><td>{{.Title}}</td><td>{{if .Link}}<a href="{{.Link}}">{{.Name}}</a>{{else}}{{.Name}}{{end}}</td><td>{{.Type}}</td><td>{{.Size}}</td><td class="checksum">{{.Checksum}}</td></tr>
+{{- end}}
+</table>
+{{- if .Changelog}}
+<h2>Changelog</h2>
+<section>{{.Changelog}}</section>
+{{- end}}
+</main>
+</body>
+</html>
+`
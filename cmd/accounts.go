@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"gogcli/sdk"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var accounts []string
+var accountNames []string
+var accountSdks map[string]*sdk.Sdk
+
+func createSdk(cookieFile string) (*sdk.Sdk, error) {
+	cookies, err := sdk.ReadCookie(cookieFile, cookieFileType)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkPtr := sdk.NewSdk(cookies, logSource)
+	sdkPtr.SetRetries(sdkRetries, sdkRetryPause)
+	sdkPtr.SetSessionRefresh(sessionRefreshInterval)
+	sdkPtr.SetSegmentedDownloads(downloadConnections, segmentedDownloadMinSize*1024*1024)
+	if writeBackCookie {
+		sdkPtr.SetCookiePersister(func(cookies []*http.Cookie) error {
+			return sdk.WriteCookie(cookieFile, cookieFileType, cookies)
+		})
+	}
+	return sdkPtr, nil
+}
+
+func loadAccounts(accounts []string) ([]string, map[string]*sdk.Sdk, error) {
+	names := []string{}
+	sdks := make(map[string]*sdk.Sdk)
+	for _, account := range accounts {
+		parts := strings.SplitN(account, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, nil, errors.New(fmt.Sprintf("loadAccounts(...) -> Account %s is not of the form <name>=<cookie file>", account))
+		}
+
+		if _, ok := sdks[parts[0]]; ok {
+			return nil, nil, errors.New(fmt.Sprintf("loadAccounts(...) -> Account %s was given more than once", parts[0]))
+		}
+
+		sdkPtr, err := createSdk(parts[1])
+		if err != nil {
+			return nil, nil, errors.New(fmt.Sprintf("loadAccounts(...) -> Could not load cookie of account %s: %s", parts[0], err.Error()))
+		}
+		names = append(names, parts[0])
+		sdks[parts[0]] = sdkPtr
+	}
+	return names, sdks, nil
+}
+
+func getAccountsDownloader() sdk.AccountsDownloader {
+	return sdk.AccountsDownloader{
+		Accounts: accountSdks,
+		Default:  sdkPtr,
+		Logger:   logSource.CreateLogger(os.Stdout, "[accounts] ", log.Lmsgprefix),
+	}
+}
+
+//Games of each account are generated in turn and merged into the writer's manifest in the order the accounts were given
+func writeAccountsManifestGames(writer *manifest.ManifestGamesWriter, concurrency int, pause int, tolerateDangles bool, tolerateBadFileMetadata bool, persister manifest.ManifestWriterStatePersister) []error {
+	if len(accountNames) == 0 {
+		if (*writer).State.Accounts != nil {
+			msg := fmt.Sprintf("writeAccountsManifestGames(...) -> Progress is for accounts %v, pass them with the --account flag", (*(*writer).State.Accounts).Accounts)
+			return []error{errors.New(msg)}
+		}
+		return writer.Write(
+			sdkPtr.GenerateManifestGameGetter(concurrency, pause, tolerateDangles, tolerateBadFileMetadata),
+			persister,
+		)
+	}
+
+	return writer.WriteAccounts(
+		accountNames,
+		func(name string) manifest.ManifestGameGetter {
+			return accountSdks[name].GenerateManifestGameGetter(concurrency, pause, tolerateDangles, tolerateBadFileMetadata)
+		},
+		persister,
+	)
+}
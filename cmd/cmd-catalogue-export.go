@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"gogcli/catalogue"
+	"gogcli/metadata"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateCatalogueExportCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var out string
+	var metadataFile string
+	var skipImages bool
+
+	catalogueExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Generate a static html website listing the games, files and metadata of a storage",
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+
+			m, err := gamesStorage.LoadManifest()
+			processError(err)
+
+			var meta *metadata.Metadata
+			if metadataFile != "" {
+				fileMeta, err := loadMetadataFromFile(metadataFile)
+				processError(err)
+				meta = &fileMeta
+			} else {
+				hasMetadata, err := gamesStorage.HasMetadata()
+				processError(err)
+				if hasMetadata {
+					meta, err = gamesStorage.LoadMetadata()
+					processError(err)
+				}
+			}
+
+			c, errs := catalogue.NewCatalogue(m, meta, gamesStorage.GetFileLink)
+			processErrors(errs)
+
+			var getImage catalogue.ImageGetter
+			if !skipImages {
+				getImage = gamesStorage.DownloadImage
+			}
+
+			errs = catalogue.Export(&c, out, getImage, logSource)
+			if len(errs) > 0 {
+				for _, err := range errs {
+					fmt.Println(err)
+				}
+				os.Exit(1)
+			}
+		},
+	}
+
+	catalogueExportCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	catalogueExportCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	catalogueExportCmd.Flags().StringVarP(&out, "out", "o", "site", "Directory where the website should be generated")
+	catalogueExportCmd.Flags().StringVarP(&metadataFile, "metadata", "m", "", "Optional metadata file to use instead of the metadata in the storage")
+	catalogueExportCmd.Flags().BoolVarP(&skipImages, "skip-images", "s", false, "If set to true, images will not be copied from the storage and the website will link to their original url instead")
+
+	return catalogueExportCmd
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func generateCatalogueCmd() *cobra.Command {
+	catalogueCmd := &cobra.Command{
+		Use:   "catalogue",
+		Short: "Commands to generate a browsable catalogue of the games in a storage",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			callPersistentPreRun(cmd, args)
+		},
+	}
+
+	catalogueCmd.AddCommand(generateCatalogueExportCmd())
+
+	return catalogueCmd
+}
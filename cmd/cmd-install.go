@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/install"
+	"gogcli/manifest"
+	"gogcli/storage"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+//Directory of the installation where installers are downloaded, so that interrupted downloads can be resumed
+const installDownloadDir = ".gogcli-download"
+
+func findManifestGame(m *manifest.Manifest, term string) (*manifest.ManifestGame, error) {
+	var found *manifest.ManifestGame
+	for idx, _ := range (*m).Games {
+		if !(*m).Games[idx].IsDesignatedBy(term) {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New(fmt.Sprintf("Several games of the manifest are designated by %s. Use the id of the game instead", term))
+		}
+		found = &(*m).Games[idx]
+	}
+
+	if found == nil {
+		return nil, errors.New(fmt.Sprintf("No game in the manifest has %s as its id, slug or title", term))
+	}
+	return found, nil
+}
+
+func generateInstallCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var gameTerm string
+	var dest string
+	var languages []string
+	var dlcs bool
+	var force bool
+
+	installCmd := &cobra.Command{
+		Use:   "install",
+		Short: "Install a linux game from the storage by extracting the game files of its installer, without running it",
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, downloader := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+			processError(storage.RecoverJournal(gamesStorage))
+			m, err := gamesStorage.LoadManifest()
+			processError(err)
+
+			game, err := findManifestGame(m, gameTerm)
+			processError(err)
+
+			installers, err := install.SelectLinuxInstallers(game, languages, dlcs)
+			processError(err)
+
+			if dest == "" {
+				dest = (*game).Slug
+			}
+
+			receipt, err := install.LoadReceipt(dest)
+			processError(err)
+			if receipt != nil && (!force) && receipt.IsUpToDate(installers) {
+				fmt.Println(fmt.Sprintf("%s is already installed from the latest installers in %s", (*game).Title, dest))
+				return
+			}
+
+			downloadDir := filepath.Join(dest, installDownloadDir)
+			gameInfo := manifest.GameInfo{Id: (*game).Id, Slug: (*game).Slug, Title: (*game).Title}
+			files := []manifest.FileInfo{}
+			for _, installer := range installers {
+				files = append(files, manifest.FileInfo{
+					Game:      gameInfo,
+					Kind:      "installer",
+					Name:      installer.Name,
+					Checksum:  installer.Checksum,
+					Size:      installer.VerifiedSize,
+					Url:       installer.Url,
+					Os:        installer.Os,
+					Languages: installer.Languages,
+				})
+			}
+			_, errs := storage.RestoreFiles(downloader, files, downloadDir, 1)
+			processErrors(errs)
+
+			//Dlcs are extracted after the base game so that the files they replace are overwritten
+			installed := []string{}
+			seen := make(map[string]bool)
+			for _, file := range files {
+				extracted, err := install.ExtractMojoSetupInstaller(storage.GetRestorePath(downloadDir, file), dest)
+				processError(err)
+				for _, extractedFile := range extracted {
+					if !seen[extractedFile] {
+						seen[extractedFile] = true
+						installed = append(installed, extractedFile)
+					}
+				}
+			}
+
+			if receipt != nil {
+				processError(receipt.RemoveStaleFiles(dest, installed))
+			}
+
+			processError(install.StoreReceipt(install.NewReceipt(game, installers, installed), dest))
+			processError(os.RemoveAll(downloadDir))
+			fmt.Println(fmt.Sprintf("%s was installed from %s in %s", (*game).Title, installers[0].Name, dest))
+		},
+	}
+
+	installCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	installCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	installCmd.Flags().StringVarP(&gameTerm, "game", "", "", "Id, slug or title of the game to install")
+	installCmd.MarkFlagRequired("game")
+	installCmd.Flags().StringVarP(&dest, "dest", "d", "", "Directory to install the game in. Defaults to a directory named after the slug of the game in the current directory")
+	installCmd.Flags().StringArrayVarP(&languages, "lang", "l", []string{}, "Language of the installer to install, for games that have several linux installers")
+	installCmd.Flags().BoolVarP(&dlcs, "dlcs", "", true, "Whether to install the dlcs of the game on top of it")
+	installCmd.Flags().BoolVarP(&force, "force", "", false, "If set to true, the game is installed again even if its installation is up to date")
+
+	return installCmd
+}
@@ -40,15 +40,13 @@ func generateManifestGenerateResumeCmd() *cobra.Command {
 			}
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			progressFn := PersistManifestProgress(progressFile)
 			writer := manifest.NewManifestGamesWriter(
 				*s,
 				logSource,
 			)
-			errs := writer.Write( 
-				sdkPtr.GenerateManifestGameGetter(concurrency, pause, tolerateDangles, tolerateBadFileMetadata),
-				progressFn,
-			)
+			errs := writeAccountsManifestGames(writer, concurrency, pause, tolerateDangles, tolerateBadFileMetadata, progressFn)
 			m, warnings := writer.State.Manifest, writer.State.Warnings
 			
 			if len(warnings) > 0 {
@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"fmt"
 	"gogcli/manifest"
+	"os"
 
 	"github.com/spf13/cobra"
 )
@@ -13,9 +15,17 @@ func generateManifestGenerateCmd() *cobra.Command {
 	var gameTitleFilters []string
 	var downloads bool
 	var extras bool
+	var depots bool
+	var patches string
+	var languagePacks bool
+	var dlcs bool
+	var orphanDlcs bool
+	var depotFormat string
 	var extraTypeFilters []string
 	var skipUrlFilters []string
 	var hasUrlFilters []string
+	var predicates gamePredicateFlags
+	var policyFile string
 	var concurrency int
 	var pause int
 	var manifestFile string
@@ -30,10 +40,19 @@ func generateManifestGenerateCmd() *cobra.Command {
 		Use:   "generate",
 		Short: "Generate a games manifest from the GOG Api, which can then be applied to a storage",
 		PreRun: func(cmd *cobra.Command, args []string) {
+			if patches != manifest.PatchesAll && patches != manifest.PatchesLatest && patches != manifest.PatchesNone {
+				fmt.Println("Patches must be either 'all', 'latest' or 'none'")
+				os.Exit(1)
+			}
+			if depotFormat != manifest.DepotFormatFiles && depotFormat != manifest.DepotFormatChunks {
+				fmt.Println("Depot format must be either 'files' or 'chunks'")
+				os.Exit(1)
+			}
 			CleanupFile(warningFile)
 			CleanupFile(duplicatesFile)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			f := manifest.NewManifestFilter(
 				gameTitleFilters,
 				oses,
@@ -41,19 +60,30 @@ func generateManifestGenerateCmd() *cobra.Command {
 				gameTagFilters,
 				downloads,
 				extras,
+				depots,
 				extraTypeFilters,
 				skipUrlFilters,
 				hasUrlFilters,
 			)
+			f.Patches = patches
+			f.SkipLanguagePacks = !languagePacks
+			f.SkipDlcs = !dlcs
+			f.SkipOrphanDlcs = !orphanDlcs
+			processError(predicates.applyTo(&f))
+			if policyFile != "" {
+				policy, err := loadPolicyFromFile(policyFile)
+				processError(err)
+				f.PolicyRules = (*policy).Rules
+			}
+			if depots {
+				f.DepotFormat = depotFormat
+			}
 			progressFn := PersistManifestProgress(progressFile)
 			writer := manifest.NewManifestGamesWriter(
 				manifest.NewManifestGamesWriterState(f, []int64{}),
 				logSource,
 			)
-			errs := writer.Write( 
-				sdkPtr.GenerateManifestGameGetter(concurrency, pause, tolerateDangles, tolerateBadFileMetadata),
-				progressFn,
-			)
+			errs := writeAccountsManifestGames(writer, concurrency, pause, tolerateDangles, tolerateBadFileMetadata, progressFn)
 			m, warnings := writer.State.Manifest, writer.State.Warnings
 			
 			if len(warnings) > 0 {
@@ -82,6 +112,12 @@ func generateManifestGenerateCmd() *cobra.Command {
 	manifestGenerateCmd.Flags().StringArrayVarP(&gameTitleFilters, "title", "i", []string{}, "If you want to include only games with title that contain at least one of the given strings")
 	manifestGenerateCmd.Flags().BoolVarP(&downloads, "installers", "n", true, "Whether to incluse installer downloads")
 	manifestGenerateCmd.Flags().BoolVarP(&extras, "extras", "e", true, "Whether to incluse extras")
+	manifestGenerateCmd.Flags().StringVarP(&patches, "patches", "", manifest.PatchesAll, "Which patches to include. Can be 'all', 'latest' to only keep the patches leading to the current version of the installers or 'none'")
+	manifestGenerateCmd.Flags().BoolVarP(&languagePacks, "language-packs", "", true, "Whether to include language packs")
+	manifestGenerateCmd.Flags().BoolVarP(&dlcs, "dlcs", "", true, "Whether to include the files of dlcs along with the files of their base game")
+	manifestGenerateCmd.Flags().BoolVarP(&orphanDlcs, "orphan-dlcs", "", true, "Whether to include dlcs whose base game is not owned")
+	manifestGenerateCmd.Flags().BoolVarP(&depots, "depots", "", false, "Whether to include the galaxy depots of the latest build of games for each os, as an alternative to the installers for games that only get updated builds in galaxy")
+	manifestGenerateCmd.Flags().StringVarP(&depotFormat, "depot-format", "", manifest.DepotFormatFiles, "How galaxy depots are stored. Can be 'files' to store an archive of the reassembled files or 'chunks' to store an archive of the depot manifest and its compressed chunks")
 	manifestGenerateCmd.Flags().StringArrayVarP(&extraTypeFilters, "extratype", "x", []string{}, "If you want to include only extras whole type contain one of the given strings. Look at full generated manifest without this flag to figure out valid types")
 	manifestGenerateCmd.Flags().StringArrayVarP(&skipUrlFilters, "skip-url", "v", []string{}, "Regex of file urls that should be skipped")
 	manifestGenerateCmd.Flags().StringArrayVarP(&hasUrlFilters, "has-url", "j", []string{}, "Regex of file urls that should match at least one of the game's installer files")
@@ -94,5 +130,7 @@ func generateManifestGenerateCmd() *cobra.Command {
 	manifestGenerateCmd.Flags().StringVarP(&warningFile, "warning-file", "w", "manifest-generation-warnings.json", "Warnings from files whose download url return 404 will be listed in this file. Will only be generated if tolerate-dangles is set to true")
 	manifestGenerateCmd.Flags().StringVarP(&duplicatesFile, "duplicates-file", "u", "manifest-generation-duplicates.json", "Files that had duplicate filenames within the same game and had to be renamed will be listed in this file")
 	manifestGenerateCmd.Flags().BoolVarP(&tolerateBadFileMetadata, "tolerate-bad-metadata", "b", true, "Tolerate files for which metadata cannot be retrieved. The checksum will be infered by performing a throwaway file download instead.")
+	manifestGenerateCmd.Flags().StringVarP(&policyFile, "policy", "", "", "Optional policy file with rules overriding the oses, languages and kinds of files to include for specific games")
+	predicates.addFlags(manifestGenerateCmd)
 	return manifestGenerateCmd
 }
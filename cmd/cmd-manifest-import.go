@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"gogcli/importers"
+	"gogcli/manifest"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+//Files without a verified size are trimmed when the manifest is finalized and are reported beforehand
+func getImportWarnings(m *manifest.Manifest) []string {
+	warnings := []string{}
+	for _, game := range (*m).Games {
+		for _, installer := range game.Installers {
+			if installer.VerifiedSize == 0 {
+				warnings = append(warnings, fmt.Sprintf("Installer %s of game %d (%s) does not have a known size and was left out of the manifest", installer.Name, game.Id, game.Slug))
+			}
+		}
+		for _, extra := range game.Extras {
+			if extra.VerifiedSize == 0 {
+				warnings = append(warnings, fmt.Sprintf("Extra %s of game %d (%s) does not have a known size and was left out of the manifest", extra.Name, game.Id, game.Slug))
+			}
+		}
+	}
+	return warnings
+}
+
+func generateManifestImportCmd() *cobra.Command {
+	var format string
+	var file string
+	var xmlDir string
+	var manifestFile string
+	var terminalOutput bool
+	var warningFile string
+	var duplicatesFile string
+
+	manifestImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Convert the manifest of another gog downloader into a games manifest, which can then be applied to a storage",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			if format != "gogrepo" && format != "lgogdownloader" {
+				fmt.Println("Format must be either 'gogrepo' or 'lgogdownloader'")
+				os.Exit(1)
+			}
+			CleanupFile(warningFile)
+			CleanupFile(duplicatesFile)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			content, err := ioutil.ReadFile(file)
+			processError(err)
+
+			var m *manifest.Manifest
+			if format == "gogrepo" {
+				m, err = importers.ImportGogrepoManifest(content)
+			} else {
+				m, err = importers.ImportLgogdownloaderDetails(content, xmlDir)
+			}
+			processError(err)
+
+			warnings := getImportWarnings(m)
+			if len(warnings) > 0 {
+				processSerializableOutput(Errors{warnings}, []error{}, false, warningFile)
+			}
+
+			duplicates := m.Finalize()
+			if len(duplicates) > 0 {
+				processSerializableOutput(duplicates, []error{}, false, duplicatesFile)
+			}
+
+			processSerializableOutput(m, []error{}, terminalOutput, manifestFile)
+		},
+	}
+
+	manifestImportCmd.Flags().StringVarP(&format, "format", "", "gogrepo", "Format of the file to import. Can be 'gogrepo' for the gog-manifest.dat file of gogrepo or 'lgogdownloader' for the gamedetails.json cache of lgogdownloader")
+	manifestImportCmd.Flags().StringVarP(&file, "file", "i", "", "File to import")
+	manifestImportCmd.MarkFlagRequired("file")
+	manifestImportCmd.Flags().StringVarP(&xmlDir, "xml-dir", "x", "", "Directory of the xml files lgogdownloader keeps for the files it downloaded, from which their checksums are taken. Only used with the lgogdownloader format")
+	manifestImportCmd.Flags().StringVarP(&manifestFile, "manifest-file", "f", "manifest.json", "File to output the manifest in")
+	manifestImportCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the manifest will be output on the terminal instead of in a file")
+	manifestImportCmd.Flags().StringVarP(&warningFile, "warning-file", "w", "manifest-import-warnings.json", "Files that were left out of the manifest because their size is not known will be listed in this file")
+	manifestImportCmd.Flags().StringVarP(&duplicatesFile, "duplicates-file", "u", "manifest-import-duplicates.json", "Files that had duplicate filenames within the same game and had to be renamed will be listed in this file")
+	return manifestImportCmd
+}
@@ -23,6 +23,7 @@ func generateManifestMigrateCmd() *cobra.Command {
 			processError(err)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			next, errs := m.Migrate(sdkPtr)
 			processSerializableOutput(next, errs, false, manifestFile)
 		},
@@ -27,6 +27,7 @@ func generateManifestMissingGamesCmd() *cobra.Command {
 			processError(err)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			o, err := sdkPtr.GetAllOwnedGamesPagesSync("", concurrency, pause)
 			if err != nil {
 				fmt.Println("Could not retrieve owned games from gog.com: ", err)
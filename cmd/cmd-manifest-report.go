@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"gogcli/manifest"
+	"gogcli/report"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateManifestReportCmd() *cobra.Command {
+	var from manifest.Manifest
+	var to manifest.Manifest
+	var fromManifestFile string
+	var toManifestFile string
+	var format string
+	var reportFile string
+	var terminalOutput bool
+
+	manifestReportCmd := &cobra.Command{
+		Use:   "report",
+		Short: "Generate a human-readable changelog of the games between two manifests",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			if !report.IsValidFormat(format) {
+				fmt.Println("Format must be either 'md', 'html' or 'json'")
+				os.Exit(1)
+			}
+
+			var err error
+			from, err = loadManifestFromFile(fromManifestFile)
+			processError(err)
+			to, err = loadManifestFromFile(toManifestFile)
+			processError(err)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			r := report.NewReport(&from, &to)
+
+			if terminalOutput {
+				processError(report.Render(&r, format, os.Stdout))
+				return
+			}
+
+			out, err := os.Create(reportFile)
+			processError(err)
+			err = report.Render(&r, format, out)
+			out.Close()
+			processError(err)
+		},
+	}
+
+	manifestReportCmd.Flags().StringVarP(&fromManifestFile, "from", "", "manifest.json", "Manifest representing the games before the changes")
+	manifestReportCmd.MarkFlagFilename("from")
+	manifestReportCmd.Flags().StringVarP(&toManifestFile, "to", "", "next-manifest.json", "Manifest representing the games after the changes")
+	manifestReportCmd.MarkFlagFilename("to")
+	manifestReportCmd.Flags().StringVarP(&format, "format", "", report.FormatMarkdown, "Format of the report. Can be 'md', 'html' or 'json'")
+	manifestReportCmd.Flags().StringVarP(&reportFile, "report-file", "f", "manifest-report.md", "File to output the report in")
+	manifestReportCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the report will be output on the terminal instead of in a file")
+
+	return manifestReportCmd
+}
@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"fmt"
 	"gogcli/manifest"
+	"os"
 
 	"github.com/spf13/cobra"
 )
@@ -15,9 +17,15 @@ func generateManifestSearchCmd() *cobra.Command {
 	var gameTitleFilters []string
 	var downloads bool
 	var extras bool
+	var depots bool
+	var patches string
+	var languagePacks bool
+	var dlcs bool
+	var orphanDlcs bool
 	var extraTypeFilters []string
 	var skipUrlFilters []string
 	var hasUrlFilters []string
+	var predicates gamePredicateFlags
 	var file string
 	var terminalOutput bool
 
@@ -25,6 +33,10 @@ func generateManifestSearchCmd() *cobra.Command {
 		Use:   "search",
 		Short: "Get a subset of a given manifest, corresponding to search terms",
 		PreRun: func(cmd *cobra.Command, args []string) {
+			if patches != manifest.PatchesAll && patches != manifest.PatchesLatest && patches != manifest.PatchesNone {
+				fmt.Println("Patches must be either 'all', 'latest' or 'none'")
+				os.Exit(1)
+			}
 			var err error
 			m, err = loadManifestFromFile(manifestFile)
 			processError(err)
@@ -37,10 +49,16 @@ func generateManifestSearchCmd() *cobra.Command {
 				gameTagFilters,
 				downloads,
 				extras,
+				depots,
 				extraTypeFilters,
 				skipUrlFilters,
 				hasUrlFilters,
 			)
+			f.Patches = patches
+			f.SkipLanguagePacks = !languagePacks
+			f.SkipDlcs = !dlcs
+			f.SkipOrphanDlcs = !orphanDlcs
+			processError(predicates.applyTo(&f))
 			m.Filter = *(f.Intersect(m.Filter))
 			m.Trim()
 			m.Finalize()
@@ -55,10 +73,16 @@ func generateManifestSearchCmd() *cobra.Command {
 	manifestSearchCmd.Flags().StringArrayVarP(&gameTitleFilters, "title", "i", []string{}, "If you want to include only games with title that contain at least one of the given strings")
 	manifestSearchCmd.Flags().BoolVarP(&downloads, "installers", "n", true, "Whether to include installer downloads")
 	manifestSearchCmd.Flags().BoolVarP(&extras, "extras", "e", true, "Whether to include extras")
+	manifestSearchCmd.Flags().StringVarP(&patches, "patches", "", manifest.PatchesAll, "Which patches to include. Can be 'all', 'latest' to only keep the patches leading to the current version of the installers or 'none'")
+	manifestSearchCmd.Flags().BoolVarP(&languagePacks, "language-packs", "", true, "Whether to include language packs")
+	manifestSearchCmd.Flags().BoolVarP(&dlcs, "dlcs", "", true, "Whether to include the files of dlcs along with the files of their base game")
+	manifestSearchCmd.Flags().BoolVarP(&orphanDlcs, "orphan-dlcs", "", true, "Whether to include dlcs whose base game is not owned")
+	manifestSearchCmd.Flags().BoolVar(&depots, "depots", true, "Whether to include galaxy depots")
 	manifestSearchCmd.Flags().StringArrayVarP(&extraTypeFilters, "extra-type", "x", []string{}, "If you want to include only extras whole type contain one of the given strings. Look at full generated manifest without this flag to figure out valid types")
 	manifestSearchCmd.Flags().StringArrayVarP(&skipUrlFilters, "skip-url", "v", []string{}, "Regex of file urls that should be skipped")
 	manifestSearchCmd.Flags().StringArrayVarP(&hasUrlFilters, "has-url", "j", []string{}, "Regex of file urls that should match at least one of the game's installer files")
 	manifestSearchCmd.Flags().StringVarP(&file, "file", "f", "search.json", "File to output the search in")
 	manifestSearchCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", true, "If set to true, the search will be output on the terminal instead of in a file")
+	predicates.addFlags(manifestSearchCmd)
 	return manifestSearchCmd
 }
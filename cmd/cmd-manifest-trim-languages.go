@@ -41,6 +41,7 @@ func generateManifestTrimLanguagesCmd() *cobra.Command {
 			processError(err)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			err := m.AddUrlFilterForGame(gameId, getLangRegex(trimmedLanguages))
 			processError(err)
 
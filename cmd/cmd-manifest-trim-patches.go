@@ -26,6 +26,7 @@ func generateManifestTrimPatchesCmd() *cobra.Command {
 			processError(err)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			err := m.AddUrlFilterForGame(gameId, "[a-z]{2,2}[0-9]patch[0-9]")
 			processError(err)
 
@@ -45,15 +45,13 @@ func generateManifestUpdateResumeCmd() *cobra.Command {
 			}
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			progressFn := PersistManifestProgress(progressFile)
 			writer := manifest.NewManifestGamesWriter(
 				*s,
 				logSource,
 			)
-			errs := writer.Write( 
-				sdkPtr.GenerateManifestGameGetter(concurrency, pause, tolerateDangles, tolerateBadFileMetadata),
-				progressFn,
-			)
+			errs := writeAccountsManifestGames(writer, concurrency, pause, tolerateDangles, tolerateBadFileMetadata, progressFn)
 			uManifest, warnings := writer.State.Manifest, writer.State.Warnings
 
 			if len(warnings) > 0 {
@@ -24,6 +24,7 @@ func generateManifestUpdateCmd() *cobra.Command {
 	var pause int
 	var tolerateDangles bool
 	var tolerateBadFileMetadata bool
+	var policyFile string
 
 	manifestUpdateCmd := &cobra.Command{
 		Use:   "update",
@@ -54,10 +55,17 @@ func generateManifestUpdateCmd() *cobra.Command {
 				}
 			}
 
+			if policyFile != "" {
+				policy, err := loadPolicyFromFile(policyFile)
+				processError(err)
+				m.Filter.PolicyRules = (*policy).Rules
+			}
+
 			CleanupFile(warningFile)
 			CleanupFile(duplicatesFile)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			ids := gameIds
 			if u != nil {
 				ids = append(ids, (*u).NewGames...)
@@ -69,10 +77,7 @@ func generateManifestUpdateCmd() *cobra.Command {
 				manifest.NewManifestGamesWriterState(m.Filter, ids),
 				logSource,
 			)
-			errs := writer.Write( 
-				sdkPtr.GenerateManifestGameGetter(concurrency, pause, tolerateDangles, tolerateBadFileMetadata),
-				progressFn,
-			)
+			errs := writeAccountsManifestGames(writer, concurrency, pause, tolerateDangles, tolerateBadFileMetadata, progressFn)
 			uManifest, warnings := writer.State.Manifest, writer.State.Warnings
 			
 			if len(warnings) > 0 {
@@ -85,6 +90,10 @@ func generateManifestUpdateCmd() *cobra.Command {
 			processErrors(errs)
 
 			m.OverwriteGames(uManifest.Games)
+			if policyFile != "" {
+				//Games that were not updated get narrowed down by the new policy as well
+				m.TrimFilesFromPolicy()
+			}
 
 			duplicates := m.Finalize()
 			if len(duplicates) > 0 {
@@ -108,5 +117,6 @@ func generateManifestUpdateCmd() *cobra.Command {
 	manifestUpdateCmd.Flags().StringVarP(&warningFile, "warning-file", "w", "manifest-update-warnings.json", "Warnings from files whose download url return 404 will be listed in this file. Will only be generated if tolerate-dangles is set to true")
 	manifestUpdateCmd.Flags().StringVarP(&duplicatesFile, "duplicates-file", "l", "manifest-update-duplicates.json", "Files that had duplicate filenames within the same game and had to be renamed will be listed in this file")
 	manifestUpdateCmd.Flags().BoolVarP(&tolerateBadFileMetadata, "tolerate-bad-metadata", "b", true, "Tolerate files for which metadata cannot be retrieved. The checksum will be infered by performing a throwaway file download instead.")
+	manifestUpdateCmd.Flags().StringVarP(&policyFile, "policy", "", "", "Optional policy file replacing the rules of the manifest that override the oses, languages and kinds of files to include for specific games")
 	return manifestUpdateCmd
 }
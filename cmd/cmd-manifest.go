@@ -20,10 +20,12 @@ func generateManifestCmd() *cobra.Command {
 	manifestCmd.AddCommand(generateManifestUpdateCmd())
 	manifestCmd.AddCommand(generateManifestUpdateResumeCmd())
 	manifestCmd.AddCommand(generateManifestDiffCmd())
+	manifestCmd.AddCommand(generateManifestReportCmd())
 	manifestCmd.AddCommand(generateManifestMigrateCmd())
 	manifestCmd.AddCommand(generateManifestTrimLanguagesCmd())
 	manifestCmd.AddCommand(generateManifestTrimPatchesCmd())
 	manifestCmd.AddCommand(generateManifestMissingGamesCmd())
+	manifestCmd.AddCommand(generateManifestImportCmd())
 
 	return manifestCmd
 }
@@ -38,6 +38,7 @@ func generateMetadataGenerateResumeCmd() *cobra.Command {
 			}
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			progressFn := PersistMetadataProgress(progressFile)
 			writer := metadata.NewMetadataGamesWriter(
 				*s,
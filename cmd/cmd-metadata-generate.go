@@ -24,6 +24,7 @@ func generateMetadataGenerateCmd() *cobra.Command {
 			CleanupFile(warningFile)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			f := metadata.NewMetadataFilter(
 				gameTitleFilters,
 			)
@@ -55,6 +55,7 @@ func generateMetadataUpdateCmd() *cobra.Command {
 			CleanupFile(warningFile)
 		},
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			ids := gameIds
 			if u != nil {
 				ids = append(ids, (*u).NewGames...)
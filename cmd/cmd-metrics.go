@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"errors"
+	"gogcli/storage"
+
+	"github.com/spf13/cobra"
+)
+
+func generateMetricsCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var address string
+
+	metricsCmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Serve prometheus metrics about the manifest, pending actions and validations of a storage",
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+			collect := func() error {
+				return storage.CollectMetrics(gamesStorage)
+			}
+
+			if metricsAddress != "" {
+				processError(errors.New("The --metrics-address flag cannot be used with the metrics command. Use --address instead"))
+			}
+
+			serveMetrics(address, collect)
+			select {}
+		},
+	}
+
+	metricsCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	metricsCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	metricsCmd.Flags().StringVarP(&address, "address", "a", "127.0.0.1:9101", "Address the metrics should be served on")
+
+	return metricsCmd
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"fmt"
+	"gogcli/config"
 	"gogcli/logging"
 	"gogcli/sdk"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -14,6 +16,17 @@ var cookieFile string
 var cookieFileType string
 var sdkPtr *sdk.Sdk
 var logSource *logging.Source
+var metricsAddress string
+var configFile string
+var profile string
+var configPtr = config.NewEmptyConfig()
+var sdkRetries int64
+var sdkRetryPause time.Duration
+var writeBackCookie bool
+var sessionRefreshInterval time.Duration
+var skipSessionCheck bool
+var downloadConnections int
+var segmentedDownloadMinSize int64
 
 var rootCmd = &cobra.Command{
 	Use:   "gogcli",
@@ -21,29 +34,57 @@ var rootCmd = &cobra.Command{
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
 		var err error
 		logSource = logging.CreateSource(logLevel)
-		cookies, err := sdk.ReadCookie(cookieFile, cookieFileType)
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+		if len(accounts) > 0 {
+			accountNames, accountSdks, err = loadAccounts(accounts)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
+			sdkPtr = accountSdks[accountNames[0]]
+		} else {
+			sdkPtr, err = createSdk(cookieFile)
+			if err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
 		}
 
-		sdkPtr = sdk.NewSdk(cookies, logSource)
+		if metricsAddress != "" {
+			serveMetrics(metricsAddress)
+		}
 	},
 }
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&cookieFile, "cookiefile", "c", "cookie", "Path were to read the user provided cookie file")
 	rootCmd.MarkPersistentFlagFilename("cookiefile")
+	rootCmd.PersistentFlags().StringArrayVar(&accounts, "account", []string{}, "Account of a library spanning several gog accounts, in the form <name>=<cookie file>. Can be repeated, in order of download preference. If set, the cookiefile flag is ignored")
 	rootCmd.PersistentFlags().StringVarP(&cookieFileType, "cookiefile-type", "y", "default", "The type of cookie file. Can either be 'default', 'string', 'firefox' or 'netscape'")
 	rootCmd.PersistentFlags().StringVarP(&logLevel, "log-level", "g", "info", "Logs below this level of significance won't be displayed. Possible values are: debug, info and warning")
+	rootCmd.PersistentFlags().BoolVar(&writeBackCookie, "write-back-cookie", false, "If set to true, cookie values refreshed by gog during the run will be written back to the cookie file in its original format")
+	rootCmd.PersistentFlags().DurationVar(&sessionRefreshInterval, "session-refresh-interval", 0, "If greater than 0, the gog session will be refreshed through gog's token endpoint at this interval (ex: 30m) during long runs")
+	rootCmd.PersistentFlags().BoolVar(&skipSessionCheck, "skip-session-check", false, "If set to true, commands making many requests to the gog api will not verify that the cookie is still valid before starting")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Path of the configuration file. Defaults to gogcli/config.yaml in the user's configuration directory ($XDG_CONFIG_HOME on linux)")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "Profile of the configuration file to take flag values from. Defaults to the GOGCLI_PROFILE environment variable or the default profile of the configuration file")
+	rootCmd.PersistentFlags().Int64Var(&sdkRetries, "sdk-retries", 5, "How many times a failed request to the gog api should be retried")
+	rootCmd.PersistentFlags().DurationVar(&sdkRetryPause, "sdk-retry-pause", 100*time.Millisecond, "How long to wait before retrying a failed request to the gog api")
+	rootCmd.PersistentFlags().IntVar(&downloadConnections, "download-connections", 1, "If greater than 1, large files will be downloaded in ranged segments over this many concurrent connections")
+	rootCmd.PersistentFlags().Int64Var(&segmentedDownloadMinSize, "segmented-download-min-size", 1024, "Minimum size in MiB of files that are downloaded over several connections when --download-connections is greater than 1")
+	rootCmd.PersistentFlags().StringVar(&metricsAddress, "metrics-address", "", "If set, prometheus metrics will be served on the /metrics path of this address while the command runs")
 
 	rootCmd.AddCommand(generateUpdateCmd())
 	rootCmd.AddCommand(generateGogApiCmd())
 	rootCmd.AddCommand(generateManifestCmd())
 	rootCmd.AddCommand(generateMetadataCmd())
 	rootCmd.AddCommand(generateStorageCmd())
+	rootCmd.AddCommand(generateInstallCmd())
 	rootCmd.AddCommand(generateVersionCmd())
 	rootCmd.AddCommand(generateActionsCmd())
+	rootCmd.AddCommand(generateCatalogueCmd())
+	rootCmd.AddCommand(generateServeApiCmd())
+	rootCmd.AddCommand(generateMetricsCmd())
+
+	addConfigHooks(rootCmd)
 }
 
 func Execute() error {
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"errors"
+	"gogcli/api"
+	"gogcli/logging"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateServeApiCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var address string
+	var token string
+
+	serveApiCmd := &cobra.Command{
+		Use:   "serve-api",
+		Short: "Serve a http api to query the manifest and status of a storage and to run jobs on it",
+		Run: func(cmd *cobra.Command, args []string) {
+			if token == "" {
+				token = os.Getenv("GOGCLI_API_TOKEN")
+			}
+			if token == "" {
+				processError(errors.New("A token must be provided either with the --token flag or the GOGCLI_API_TOKEN environment variable"))
+			}
+
+			getJobStorage := func(jobLogSource *logging.Source) storage.Storage {
+				gamesStorage, _ := getStorage(path, storageType, jobLogSource, "")
+				return gamesStorage
+			}
+			server := api.NewServer(getJobStorage, getSourceDownloader, token, logLevel, logSource)
+			processError(server.ListenAndServe(address))
+		},
+	}
+
+	serveApiCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	serveApiCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	serveApiCmd.Flags().StringVarP(&address, "address", "a", "127.0.0.1:8080", "Address the api should listen on")
+	serveApiCmd.Flags().StringVarP(&token, "token", "o", "", "Token clients must provide as a bearer token to use the api. Defaults to the GOGCLI_API_TOKEN environment variable")
+
+	return serveApiCmd
+}
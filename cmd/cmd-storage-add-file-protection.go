@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"gogcli/manifest"
+	"gogcli/storage"
 
 	"github.com/spf13/cobra"
 )
@@ -19,6 +20,7 @@ func generateStorageAddFileProtectionCmd() *cobra.Command {
 		Short: "Protect a file in your manifest against deletion",
 		Run: func(cmd *cobra.Command, args []string) {
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			exists, err := gamesStorage.Exists()
 			processError(err)
@@ -49,7 +51,7 @@ func generateStorageAddFileProtectionCmd() *cobra.Command {
 	}
 
 	storageAddFileProtectionCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to the directory where game files should be stored")
-	storageAddFileProtectionCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageAddFileProtectionCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 	storageAddFileProtectionCmd.Flags().StringVarP(&FileType, "file-type", "t", "installer", "Type of the file to protect. Can be 'installer' or 'extra'")
 	storageAddFileProtectionCmd.Flags().StringVarP(&FileName, "file-name", "n", "", "Name of the file to protect")
 	storageAddFileProtectionCmd.MarkFlagRequired("file-name")
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageAdoptCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var from string
+	var layout string
+	var link bool
+	var reportFile string
+
+	storageAdoptCmd := &cobra.Command{
+		Use:   "adopt",
+		Short: "Move the files downloaded by gogrepo or lgogdownloader into the storage, after applying the manifest imported from them to it",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			if !storage.IsAdoptLayout(layout) {
+				fmt.Println("Layout must be either 'gogrepo' or 'lgogdownloader'")
+				os.Exit(1)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+
+			report, err := storage.AdoptFiles(gamesStorage, from, layout, link)
+			if report != nil {
+				processSerializableOutput(report, []error{}, false, reportFile)
+			}
+			processError(err)
+		},
+	}
+
+	storageAdoptCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite)")
+	storageAdoptCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 'sqlite' (for file system with a sqlite index)")
+	storageAdoptCmd.Flags().StringVarP(&from, "from", "m", "", "Directory where gogrepo or lgogdownloader downloaded the games")
+	storageAdoptCmd.MarkFlagRequired("from")
+	storageAdoptCmd.Flags().StringVarP(&layout, "layout", "l", "gogrepo", "Directory layout of the downloaded games. Can be 'gogrepo' or 'lgogdownloader'")
+	storageAdoptCmd.Flags().BoolVarP(&link, "link", "", false, "If set to true, files are hard linked into the storage instead of moved, leaving the directory of the other downloader intact. Files on another file system are copied instead")
+	storageAdoptCmd.Flags().StringVarP(&reportFile, "report-file", "f", "storage-adopt.json", "File to output the adopted and mismatched files in")
+
+	return storageAdoptCmd
+}
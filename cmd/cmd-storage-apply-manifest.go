@@ -28,6 +28,7 @@ func generateStorageApplyManifestCmd() *cobra.Command {
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			err := storage.EnsureInitialization(gamesStorage)
 			processError(err)
@@ -62,7 +63,7 @@ func generateStorageApplyManifestCmd() *cobra.Command {
 	storageApplyManifestCmd.Flags().StringVarP(&manifestPath, "manifest", "m", "manifest.json", "Path were the manifest you want to apply is")
 	storageApplyManifestCmd.MarkFlagFilename("manifest")
 	storageApplyManifestCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to the directory where game files should be stored")
-	storageApplyManifestCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageApplyManifestCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 	storageApplyManifestCmd.Flags().BoolVarP(&allowEmptyCheckum, "empty-checksum", "s", false, "If set to true, manifest files with empty checksums will count as already uploaded if everything else matches")
 	storageApplyManifestCmd.Flags().BoolVarP(&allowGameDeletions, "allow-game-deletions", "d", false, "If set to true, an actions file that contain game deletion actions will be allowed, otherwise the command will abort if this would be the result")
 	storageApplyManifestCmd.Flags().BoolVarP(&useStorageFilter, "storage-filter", "l", false, "If set to true, applies the filter of the manifest in storage to current manifest before doing the apply")
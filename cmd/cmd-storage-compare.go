@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageCompareCmd() *cobra.Command {
+	var concurrency int
+	var aPath string
+	var bPath string
+	var aStorage string
+	var bStorage string
+	var verifyChecksum bool
+	var reportFile string
+	var terminalOutput bool
+	var actionsFile string
+	var apply bool
+
+	storageCompareCmd := &cobra.Command{
+		Use:   "compare",
+		Short: "Compare the manifests and game files of two storages and report where storage b diverges from storage a",
+		Run: func(cmd *cobra.Command, args []string) {
+			a, _ := getStorage(aPath, aStorage, logSource, "a")
+			defer storage.CloseStorage(a)
+			b, _ := getStorage(bPath, bStorage, logSource, "b")
+			defer storage.CloseStorage(b)
+
+			report, actions, errs := storage.Compare(a, b, concurrency, verifyChecksum)
+			if report == nil {
+				processErrors(errs)
+			}
+
+			if actionsFile != "" {
+				processSerializableOutput(actions, []error{}, false, actionsFile)
+			}
+			if apply {
+				processError(storage.ApplyComparison(a, b, actions))
+			}
+
+			processSerializableOutput(report, []error{}, terminalOutput, reportFile)
+			processErrors(errs)
+			if report.HasDivergences() {
+				if !terminalOutput {
+					fmt.Println(fmt.Sprintf("Storages diverge. See %s for details.", reportFile))
+				}
+				os.Exit(1)
+			}
+		},
+	}
+
+	storageCompareCmd.Flags().IntVarP(&concurrency, "concurrency", "r", 4, "Number of files that should be hashed at the same time")
+	storageCompareCmd.Flags().StringVarP(&aPath, "a-path", "", "games", "Path to the reference storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageCompareCmd.Flags().StringVarP(&aStorage, "a", "", "fs", "Kind of the reference storage. Can be 'fs' (for file system), 's3' (for s3 store), 'sqlite' (for file system with a sqlite index) or the name of a storage in the configuration file")
+	storageCompareCmd.Flags().StringVarP(&bPath, "b-path", "", "games-copy", "Path to the storage compared against the reference (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageCompareCmd.Flags().StringVarP(&bStorage, "b", "", "fs", "Kind of the storage compared against the reference. Can be 'fs' (for file system), 's3' (for s3 store), 'sqlite' (for file system with a sqlite index) or the name of a storage in the configuration file")
+	storageCompareCmd.Flags().BoolVarP(&verifyChecksum, "verify-checksum", "v", true, "If set to true, the files of storages that are not self validating will be hashed and compared against the checksums of the reference manifest")
+	storageCompareCmd.Flags().StringVarP(&reportFile, "report-file", "f", "storage-comparison.json", "File to output the divergence report in")
+	storageCompareCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the divergence report will be output on the terminal instead of in a file")
+	storageCompareCmd.Flags().StringVarP(&actionsFile, "actions-file", "o", "", "If set, the actions bringing storage b in line with storage a will be output in this file")
+	storageCompareCmd.Flags().BoolVarP(&apply, "apply", "", false, "If set to true, the manifest of storage a and the actions bringing storage b in line with it will be stored in storage b, to be run with 'storage execute-actions'")
+
+	return storageCompareCmd
+}
@@ -18,13 +18,24 @@ func generateStorageCopyCmd() *cobra.Command {
 	var sortCriterion string
 	var sortAscending bool
 	var downloadRetries int
+	var from string
+	var to string
 
 	storageCopyCmd := &cobra.Command{
 		Use:   "copy",
 		Short: "Copy the game files from one storage to another",
 		Run: func(cmd *cobra.Command, args []string) {
+			if from != "" {
+				sourceStorage = from
+			}
+			if to != "" {
+				destinationStorage = to
+			}
+
 			source, downloader := getStorage(sourcePath, sourceStorage, logSource, "source")
+			defer storage.CloseStorage(source)
 			destination, _ := getStorage(destinationPath, destinationStorage, logSource, "destination")
+			defer storage.CloseStorage(destination)
 
 			sort := manifest.NewActionIteratorSort(preferredGameIds, sortCriterion, sortAscending)
 			proc := storage.GetActionsProcessor(concurrency, downloadRetries, gamesMax, sort, logSource)
@@ -34,10 +45,12 @@ func generateStorageCopyCmd() *cobra.Command {
 	}
 
 	storageCopyCmd.Flags().IntVarP(&concurrency, "concurrency", "r", 4, "Number of downloads that should be attempted at the same time")
-	storageCopyCmd.Flags().StringVarP(&sourcePath, "source-path", "s", "games", "Path to the source of your games (directory if it is of type fs, json configuration file if it is of type s3)")
-	storageCopyCmd.Flags().StringVarP(&sourceStorage, "source-storage", "t", "fs", "Kind of storage your source is. Can be 'fs' (for file system) or 's3' (for s3 store)")
-	storageCopyCmd.Flags().StringVarP(&destinationPath, "destination-path", "n", "games-copy", "Path to the destination of your games (directory if it is of type fs, json configuration file if it is of type s3)")
-	storageCopyCmd.Flags().StringVarP(&destinationStorage, "destination-storage", "o", "fs", "Kind of storage your destination is. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageCopyCmd.Flags().StringVarP(&sourcePath, "source-path", "s", "games", "Path to the source of your games (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageCopyCmd.Flags().StringVarP(&sourceStorage, "source-storage", "t", "fs", "Kind of storage your source is. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	storageCopyCmd.Flags().StringVarP(&destinationPath, "destination-path", "n", "games-copy", "Path to the destination of your games (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageCopyCmd.Flags().StringVarP(&destinationStorage, "destination-storage", "o", "fs", "Kind of storage your destination is. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	storageCopyCmd.Flags().StringVar(&from, "from", "", "Name of the source storage in the configuration file. Shorthand for --source-storage")
+	storageCopyCmd.Flags().StringVar(&to, "to", "", "Name of the destination storage in the configuration file. Shorthand for --destination-storage")
 	storageCopyCmd.Flags().IntVarP(&gamesMax, "maximum", "x", -1, "The maximum number of games to copy into storage.")
 	storageCopyCmd.Flags().Int64SliceVarP(&preferredGameIds, "preferred-ids", "f", []int64{}, "Ids of games to download first")
 	storageCopyCmd.Flags().StringVarP(&sortCriterion, "sort-criterion", "i", "none", "Criteria to sort games download order by. Can be: id, title, size, none")
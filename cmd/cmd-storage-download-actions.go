@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"gogcli/storage"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +20,7 @@ func generateStorageDownloadActionsCmd() *cobra.Command {
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			exists, err := gamesStorage.Exists()
 			processError(err)
@@ -40,8 +42,8 @@ func generateStorageDownloadActionsCmd() *cobra.Command {
 
 	storageDownloadActionsCmd.Flags().StringVarP(&actionsFile, "actions-file", "f", "actions.json", "File to output the actions in")
 	storageDownloadActionsCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the actions will be output on the terminal instead of in a file")
-	storageDownloadActionsCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs, json configuration file if it is of type s3)")
-	storageDownloadActionsCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageDownloadActionsCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageDownloadActionsCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 
 	return storageDownloadActionsCmd
 }
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"gogcli/storage"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +20,7 @@ func generateStorageDownloadManifestCmd() *cobra.Command {
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			exists, err := gamesStorage.Exists()
 			processError(err)
@@ -40,8 +42,8 @@ func generateStorageDownloadManifestCmd() *cobra.Command {
 
 	storageDownloadManifestCmd.Flags().StringVarP(&manifestFile, "manifest-file", "f", "manifest.json", "File to output the manifest in")
 	storageDownloadManifestCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the manifest will be output on the terminal instead of in a file")
-	storageDownloadManifestCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs, json configuration file if it is of type s3)")
-	storageDownloadManifestCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageDownloadManifestCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageDownloadManifestCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 
 	return storageDownloadManifestCmd
 }
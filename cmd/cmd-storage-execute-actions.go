@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"gogcli/manifest"
-	"gogcli/sdk"
 	"gogcli/storage"
 
 	"github.com/spf13/cobra"
@@ -22,28 +21,13 @@ func generateStorageExecuteActionsCmd() *cobra.Command {
 		Use:   "execute-actions",
 		Short: "Runs any pending actions in the storage",
 		Run: func(cmd *cobra.Command, args []string) {
-			var downloader storage.Downloader
 			gamesStorage, _ := getStorage(path, storageType, logSource, "destination")
+			defer storage.CloseStorage(gamesStorage)
 
 			source, err := gamesStorage.LoadSource()
-			if err != nil {
-				processError(err)
-			}
-			if source.Type == "gog" {
-				downloader = sdk.Downloader{sdkPtr}
-			} else if source.Type == "fs" {
-				fs, sourceErr := storage.GetFileSystemFromSource(*source, logSource, "source")
-				if sourceErr != nil {
-					processError(sourceErr)
-				}
-				downloader = storage.FileSystemDownloader{fs}
-			} else {
-				s3, sourceErr := storage.GetS3StoreFromSource(*source, logSource, "source")
-				if sourceErr != nil {
-					processError(sourceErr)
-				}
-				downloader = storage.S3StoreDownloader{s3}
-			}
+			processError(err)
+			downloader, err := getSourceDownloader(*source, logSource)
+			processError(err)
 
 			sort := manifest.NewActionIteratorSort(preferredGameIds, sortCriterion, sortAscending)
 			proc := storage.GetActionsProcessor(concurrency, downloadRetries, gamesMax, sort, logSource)
@@ -52,8 +36,8 @@ func generateStorageExecuteActionsCmd() *cobra.Command {
 		},
 	}
 
-	storageExecuteActionsCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs, json configuration file if it is of type s3)")
-	storageExecuteActionsCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageExecuteActionsCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageExecuteActionsCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 	storageExecuteActionsCmd.Flags().IntVarP(&gamesMax, "maximum", "x", -1, "The maximum number of games to upload into storage.")
 	storageExecuteActionsCmd.Flags().IntVarP(&concurrency, "concurrency", "r", 4, "Number of downloads that should be attempted at the same time")
 	storageExecuteActionsCmd.Flags().Int64SliceVarP(&preferredGameIds, "preferred-ids", "f", []int64{}, "Ids of games to download first")
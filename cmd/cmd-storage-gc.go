@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageGcCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var reportFile string
+	var terminalOutput bool
+	var apply bool
+	var trashPath string
+	var undoFile string
+	var undo bool
+
+	storageGcCmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Report the games and files of the storage that are not accounted for by its manifest and optionally move them to a trash",
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+
+			if undo {
+				undoList, err := storage.LoadGcUndoList(undoFile)
+				processError(err)
+				errs := storage.UndoGarbageCollection(gamesStorage, undoList, logSource)
+				processError(storage.StoreGcUndoList(undoList, undoFile))
+				processErrors(errs)
+				return
+			}
+
+			report, err := storage.FindOrphans(gamesStorage)
+			processError(err)
+			processSerializableOutput(report, []error{}, terminalOutput, reportFile)
+
+			if !apply {
+				return
+			}
+
+			prevUndoList := &storage.GcUndoList{TrashPath: trashPath}
+			if _, statErr := os.Stat(undoFile); statErr == nil {
+				prevUndoList, err = storage.LoadGcUndoList(undoFile)
+				processError(err)
+				if (*prevUndoList).TrashPath != trashPath {
+					msg := fmt.Sprintf("The undo file %s refers to the trash at %s. Undo the previous garbage collection or use a different undo file.", undoFile, (*prevUndoList).TrashPath)
+					processError(errors.New(msg))
+				}
+			}
+
+			undoList, errs := storage.CollectGarbage(gamesStorage, report, trashPath, logSource)
+			undoList.Games = append((*prevUndoList).Games, undoList.Games...)
+			undoList.Files = append((*prevUndoList).Files, undoList.Files...)
+			processError(storage.StoreGcUndoList(&undoList, undoFile))
+			processErrors(errs)
+		},
+	}
+
+	storageGcCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageGcCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	storageGcCmd.Flags().StringVarP(&reportFile, "report-file", "f", "storage-gc.json", "File to output the orphaned games and files in")
+	storageGcCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the orphaned games and files will be output on the terminal instead of in a file")
+	storageGcCmd.Flags().BoolVarP(&apply, "apply", "a", false, "If set to true, the orphaned files will be moved to the trash and the orphaned games removed from the storage")
+	storageGcCmd.Flags().StringVarP(&trashPath, "trash-path", "", "storage-gc-trash", "Directory the orphaned files are moved to")
+	storageGcCmd.Flags().StringVarP(&undoFile, "undo-file", "u", "storage-gc-undo.json", "File listing the orphaned games and files that were moved to the trash so that they can be restored")
+	storageGcCmd.Flags().BoolVarP(&undo, "undo", "", false, "If set to true, the games and files listed in the undo file will be moved back from the trash into the storage")
+
+	return storageGcCmd
+}
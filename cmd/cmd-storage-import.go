@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"gogcli/manifest"
+	"gogcli/storage"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageImportCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var from string
+	var concurrency int
+	var downloadRetries int
+	var reportFile string
+	var dryRun bool
+
+	storageImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Upload local files matching the files the pending actions of the storage would download, so that they don't have to be downloaded again",
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+
+			report, err := storage.FindLocalFiles(gamesStorage, from, concurrency)
+			processError(err)
+			processSerializableOutput(report, []error{}, false, reportFile)
+
+			if dryRun || len(report.Matches) == 0 {
+				return
+			}
+
+			sort := manifest.NewActionIteratorSort([]int64{}, "none", true)
+			proc := storage.GetActionsProcessor(concurrency, downloadRetries, -1, sort, logSource)
+			errs := storage.ImportLocalFiles(gamesStorage, report, proc)
+			processErrors(errs)
+		},
+	}
+
+	storageImportCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageImportCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	storageImportCmd.Flags().StringVarP(&from, "from", "m", "", "Directory containing the local files to import, in any layout")
+	storageImportCmd.MarkFlagRequired("from")
+	storageImportCmd.Flags().IntVarP(&concurrency, "concurrency", "r", 4, "Number of files that should be hashed or uploaded at the same time")
+	storageImportCmd.Flags().IntVarP(&downloadRetries, "upload-retries", "d", 2, "How many times to retry a failed upload before giving up")
+	storageImportCmd.Flags().StringVarP(&reportFile, "report-file", "f", "storage-import.json", "File to output the matched and mismatched local files in")
+	storageImportCmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "If set to true, local files will only be matched and reported, not uploaded")
+
+	return storageImportCmd
+}
@@ -21,6 +21,7 @@ func generateStoragePlanCmd() *cobra.Command {
 	var storageType string
 	var allowEmptyCheckum bool
 	var useStorageFilter bool
+	var policyFile string
 
 	show := func(a *manifest.GameActions) {
 		var buf bytes.Buffer
@@ -53,6 +54,7 @@ func generateStoragePlanCmd() *cobra.Command {
 			var actions *manifest.GameActions
 
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			err := storage.EnsureInitialization(gamesStorage)
 			processError(err)
@@ -73,6 +75,13 @@ func generateStoragePlanCmd() *cobra.Command {
 				processError(err)
 			}
 
+			if policyFile != "" {
+				policy, err := loadPolicyFromFile(policyFile)
+				processError(err)
+				m.Filter.PolicyRules = (*policy).Rules
+				m.Trim()
+			}
+
 			actions, err = storage.PlanManifest(&m, gamesStorage, checksumValidation)
 			if err != nil {
 				fmt.Println(err)
@@ -86,10 +95,11 @@ func generateStoragePlanCmd() *cobra.Command {
 	storagePlanCmd.MarkFlagFilename("manifest")
 	storagePlanCmd.Flags().StringVarP(&file, "file", "f", "actions.json", "File to output the plan in")
 	storagePlanCmd.Flags().BoolVarP(&terminalOutput, "terminal", "t", false, "If set to true, the plan will be output on the terminal instead of in a file")
-	storagePlanCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs, json configuration file if it is of type s3)")
-	storagePlanCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storagePlanCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storagePlanCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 	storagePlanCmd.Flags().BoolVarP(&allowEmptyCheckum, "empty-checksum", "s", false, "If set to true, manifest files with empty checksums will count as already uploaded if everything else matches")
 	storagePlanCmd.Flags().BoolVarP(&useStorageFilter, "storage-filter", "l", false, "If set to true, applies the filter of the manifest in storage to current manifest before doing the plan")
 
+	storagePlanCmd.Flags().StringVarP(&policyFile, "policy", "", "", "Optional policy file with rules narrowing down the oses, languages and kinds of files of specific games before doing the plan")
 	return storagePlanCmd
 }
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageRelayoutCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var gameDirectories string
+	var fileDirectories string
+
+	storageRelayoutCmd := &cobra.Command{
+		Use:   "relayout",
+		Short: "Change how the directories of a file system storage are named and move its games and files in place accordingly",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_, err := storage.NewFileSystemLayout(gameDirectories, fileDirectories)
+			if err != nil {
+				fmt.Println("Games must be either 'id', 'slug' or 'title' and files either 'flat' or 'os-language'")
+				os.Exit(1)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
+			fs, ok := storage.GetStorageFileSystem(gamesStorage)
+			if !ok {
+				fmt.Println("Only fs and sqlite storages have a directory layout")
+				os.Exit(1)
+			}
+
+			errs := storage.RelayoutFileSystem(fs, gameDirectories, fileDirectories)
+			processErrors(errs)
+		},
+	}
+
+	storageRelayoutCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite)")
+	storageRelayoutCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 'sqlite' (for file system with a sqlite index)")
+	storageRelayoutCmd.Flags().StringVarP(&gameDirectories, "games", "", storage.GameDirectoriesId, "How game directories are named. Can be 'id', 'slug' or 'title' for '<title> (<id>)'")
+	storageRelayoutCmd.Flags().StringVarP(&fileDirectories, "files", "", storage.FileDirectoriesFlat, "How installers are sorted. Can be 'flat' or 'os-language' for sub-directories by os and language")
+
+	return storageRelayoutCmd
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"gogcli/manifest"
+	"gogcli/storage"
 
 	"github.com/spf13/cobra"
 )
@@ -19,6 +20,7 @@ func generateStorageRemoveFileProtectionCmd() *cobra.Command {
 		Short: "Remove protection a file in your manifest has against deletion",
 		Run: func(cmd *cobra.Command, args []string) {
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			exists, err := gamesStorage.Exists()
 			processError(err)
@@ -49,7 +51,7 @@ func generateStorageRemoveFileProtectionCmd() *cobra.Command {
 	}
 
 	storageRemoveFileProtectionCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to the directory where game files should be stored")
-	storageRemoveFileProtectionCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageRemoveFileProtectionCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 	storageRemoveFileProtectionCmd.Flags().StringVarP(&FileType, "file-type", "t", "installer", "Type of the file to remove protection from. Can be 'installer' or 'extra'")
 	storageRemoveFileProtectionCmd.Flags().StringVarP(&FileName, "file-name", "n", "", "Name of the file to remove protection from")
 	storageRemoveFileProtectionCmd.MarkFlagRequired("file-name")
@@ -34,6 +34,7 @@ func generateStorageRepairResumeCmd() *cobra.Command {
 			}
 
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 			storageManifest, err := gamesStorage.LoadManifest()
 			processError(err)
 			authMan = (*storageManifest)
@@ -47,6 +48,7 @@ func generateStorageRepairResumeCmd() *cobra.Command {
 		},
 		Run: func(cmd *cobra.Command, args []string) {
 			gamesStorage, _ := getStorage(path, storageType, logSource, "")
+			defer storage.CloseStorage(gamesStorage)
 
 			progressFn := PersistManifestProgress(progressFile)
 
@@ -69,8 +71,8 @@ func generateStorageRepairResumeCmd() *cobra.Command {
 	storageRepairResumeCmd.Flags().BoolVarP(&useFileManifest, "file-manifest", "f", false, "If set to true, a specified manifest file will be used to repair the storage, otherwise the storage's manifest will be used")
 	storageRepairResumeCmd.Flags().StringVarP(&manifestPath, "manifest", "m", "manifest.json", "Manifest file that you want to use to repair the storage")
 	storageRepairResumeCmd.MarkFlagFilename("manifest")
-	storageRepairResumeCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs, json configuration file if it is of type s3)")
-	storageRepairResumeCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system) or 's3' (for s3 store)")
+	storageRepairResumeCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageRepairResumeCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
 	storageRepairResumeCmd.Flags().IntVarP(&concurrency, "concurrency", "r", 4, "Number of manifest games that should be processed at the same time")
     storageRepairResumeCmd.Flags().BoolVarP(&verifyChecksum, "verify-checksum", "v", false, "If set to true, checksum comparison of files against the manifest checksum value will be performed")
 	storageRepairResumeCmd.Flags().StringVarP(&progressFile, "progress-file", "z", "storage-repair-progress.json", "File containing transient progress of interrupted storage repair to resume")
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"gogcli/logging"
+	"gogcli/storage"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageRestoreCmd() *cobra.Command {
+	var path string
+	var storageType string
+	var selection storage.RestoreSelection
+	var dest string
+	var concurrency int
+	var reportFile string
+
+	storageRestoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Download the files of some games from the storage to a local directory",
+		Run: func(cmd *cobra.Command, args []string) {
+			//Logs and errors would be mixed with the content of the files otherwise
+			storageLogSource := logSource
+			processRestoreError := processError
+			if dest == "-" {
+				storageLogSource = logging.CreateRedirectedSource(logLevel, os.Stderr)
+				processRestoreError = processErrorOnStderr
+			}
+
+			gamesStorage, downloader := getStorage(path, storageType, storageLogSource, "")
+			defer storage.CloseStorage(gamesStorage)
+			processRestoreError(storage.RecoverJournal(gamesStorage))
+			m, err := gamesStorage.LoadManifest()
+			processRestoreError(err)
+
+			files, err := storage.SelectRestoreFiles(m, selection)
+			processRestoreError(err)
+
+			if dest == "-" {
+				processRestoreError(storage.RestoreFilesToWriter(downloader, files, os.Stdout))
+				return
+			}
+
+			report, errs := storage.RestoreFiles(downloader, files, dest, concurrency)
+			processSerializableOutput(report, []error{}, false, reportFile)
+			processErrors(errs)
+		},
+	}
+
+	storageRestoreCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to your games' storage (directory if it is of type fs or sqlite, json configuration file if it is of type s3)")
+	storageRestoreCmd.Flags().StringVarP(&storageType, "storage", "k", "fs", "The type of storage you are using. Can be 'fs' (for file system), 's3' (for s3 store) or 'sqlite' (for file system with a sqlite index)")
+	storageRestoreCmd.Flags().StringArrayVarP(&selection.Games, "game", "", []string{}, "Id, slug or title of a game to restore. Can be repeated")
+	storageRestoreCmd.MarkFlagRequired("game")
+	storageRestoreCmd.Flags().StringArrayVarP(&selection.Oses, "os", "o", []string{}, "If you want to restore only the installers of specific oses. Valid values: windows, mac, linux")
+	storageRestoreCmd.Flags().StringArrayVarP(&selection.Languages, "lang", "l", []string{}, "If you want to restore only the installers of specific languages")
+	storageRestoreCmd.Flags().BoolVarP(&selection.Installers, "installers", "n", true, "Whether to restore installers")
+	storageRestoreCmd.Flags().BoolVarP(&selection.Extras, "extras", "e", true, "Whether to restore extras")
+	storageRestoreCmd.Flags().BoolVarP(&selection.Depots, "depots", "", false, "Whether to restore galaxy depots")
+	storageRestoreCmd.Flags().StringVarP(&dest, "dest", "d", ".", "Directory to restore the files in, under a directory for each game. If set to '-', the files are written one after the other on the standard output instead")
+	storageRestoreCmd.Flags().IntVarP(&concurrency, "concurrency", "r", 4, "Number of files that should be restored at the same time")
+	storageRestoreCmd.Flags().StringVarP(&reportFile, "report-file", "f", "storage-restore.json", "File to output the restored and skipped files in")
+
+	return storageRestoreCmd
+}
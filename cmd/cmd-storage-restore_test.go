@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"gogcli/storage"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//The command exits on errors, so it is run in a sub-process of the test binary
+func TestStorageRestoreToStdoutError(t *testing.T) {
+	if path := os.Getenv("GOGCLI_TEST_RESTORE_PATH"); path != "" {
+		logLevel = "error"
+		logSource = logging.CreateSource(logLevel)
+		restoreCmd := generateStorageRestoreCmd()
+		restoreCmd.SetArgs([]string{"--path", path, "--game", "missing_game", "--dest", "-"})
+		restoreCmd.Execute()
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "games")
+	s := storage.GetFileSystem(path, logging.CreateSource("error"), "")
+	err := s.Initialize()
+	if err == nil {
+		err = s.StoreManifest(manifest.NewEmptyManifest(manifest.ManifestFilter{Installers: true, Extras: true}))
+	}
+	if err != nil {
+		t.Fatalf("Could not create the storage: %s", err.Error())
+	}
+
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	restore := exec.Command(os.Args[0], "-test.run=^TestStorageRestoreToStdoutError$")
+	restore.Env = append(os.Environ(), "GOGCLI_TEST_RESTORE_PATH="+path)
+	restore.Stdout = &stdout
+	restore.Stderr = &stderr
+	err = restore.Run()
+
+	if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+		t.Errorf("Expected the restore to exit with an error and got %v", err)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("Expected nothing but the files to be written on the standard output and got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "missing_game") {
+		t.Errorf("Expected the error to be written on the standard error and got %q", stderr.String())
+	}
+}
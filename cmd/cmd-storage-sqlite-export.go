@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"gogcli/storage"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageSqliteExportCmd() *cobra.Command {
+	var path string
+
+	storageSqliteExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the manifest, metadata and actions of a sqlite store as json files in the same directory",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			callPersistentPreRun(cmd, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			sq := storage.GetSqliteStore(path, logSource, "")
+			defer sq.Close()
+			fs := storage.GetFileSystem(path, logSource, "")
+			processError(storage.CopyIndex(sq, fs))
+		},
+	}
+
+	storageSqliteExportCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to the directory of your games' storage")
+
+	return storageSqliteExportCmd
+}
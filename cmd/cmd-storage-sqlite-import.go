@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"gogcli/storage"
+
+	"github.com/spf13/cobra"
+)
+
+func generateStorageSqliteImportCmd() *cobra.Command {
+	var path string
+
+	storageSqliteImportCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import the json manifest, metadata and actions of a file system storage in a sqlite database in the same directory",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			callPersistentPreRun(cmd, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			fs := storage.GetFileSystem(path, logSource, "")
+			sq := storage.GetSqliteStore(path, logSource, "")
+			defer sq.Close()
+			processError(storage.CopyIndex(fs, sq))
+		},
+	}
+
+	storageSqliteImportCmd.Flags().StringVarP(&path, "path", "p", "games", "Path to the directory of your games' storage")
+
+	return storageSqliteImportCmd
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func generateStorageSqliteCmd() *cobra.Command {
+	storageSqliteCmd := &cobra.Command{
+		Use:   "sqlite",
+		Short: "Commands to convert a file system storage to and from a sqlite store",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			callPersistentPreRun(cmd, args)
+		},
+	}
+
+	storageSqliteCmd.AddCommand(generateStorageSqliteImportCmd())
+	storageSqliteCmd.AddCommand(generateStorageSqliteExportCmd())
+
+	return storageSqliteCmd
+}
@@ -17,12 +17,19 @@ func generateStorageCmd() *cobra.Command {
 	storageCmd.AddCommand(generateStorageApplyCmd())
 	storageCmd.AddCommand(generateStorageCopyCmd())
 	storageCmd.AddCommand(generateStorageValidateCmd())
+	storageCmd.AddCommand(generateStorageCompareCmd())
+	storageCmd.AddCommand(generateStorageGcCmd())
+	storageCmd.AddCommand(generateStorageImportCmd())
+	storageCmd.AddCommand(generateStorageAdoptCmd())
+	storageCmd.AddCommand(generateStorageRelayoutCmd())
+	storageCmd.AddCommand(generateStorageRestoreCmd())
 	storageCmd.AddCommand(generateStorageExecuteActionsCmd())
 	storageCmd.AddCommand(generateStorageDownloadCmd())
 	storageCmd.AddCommand(generateStorageRepairCmd())
 	storageCmd.AddCommand(generateStorageRepairResumeCmd())
 	storageCmd.AddCommand(generateStorageAddFileProtectionCmd())
 	storageCmd.AddCommand(generateStorageRemoveFileProtectionCmd())
+	storageCmd.AddCommand(generateStorageSqliteCmd())
 
 	return storageCmd
 }
@@ -35,6 +35,7 @@ func generateUpdateAccumulateCmd() *cobra.Command {
 			}
 		},
         Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			u, errs := sdkPtr.GetUpdates(concurrency, pause)
 			processErrors(errs)
             u.Merge(prev)
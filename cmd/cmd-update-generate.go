@@ -14,6 +14,7 @@ func generateUpdateGenerateCmd() *cobra.Command {
 		Use:   "generate",
 		Short: "Generate update file based on what is new or got updated in GOG.com",
 		Run: func(cmd *cobra.Command, args []string) {
+			processError(checkSession())
 			u, errs := sdkPtr.GetUpdates(concurrency, pause)
 			processErrors(errs)
 			processSerializableOutput(u, []error{}, terminalOutput, updatefile)
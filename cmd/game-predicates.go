@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+//Game exclusions and predicates shared by the commands that build a manifest filter
+type gamePredicateFlags struct {
+	skipTitles         []string
+	skipTags           []string
+	skipIds            []int64
+	maxGameSize        string
+	installersSince    string
+	installersUntil    string
+	minReleaseYear     int
+	maxReleaseYear     int
+	categories         []string
+	minRating          int
+	worksOn            []string
+	hidden             bool
+	inDevelopment      bool
+	galaxyIncompatible bool
+}
+
+func (p *gamePredicateFlags) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVarP(&p.skipTitles, "skip-title", "", []string{}, "If you want to exclude games with title that contain at least one of the given strings")
+	cmd.Flags().StringArrayVarP(&p.skipTags, "skip-tag", "", []string{}, "If you want to exclude games having specific tags")
+	cmd.Flags().Int64SliceVarP(&p.skipIds, "skip-id", "", []int64{}, "If you want to exclude games with specific ids")
+	cmd.Flags().StringVarP(&p.maxGameSize, "max-game-size", "", "", "If you want to exclude games whose files add up to more than the given size (ex: '20 GB')")
+	cmd.Flags().StringVarP(&p.installersSince, "installers-since", "", "", "If you want to include only games with an installer dated on or after the given date (YYYY-MM-DD)")
+	cmd.Flags().StringVarP(&p.installersUntil, "installers-until", "", "", "If you want to include only games with an installer dated on or before the given date (YYYY-MM-DD)")
+	cmd.Flags().IntVarP(&p.minReleaseYear, "min-release-year", "", 0, "If you want to include only games released on the given year or later")
+	cmd.Flags().IntVarP(&p.maxReleaseYear, "max-release-year", "", 0, "If you want to include only games released on the given year or before")
+	cmd.Flags().StringArrayVarP(&p.categories, "category", "", []string{}, "If you want to include only games of specific categories (ex: 'Strategy')")
+	cmd.Flags().IntVarP(&p.minRating, "min-rating", "", 0, "If you want to include only games with at least the given rating, from 0 to 50")
+	cmd.Flags().StringArrayVarP(&p.worksOn, "works-on", "", []string{}, "If you want to include only games that work on at least one of the given oses. Valid values: windows, mac, linux")
+	cmd.Flags().BoolVarP(&p.hidden, "hidden", "", true, "Whether to include games hidden in your library")
+	cmd.Flags().BoolVarP(&p.inDevelopment, "in-development", "", true, "Whether to include games that are still in development")
+	cmd.Flags().BoolVarP(&p.galaxyIncompatible, "galaxy-incompatible", "", true, "Whether to include games that are not compatible with galaxy")
+}
+
+func validatePredicateDate(date string) error {
+	if date == "" {
+		return nil
+	}
+	_, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Installer dates must have the YYYY-MM-DD format: %s", date))
+	}
+	return nil
+}
+
+func (p *gamePredicateFlags) applyTo(f *manifest.ManifestFilter) error {
+	for _, date := range []string{p.installersSince, p.installersUntil} {
+		err := validatePredicateDate(date)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.maxGameSize != "" {
+		size, err := manifest.GetEstimateToBytes(p.maxGameSize)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Max game size must be an amount followed by a unit (ex: '20 GB'): %s", p.maxGameSize))
+		}
+		(*f).MaxGameSize = size
+	}
+
+	(*f).SkipTitles = p.skipTitles
+	(*f).SkipTags = p.skipTags
+	(*f).SkipIds = p.skipIds
+	(*f).InstallersSince = p.installersSince
+	(*f).InstallersUntil = p.installersUntil
+	(*f).MinReleaseYear = p.minReleaseYear
+	(*f).MaxReleaseYear = p.maxReleaseYear
+	(*f).Categories = p.categories
+	(*f).MinRating = p.minRating
+	(*f).WorksOn = p.worksOn
+	(*f).SkipHidden = !p.hidden
+	(*f).SkipInDevelopment = !p.inDevelopment
+	(*f).SkipGalaxyIncompatible = !p.galaxyIncompatible
+	return nil
+}
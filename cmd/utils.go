@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"gogcli/config"
 	"gogcli/logging"
 	"gogcli/manifest"
 	"gogcli/metadata"
+	"gogcli/metrics"
+	"gogcli/sdk"
 	"gogcli/storage"
 	"io/ioutil"
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func loadActionsFromFile(path string) (manifest.GameActions, error) {
@@ -45,6 +49,15 @@ func loadManifestFromFile(path string) (manifest.Manifest, error) {
 	return m, nil
 }
 
+func loadPolicyFromFile(path string) (*manifest.ManifestPolicy, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.ParseManifestPolicy(bs)
+}
+
 func loadMetadataFromFile(path string) (metadata.Metadata, error) {
 	var m metadata.Metadata
 	bs, err := ioutil.ReadFile(path)
@@ -61,8 +74,13 @@ func loadMetadataFromFile(path string) (metadata.Metadata, error) {
 }
 
 func getStorage(path string, storageType string, logSource *logging.Source, loggerTag string) (storage.Storage, storage.Downloader) {
-	if storageType != "fs" && storageType != "s3" {
-		msg := fmt.Sprintf("Source storage type %s is invalid", storageType)
+	if named, ok := configPtr.GetStorage(storageType); ok {
+		path = named.Path
+		storageType = named.Type
+	}
+
+	if storageType != "fs" && storageType != "s3" && storageType != "sqlite" {
+		msg := fmt.Sprintf("Source storage type %s is invalid. It should be 'fs', 's3', 'sqlite' or the name of a storage in the configuration file", storageType)
 		fmt.Println(msg)
 		os.Exit(1)
 	}
@@ -71,6 +89,10 @@ func getStorage(path string, storageType string, logSource *logging.Source, logg
 		gameStorage := storage.GetFileSystem(path, logSource, loggerTag)
 		downloader := storage.FileSystemDownloader{gameStorage}
 		return gameStorage, downloader
+	} else if storageType == "sqlite" {
+		gameStorage := storage.GetSqliteStore(path, logSource, loggerTag)
+		downloader := storage.FileSystemDownloader{Fs: gameStorage.FileSystem}
+		return gameStorage, downloader
 	} else {
 		gameStorage, err := storage.GetS3StoreFromConfigFile(path, logSource, loggerTag)
 		processError(err)
@@ -79,6 +101,70 @@ func getStorage(path string, storageType string, logSource *logging.Source, logg
 	}
 }
 
+//Fails early when the cookie is stale rather than getting login pages back for every request of a long run
+func checkSession() error {
+	if skipSessionCheck {
+		return nil
+	}
+	if len(accountNames) == 0 {
+		return sdkPtr.CheckSession()
+	}
+
+	for _, name := range accountNames {
+		err := accountSdks[name].CheckSession()
+		if err != nil {
+			return errors.New(fmt.Sprintf("checkSession() -> Session of account %s is not valid: %s", name, err.Error()))
+		}
+	}
+	return nil
+}
+
+func getSourceDownloader(source storage.Source, logSource *logging.Source) (storage.Downloader, error) {
+	if source.Type == "gog" {
+		err := checkSession()
+		if err != nil {
+			return nil, err
+		}
+		if len(accountNames) > 0 {
+			return getAccountsDownloader(), nil
+		}
+		return sdk.Downloader{SdkPtrPtr: sdkPtr}, nil
+	} else if source.Type == "fs" {
+		fs, err := storage.GetFileSystemFromSource(source, logSource, "source")
+		if err != nil {
+			return nil, err
+		}
+		return storage.FileSystemDownloader{Fs: fs}, nil
+	} else if source.Type == "sqlite" {
+		sq, err := storage.GetSqliteStoreFromSource(source, logSource, "source")
+		if err != nil {
+			return nil, err
+		}
+		return storage.FileSystemDownloader{Fs: sq.FileSystem}, nil
+	} else {
+		s3, err := storage.GetS3StoreFromSource(source, logSource, "source")
+		if err != nil {
+			return nil, err
+		}
+		return storage.S3StoreDownloader{S3: s3}, nil
+	}
+}
+
+func serveMetrics(address string, collectors ...metrics.Collector) {
+	validationsFile, err := metrics.GetValidationsFilePath()
+	processError(err)
+	collectors = append(collectors, func() error {
+		return metrics.ImprintValidations(validationsFile)
+	})
+
+	go func() {
+		err := metrics.ListenAndServe(address, metrics.Default, collectors...)
+		if err != nil {
+			fmt.Println("Metrics server stopped: ", err)
+		}
+	}()
+}
+
 func processErrors(errs []error) {
 	if len(errs) > 0 {
 		for _, err := range errs {
@@ -95,6 +181,14 @@ func processError(err error) {
 	}
 }
 
+//For commands writing data on the standard output, where errors would be mixed with it
+func processErrorOnStderr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
 type Errors struct {
 	Errors []string
 }
@@ -198,6 +292,77 @@ func CleanupFile(file string) error {
 	return nil
 }
 
+//Flags that were not passed explicitly take their value from the environment first and the selected profile second
+func applyConfig(cmd *cobra.Command) error {
+	var err error
+	if configFile == "" {
+		configFile = os.Getenv(config.GetEnvName("config"))
+	}
+	if configFile == "" {
+		configFile, err = config.GetDefaultPath()
+		if err != nil {
+			return err
+		}
+	}
+
+	configPtr, err = config.Load(configFile)
+	if err != nil {
+		return err
+	}
+
+	if profile == "" {
+		profile = os.Getenv(config.GetEnvName("profile"))
+	}
+
+	p, err := configPtr.GetProfile(profile)
+	if err != nil {
+		return err
+	}
+	profileValues := p.GetValues()
+
+	var errs []error
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if f.Changed {
+			return
+		}
+
+		if value, ok := os.LookupEnv(config.GetEnvName(f.Name)); ok {
+			setErr := cmd.Flags().Set(f.Name, value)
+			if setErr != nil {
+				errs = append(errs, setErr)
+			}
+			return
+		}
+
+		for _, value := range profileValues[f.Name] {
+			setErr := cmd.Flags().Set(f.Name, value)
+			if setErr != nil {
+				errs = append(errs, setErr)
+			}
+		}
+	})
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+//The configuration is applied on the command being executed, before the persistent pre-run of its parents
+func addConfigHooks(cmd *cobra.Command) {
+	if cmd.HasSubCommands() {
+		for _, subCmd := range cmd.Commands() {
+			addConfigHooks(subCmd)
+		}
+		return
+	}
+
+	cmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		processError(applyConfig(cmd))
+		callPersistentPreRun(cmd, args)
+	}
+}
+
 //https://github.com/spf13/cobra/issues/216#issuecomment-703846787
 func callPersistentPreRun(cmd *cobra.Command, args []string) {
 	parent := cmd.Parent()
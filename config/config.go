@@ -0,0 +1,119 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type StorageConfig struct {
+	Type string `yaml:"type"`
+	Path string `yaml:"path"`
+}
+
+//Profiles map flag names to the values they should take when not passed explicitly
+type Profile map[string]interface{}
+
+type Config struct {
+	DefaultProfile string                   `yaml:"default-profile"`
+	Storages       map[string]StorageConfig `yaml:"storages"`
+	Profiles       map[string]Profile       `yaml:"profiles"`
+}
+
+func NewEmptyConfig() *Config {
+	return &Config{
+		Storages: map[string]StorageConfig{},
+		Profiles: map[string]Profile{},
+	}
+}
+
+func GetDefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gogcli", "config.yaml"), nil
+}
+
+//A missing file results in an empty configuration
+func Load(path string) (*Config, error) {
+	c := NewEmptyConfig()
+
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(bs, c)
+	if err != nil {
+		msg := fmt.Sprintf("Load(path=%s) -> Configuration file is not valid yaml: %s", path, err.Error())
+		return nil, errors.New(msg)
+	}
+
+	if c.Storages == nil {
+		c.Storages = map[string]StorageConfig{}
+	}
+	if c.Profiles == nil {
+		c.Profiles = map[string]Profile{}
+	}
+
+	for name, s := range c.Storages {
+		if s.Type != "fs" && s.Type != "s3" && s.Type != "sqlite" {
+			msg := fmt.Sprintf("Load(path=%s) -> Storage %s has invalid type %s. Can be 'fs', 's3' or 'sqlite'", path, name, s.Type)
+			return nil, errors.New(msg)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Config) GetStorage(name string) (StorageConfig, bool) {
+	s, ok := c.Storages[name]
+	return s, ok
+}
+
+//An empty name selects the default profile if there is one
+func (c *Config) GetProfile(name string) (Profile, error) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+
+	if name == "" {
+		return Profile{}, nil
+	}
+
+	p, ok := c.Profiles[name]
+	if !ok {
+		msg := fmt.Sprintf("GetProfile(name=%s) -> Profile not found in the configuration", name)
+		return nil, errors.New(msg)
+	}
+	return p, nil
+}
+
+//Returns the flag values of the profile, lists yielding one value per element
+func (p Profile) GetValues() map[string][]string {
+	values := make(map[string][]string)
+	for name, value := range p {
+		if list, ok := value.([]interface{}); ok {
+			values[name] = make([]string, len(list))
+			for idx, elem := range list {
+				values[name][idx] = fmt.Sprint(elem)
+			}
+		} else {
+			values[name] = []string{fmt.Sprint(value)}
+		}
+	}
+	return values
+}
+
+func GetEnvName(flagName string) string {
+	return "GOGCLI_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
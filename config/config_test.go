@@ -0,0 +1,75 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gogcli-config")
+	if err != nil {
+		t.Fatalf("Could not create temporary directory: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := Load(filepath.Join(dir, "missing.yaml"))
+	if err != nil || len(c.Storages) != 0 || len(c.Profiles) != 0 {
+		t.Errorf("Missing configuration file should result in an empty configuration")
+	}
+
+	path := filepath.Join(dir, "config.yaml")
+	content := `
+default-profile: home
+storages:
+  nas:
+    type: fs
+    path: /mnt/nas
+profiles:
+  home:
+    concurrency: 8
+    os: [linux, windows]
+`
+	ioutil.WriteFile(path, []byte(content), 0644)
+
+	c, err = Load(path)
+	if err != nil {
+		t.Fatalf("Loading valid configuration returned an error: %s", err.Error())
+	}
+
+	s, ok := c.GetStorage("nas")
+	if (!ok) || s.Type != "fs" || s.Path != "/mnt/nas" {
+		t.Errorf("Storage nas was not loaded as expected: %v", s)
+	}
+
+	p, err := c.GetProfile("")
+	if err != nil {
+		t.Fatalf("Default profile could not be retrieved: %s", err.Error())
+	}
+
+	values := p.GetValues()
+	if len(values["concurrency"]) != 1 || values["concurrency"][0] != "8" {
+		t.Errorf("Scalar profile value %v doesn't match expected: [8]", values["concurrency"])
+	}
+	if len(values["os"]) != 2 || values["os"][0] != "linux" || values["os"][1] != "windows" {
+		t.Errorf("List profile value %v doesn't match expected: [linux windows]", values["os"])
+	}
+
+	_, err = c.GetProfile("missing")
+	if err == nil {
+		t.Errorf("Retrieving a missing profile should return an error")
+	}
+
+	ioutil.WriteFile(path, []byte("storages:\n  nas:\n    type: ftp\n"), 0644)
+	_, err = Load(path)
+	if err == nil {
+		t.Errorf("Loading a storage with an invalid type should return an error")
+	}
+}
+
+func TestGetEnvName(t *testing.T) {
+	if GetEnvName("download-retries") != "GOGCLI_DOWNLOAD_RETRIES" {
+		t.Errorf("Environment variable name %s doesn't match expected: GOGCLI_DOWNLOAD_RETRIES", GetEnvName("download-retries"))
+	}
+}
@@ -0,0 +1,209 @@
+package galaxy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/zlib"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+//Returns a handle on the compressed content of a chunk
+type ChunkGetter func(chunk DepotChunk) (io.ReadCloser, error)
+
+type archiveEntry struct {
+	name       string
+	size       int64
+	executable bool
+}
+
+func getArchiveEntries(d *DepotManifest, raw []byte, format string) []archiveEntry {
+	entries := []archiveEntry{}
+	if format == FormatChunks {
+		entries = append(entries, archiveEntry{name: "manifest.json", size: int64(len(raw))})
+		for _, chunk := range d.GetUniqueChunks() {
+			entries = append(entries, archiveEntry{name: "chunks/" + GetGalaxyPath(chunk.CompressedMd5), size: chunk.CompressedSize})
+		}
+		return entries
+	}
+
+	for _, file := range d.GetFiles() {
+		entries = append(entries, archiveEntry{name: file.Path, size: file.GetSize(), executable: file.IsExecutable()})
+	}
+	return entries
+}
+
+//Headers are fixed so that the same depot always results in the same archive
+func getArchiveHeader(entry archiveEntry) *tar.Header {
+	mode := int64(0644)
+	if entry.executable {
+		mode = 0755
+	}
+	return &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     entry.name,
+		Size:     entry.size,
+		Mode:     mode,
+		ModTime:  time.Unix(0, 0),
+	}
+}
+
+type countingWriter struct {
+	count int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.count += int64(len(p))
+	return len(p), nil
+}
+
+//Computes the exact size of the archive without fetching any chunk so that it can be known before the download
+func GetArchiveSize(d *DepotManifest, raw []byte, format string) (int64, error) {
+	size := int64(1024)
+	for _, entry := range getArchiveEntries(d, raw, format) {
+		counter := countingWriter{}
+		tw := tar.NewWriter(&counter)
+		err := tw.WriteHeader(getArchiveHeader(entry))
+		if err != nil {
+			msg := fmt.Sprintf("GetArchiveSize(...) -> Cannot compute header size of %s: %s", entry.name, err.Error())
+			return 0, errors.New(msg)
+		}
+		size += counter.count + (entry.size+511)/512*512
+	}
+	return size, nil
+}
+
+func getVerifiedChunk(chunk DepotChunk, getChunk ChunkGetter) ([]byte, error) {
+	handle, err := getChunk(chunk)
+	if err != nil {
+		return nil, err
+	}
+	defer handle.Close()
+
+	compressed, err := ioutil.ReadAll(handle)
+	if err != nil {
+		msg := fmt.Sprintf("getVerifiedChunk(chunk=%s) -> Error occured while reading chunk: %s", chunk.CompressedMd5, err.Error())
+		return nil, errors.New(msg)
+	}
+
+	if int64(len(compressed)) != chunk.CompressedSize {
+		msg := fmt.Sprintf("getVerifiedChunk(chunk=%s) -> Chunk has size %d instead of %d", chunk.CompressedMd5, len(compressed), chunk.CompressedSize)
+		return nil, errors.New(msg)
+	}
+
+	sum := md5.Sum(compressed)
+	if hex.EncodeToString(sum[:]) != chunk.CompressedMd5 {
+		msg := fmt.Sprintf("getVerifiedChunk(chunk=%s) -> Chunk checksum does not match", chunk.CompressedMd5)
+		return nil, errors.New(msg)
+	}
+
+	return compressed, nil
+}
+
+func decompressChunk(chunk DepotChunk, compressed []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		msg := fmt.Sprintf("decompressChunk(chunk=%s) -> Chunk is not zlib compressed: %s", chunk.CompressedMd5, err.Error())
+		return nil, errors.New(msg)
+	}
+	defer r.Close()
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		msg := fmt.Sprintf("decompressChunk(chunk=%s) -> Error occured while decompressing chunk: %s", chunk.CompressedMd5, err.Error())
+		return nil, errors.New(msg)
+	}
+
+	if int64(len(content)) != chunk.Size {
+		msg := fmt.Sprintf("decompressChunk(chunk=%s) -> Decompressed chunk has size %d instead of %d", chunk.CompressedMd5, len(content), chunk.Size)
+		return nil, errors.New(msg)
+	}
+
+	sum := md5.Sum(content)
+	if hex.EncodeToString(sum[:]) != chunk.Md5 {
+		msg := fmt.Sprintf("decompressChunk(chunk=%s) -> Decompressed chunk checksum does not match", chunk.CompressedMd5)
+		return nil, errors.New(msg)
+	}
+
+	return content, nil
+}
+
+func writeFileEntry(tw *tar.Writer, file DepotItem, getChunk ChunkGetter) error {
+	err := tw.WriteHeader(getArchiveHeader(archiveEntry{name: file.Path, size: file.GetSize(), executable: file.IsExecutable()}))
+	if err != nil {
+		return err
+	}
+
+	hash := md5.New()
+	for _, chunk := range file.Chunks {
+		compressed, err := getVerifiedChunk(chunk, getChunk)
+		if err != nil {
+			return err
+		}
+
+		content, err := decompressChunk(chunk, compressed)
+		if err != nil {
+			return err
+		}
+
+		hash.Write(content)
+		_, err = tw.Write(content)
+		if err != nil {
+			return err
+		}
+	}
+
+	if file.Md5 != "" && hex.EncodeToString(hash.Sum(nil)) != file.Md5 {
+		msg := fmt.Sprintf("writeFileEntry(file=%s) -> Reassembled file checksum does not match", file.Path)
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+//Writes the depot as a tar archive, either as reassembled files or as its manifest and compressed chunks.
+//Every chunk is verified against the depot manifest as it is written.
+func WriteArchive(w io.Writer, d *DepotManifest, raw []byte, format string, getChunk ChunkGetter) error {
+	tw := tar.NewWriter(w)
+
+	if format == FormatChunks {
+		err := tw.WriteHeader(getArchiveHeader(archiveEntry{name: "manifest.json", size: int64(len(raw))}))
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(raw)
+		if err != nil {
+			return err
+		}
+
+		for _, chunk := range d.GetUniqueChunks() {
+			compressed, err := getVerifiedChunk(chunk, getChunk)
+			if err != nil {
+				return err
+			}
+
+			err = tw.WriteHeader(getArchiveHeader(archiveEntry{name: "chunks/" + GetGalaxyPath(chunk.CompressedMd5), size: chunk.CompressedSize}))
+			if err != nil {
+				return err
+			}
+			_, err = tw.Write(compressed)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		for _, file := range d.GetFiles() {
+			err := writeFileEntry(tw, file, getChunk)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tw.Close()
+}
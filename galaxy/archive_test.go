@@ -0,0 +1,142 @@
+package galaxy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/zlib"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+func md5Hex(content []byte) string {
+	sum := md5.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
+
+//Builds a depot with a multi-chunk file, an executable and a chunk shared between two files
+func getDepotFixture() (DepotManifest, []byte, map[string][]byte) {
+	chunks := make(map[string][]byte)
+	makeChunk := func(content []byte) DepotChunk {
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(content)
+		zw.Close()
+		chunk := DepotChunk{
+			Md5:            md5Hex(content),
+			Size:           int64(len(content)),
+			CompressedMd5:  md5Hex(compressed.Bytes()),
+			CompressedSize: int64(compressed.Len()),
+		}
+		chunks[chunk.CompressedMd5] = compressed.Bytes()
+		return chunk
+	}
+
+	first := makeChunk(bytes.Repeat([]byte("first"), 300))
+	second := makeChunk([]byte("second"))
+	shared := makeChunk([]byte("shared"))
+
+	var d DepotManifest
+	d.Version = 2
+	d.Depot.Items = []DepotItem{
+		DepotItem{Type: "DepotFile", Path: "bin\\game.exe", Chunks: []DepotChunk{shared}, Flags: []string{"executable"}},
+		DepotItem{Type: "DepotDirectory", Path: "data"},
+		DepotItem{Type: "DepotFile", Path: "data\\big.dat", Chunks: []DepotChunk{first, second}, Md5: md5Hex(append(bytes.Repeat([]byte("first"), 300), []byte("second")...))},
+		DepotItem{Type: "DepotFile", Path: "data\\copy.dat", Chunks: []DepotChunk{shared}},
+	}
+
+	return d, []byte(`{"version":2}`), chunks
+}
+
+func getChunkGetter(chunks map[string][]byte) ChunkGetter {
+	return func(chunk DepotChunk) (io.ReadCloser, error) {
+		content, ok := chunks[chunk.CompressedMd5]
+		if !ok {
+			return nil, errors.New("chunk not found")
+		}
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	}
+}
+
+func TestDepotManifestGetFiles(t *testing.T) {
+	d, _, _ := getDepotFixture()
+	files := d.GetFiles()
+	if len(files) != 3 {
+		t.Fatalf("Expected 3 files and got %d", len(files))
+	}
+	if files[0].Path != "bin/game.exe" || files[1].Path != "data/big.dat" || files[2].Path != "data/copy.dat" {
+		t.Errorf("Files were not normalized and sorted properly: %v", files)
+	}
+	if len(d.GetUniqueChunks()) != 3 {
+		t.Errorf("Shared chunks should only be counted once")
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	for _, format := range []string{FormatFiles, FormatChunks} {
+		d, raw, chunks := getDepotFixture()
+
+		expectedSize, err := GetArchiveSize(&d, raw, format)
+		if err != nil {
+			t.Fatalf("Could not compute archive size: %s", err.Error())
+		}
+
+		var archive bytes.Buffer
+		err = WriteArchive(&archive, &d, raw, format, getChunkGetter(chunks))
+		if err != nil {
+			t.Fatalf("Could not write %s archive: %s", format, err.Error())
+		}
+
+		if int64(archive.Len()) != expectedSize {
+			t.Errorf("Expected %s archive of size %d and got %d", format, expectedSize, archive.Len())
+		}
+
+		var again bytes.Buffer
+		WriteArchive(&again, &d, raw, format, getChunkGetter(chunks))
+		if !bytes.Equal(archive.Bytes(), again.Bytes()) {
+			t.Errorf("Writing the same %s archive twice should yield the same bytes", format)
+		}
+
+		names := []string{}
+		tr := tar.NewReader(&archive)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Archive is not a valid tar: %s", err.Error())
+			}
+			names = append(names, header.Name)
+			if header.Name == "bin/game.exe" && header.Mode != 0755 {
+				t.Errorf("Executable should keep its flag")
+			}
+		}
+
+		expectedEntries := 3
+		if format == FormatChunks {
+			expectedEntries = 4
+		}
+		if len(names) != expectedEntries {
+			t.Errorf("Expected %d entries in %s archive and got %v", expectedEntries, format, names)
+		}
+	}
+}
+
+func TestWriteArchiveCorruptChunk(t *testing.T) {
+	d, raw, chunks := getDepotFixture()
+	for hash, content := range chunks {
+		corrupted := append([]byte{}, content...)
+		corrupted[len(corrupted)-1] ^= 0xff
+		chunks[hash] = corrupted
+		break
+	}
+
+	err := WriteArchive(ioutil.Discard, &d, raw, FormatFiles, getChunkGetter(chunks))
+	if err == nil {
+		t.Errorf("Corrupt chunk should result in an error")
+	}
+}
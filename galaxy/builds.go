@@ -0,0 +1,64 @@
+package galaxy
+
+//The os names used by galaxy differ slightly from the ones used in the rest of the gog api
+func ConvertOs(os string) string {
+	if os == "mac" {
+		return "osx"
+	}
+	return os
+}
+
+type Build struct {
+	BuildId       string  `json:"build_id"`
+	ProductId     string  `json:"product_id"`
+	Os            string  `json:"os"`
+	Branch        *string `json:"branch"`
+	VersionName   string  `json:"version_name"`
+	DatePublished string  `json:"date_published"`
+	Generation    int     `json:"generation"`
+	Link          string  `json:"link"`
+}
+
+type Builds struct {
+	TotalCount int     `json:"total_count"`
+	Items      []Build `json:"items"`
+}
+
+//Builds are listed from the most recent to the oldest. Only the default branch is considered.
+func (b *Builds) GetLatestBuild() (Build, bool) {
+	for _, build := range (*b).Items {
+		if build.Generation == 2 && build.Branch == nil {
+			return build, true
+		}
+	}
+	return Build{}, false
+}
+
+type BuildDepot struct {
+	ProductId      string   `json:"productId"`
+	Languages      []string `json:"languages"`
+	Manifest       string   `json:"manifest"`
+	Size           int64    `json:"size"`
+	CompressedSize int64    `json:"compressedSize"`
+	IsGogDepot     bool     `json:"isGogDepot"`
+}
+
+type BuildManifest struct {
+	Version          int          `json:"version"`
+	BaseProductId    string       `json:"baseProductId"`
+	BuildId          string       `json:"buildId"`
+	Platform         string       `json:"platform"`
+	InstallDirectory string       `json:"installDirectory"`
+	Depots           []BuildDepot `json:"depots"`
+}
+
+//Leaves out the depots of dlcs, which are games of their own, and the redistributables shared by all games
+func (b *BuildManifest) GetProductDepots(productId string) []BuildDepot {
+	depots := []BuildDepot{}
+	for _, depot := range (*b).Depots {
+		if depot.ProductId == productId && (!depot.IsGogDepot) {
+			depots = append(depots, depot)
+		}
+	}
+	return depots
+}
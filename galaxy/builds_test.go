@@ -0,0 +1,84 @@
+package galaxy
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+)
+
+func loadFixture(t *testing.T, path string, v interface{}) []byte {
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Could not read fixture %s: %s", path, err.Error())
+	}
+	err = json.Unmarshal(body, v)
+	if err != nil {
+		t.Fatalf("Could not parse fixture %s: %s", path, err.Error())
+	}
+	return body
+}
+
+func TestBuildsGetLatestBuild(t *testing.T) {
+	var builds Builds
+	loadFixture(t, "testdata/builds.json", &builds)
+
+	build, ok := builds.GetLatestBuild()
+	if !ok {
+		t.Fatalf("Expected a latest build to be found")
+	}
+	if build.BuildId != "54820218513587912" || build.VersionName != "2.0" {
+		t.Errorf("Expected the latest generation 2 build of the default branch and got %s", build.BuildId)
+	}
+
+	builds.Items = builds.Items[2:]
+	_, ok = builds.GetLatestBuild()
+	if ok {
+		t.Errorf("Generation 1 builds should not be returned")
+	}
+}
+
+func TestBuildManifestGetProductDepots(t *testing.T) {
+	var buildManifest BuildManifest
+	body := loadFixture(t, "testdata/build-manifest.json", &buildManifest)
+
+	depots := buildManifest.GetProductDepots("1207658930")
+	if len(depots) != 3 {
+		t.Fatalf("Expected 3 depots and got %d", len(depots))
+	}
+	if len(ConvertLanguages(depots[0].Languages)) != 0 {
+		t.Errorf("Depot shared by all languages should not have languages")
+	}
+	if languages := ConvertLanguages(depots[2].Languages); len(languages) != 1 || languages[0] != "french" {
+		t.Errorf("Expected french depot and got %v", languages)
+	}
+
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	zw.Write(body)
+	zw.Close()
+
+	decoded, err := DecodeMeta(compressed.Bytes())
+	if err != nil || !bytes.Equal(decoded, body) {
+		t.Errorf("Compressed meta file was not decoded properly: %v", err)
+	}
+
+	decoded, err = DecodeMeta(body)
+	if err != nil || !bytes.Equal(decoded, body) {
+		t.Errorf("Uncompressed meta file should be returned as is: %v", err)
+	}
+}
+
+func TestConvertLanguages(t *testing.T) {
+	languages := ConvertLanguages([]string{"en-US", "en-GB", "pt-BR", "pt-PT", "zh-Hans", "xx-XX", "*"})
+	expected := []string{"english", "portuguese_brazilian", "portuguese", "chinese_simplified", "unknown"}
+	if len(languages) != len(expected) {
+		t.Fatalf("Expected %v and got %v", expected, languages)
+	}
+	for idx, _ := range expected {
+		if languages[idx] != expected[idx] {
+			t.Errorf("Expected %v and got %v", expected, languages)
+		}
+	}
+}
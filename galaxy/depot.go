@@ -0,0 +1,92 @@
+package galaxy
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	FormatFiles  string = "files"
+	FormatChunks        = "chunks"
+)
+
+type DepotChunk struct {
+	Md5            string `json:"md5"`
+	Size           int64  `json:"size"`
+	CompressedMd5  string `json:"compressedMd5"`
+	CompressedSize int64  `json:"compressedSize"`
+}
+
+type DepotItem struct {
+	Type   string       `json:"type"`
+	Path   string       `json:"path"`
+	Chunks []DepotChunk `json:"chunks"`
+	Md5    string       `json:"md5"`
+	Flags  []string     `json:"flags"`
+}
+
+type DepotManifest struct {
+	Version int `json:"version"`
+	Depot   struct {
+		Items []DepotItem `json:"items"`
+	} `json:"depot"`
+}
+
+func (i *DepotItem) IsExecutable() bool {
+	for _, flag := range (*i).Flags {
+		if flag == "executable" {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *DepotItem) GetSize() int64 {
+	size := int64(0)
+	for _, chunk := range (*i).Chunks {
+		size += chunk.Size
+	}
+	return size
+}
+
+//Returns the files of the depot, sorted by path so that archives are always assembled in the same order.
+//Paths use windows separators in depot manifests and are normalized here.
+func (d *DepotManifest) GetFiles() []DepotItem {
+	files := []DepotItem{}
+	for _, item := range (*d).Depot.Items {
+		if item.Type != "DepotFile" {
+			continue
+		}
+		item.Path = strings.TrimLeft(strings.ReplaceAll(item.Path, "\\", "/"), "/")
+		files = append(files, item)
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files
+}
+
+func (d *DepotManifest) GetSize() int64 {
+	size := int64(0)
+	for _, file := range d.GetFiles() {
+		size += file.GetSize()
+	}
+	return size
+}
+
+//Chunks shared by several files are only stored once in chunk archives
+func (d *DepotManifest) GetUniqueChunks() []DepotChunk {
+	chunks := []DepotChunk{}
+	seen := make(map[string]bool)
+	for _, file := range d.GetFiles() {
+		for _, chunk := range file.Chunks {
+			if _, ok := seen[chunk.CompressedMd5]; ok {
+				continue
+			}
+			seen[chunk.CompressedMd5] = true
+			chunks = append(chunks, chunk)
+		}
+	}
+	return chunks
+}
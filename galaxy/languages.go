@@ -0,0 +1,59 @@
+package galaxy
+
+import "strings"
+
+var languageCodes = map[string]string{
+	"en":      "english",
+	"fr":      "french",
+	"nl":      "dutch",
+	"es":      "spanish",
+	"pt-BR":   "portuguese_brazilian",
+	"ru":      "russian",
+	"ko":      "korean",
+	"zh":      "chinese_simplified",
+	"zh-Hans": "chinese_simplified",
+	"ja":      "japanese",
+	"pl":      "polish",
+	"it":      "italian",
+	"de":      "german",
+	"cs":      "czech",
+	"hu":      "hungarian",
+	"pt":      "portuguese",
+	"da":      "danish",
+	"fi":      "finnish",
+	"sv":      "swedish",
+	"tr":      "turkish",
+	"ar":      "arabic",
+	"ro":      "romanian",
+}
+
+//Converts galaxy language codes (ex: en-US) to the language names used in manifests.
+//Depots shared by all languages ("*") end up without languages.
+func ConvertLanguages(codes []string) []string {
+	languages := []string{}
+	for _, code := range codes {
+		if code == "*" {
+			continue
+		}
+
+		language, ok := languageCodes[code]
+		if !ok {
+			language, ok = languageCodes[strings.SplitN(code, "-", 2)[0]]
+		}
+		if !ok {
+			language = "unknown"
+		}
+
+		found := false
+		for _, l := range languages {
+			if l == language {
+				found = true
+				break
+			}
+		}
+		if !found {
+			languages = append(languages, language)
+		}
+	}
+	return languages
+}
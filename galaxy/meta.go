@@ -0,0 +1,73 @@
+package galaxy
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
+)
+
+//Galaxy meta files (build and depot manifests) are zlib compressed json, though some older ones are not compressed
+func DecodeMeta(body []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return body, nil
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(body))
+	if err != nil {
+		msg := fmt.Sprintf("DecodeMeta(...) -> Meta file is neither json nor zlib compressed: %s", err.Error())
+		return nil, errors.New(msg)
+	}
+	defer r.Close()
+
+	decoded, err := ioutil.ReadAll(r)
+	if err != nil {
+		msg := fmt.Sprintf("DecodeMeta(...) -> Error occured while decompressing meta file: %s", err.Error())
+		return nil, errors.New(msg)
+	}
+	return decoded, nil
+}
+
+//Meta files and chunks are stored under paths derived from their hash, ie: ab/cd/abcdef...
+func GetGalaxyPath(hash string) string {
+	if len(hash) < 4 {
+		return hash
+	}
+	return strings.Join([]string{hash[0:2], hash[2:4], hash}, "/")
+}
+
+func GetMetaUrl(hash string) string {
+	return fmt.Sprintf("https://gog-cdn-fastly.gog.com/content-system/v2/meta/%s", GetGalaxyPath(hash))
+}
+
+//Depots do not have a download url on the gog website, so manifests reference them with a url of their own
+func GetDepotUrl(productId int64, manifestHash string, format string) string {
+	return fmt.Sprintf("galaxy://%d/%s?format=%s", productId, manifestHash, format)
+}
+
+func ParseDepotUrl(depotUrl string) (string, string, string, error) {
+	u, err := url.Parse(depotUrl)
+	if err != nil || u.Scheme != "galaxy" || u.Host == "" || strings.Trim(u.Path, "/") == "" {
+		msg := fmt.Sprintf("ParseDepotUrl(depotUrl=%s) -> Not a valid depot url", depotUrl)
+		return "", "", "", errors.New(msg)
+	}
+
+	format := u.Query().Get("format")
+	if format != FormatFiles && format != FormatChunks {
+		msg := fmt.Sprintf("ParseDepotUrl(depotUrl=%s) -> Depot format %s is not supported", depotUrl, format)
+		return "", "", "", errors.New(msg)
+	}
+
+	return u.Host, strings.Trim(u.Path, "/"), format, nil
+}
+
+func GetDepotArchiveName(os string, manifestHash string, format string) string {
+	if format == FormatChunks {
+		return fmt.Sprintf("depot_%s_%s.chunks.tar", os, manifestHash)
+	}
+	return fmt.Sprintf("depot_%s_%s.tar", os, manifestHash)
+}
@@ -0,0 +1,93 @@
+package galaxy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SecureLinkUrl struct {
+	EndpointName       string                 `json:"endpoint_name"`
+	UrlFormat          string                 `json:"url_format"`
+	Parameters         map[string]interface{} `json:"parameters"`
+	Priority           int                    `json:"priority"`
+	FallbackOnly       bool                   `json:"fallback_only"`
+	SupportsGeneration []int                  `json:"supports_generation"`
+}
+
+type SecureLinks struct {
+	ProductId int             `json:"product_id"`
+	Urls      []SecureLinkUrl `json:"urls"`
+}
+
+func (s *SecureLinks) getPreferredUrl() (SecureLinkUrl, bool) {
+	var preferred SecureLinkUrl
+	found := false
+	for _, u := range (*s).Urls {
+		if u.FallbackOnly {
+			continue
+		}
+		if (!found) || u.Priority > preferred.Priority {
+			preferred = u
+			found = true
+		}
+	}
+	return preferred, found
+}
+
+func formatParameter(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+//The url of a chunk is obtained by substituting the parameters of the endpoint in its url format,
+//with the path of the chunk appended to the path parameter
+func (s *SecureLinks) GetChunkUrl(compressedMd5 string) (string, error) {
+	u, ok := s.getPreferredUrl()
+	if !ok {
+		msg := fmt.Sprintf("GetChunkUrl(compressedMd5=%s) -> No usable endpoint in secure links", compressedMd5)
+		return "", errors.New(msg)
+	}
+
+	chunkUrl := u.UrlFormat
+	for key, value := range u.Parameters {
+		param := formatParameter(value)
+		if key == "path" {
+			param = param + "/" + GetGalaxyPath(compressedMd5)
+		}
+		chunkUrl = strings.ReplaceAll(chunkUrl, "{"+key+"}", param)
+	}
+	return chunkUrl, nil
+}
+
+//Returns the earliest expiry of the preferred endpoint. Links without expiry never expire.
+func (s *SecureLinks) GetExpiry() (time.Time, bool) {
+	u, ok := s.getPreferredUrl()
+	if !ok {
+		return time.Time{}, false
+	}
+
+	value, ok := u.Parameters["expires_at"]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	expiry, err := strconv.ParseInt(formatParameter(value), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(expiry, 0), true
+}
+
+func (s *SecureLinks) IsExpiredAt(t time.Time) bool {
+	expiry, ok := s.GetExpiry()
+	return ok && (!t.Before(expiry))
+}
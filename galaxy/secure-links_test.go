@@ -0,0 +1,55 @@
+package galaxy
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestSecureLinksGetChunkUrl(t *testing.T) {
+	body := `{
+		"product_id": 1207658930,
+		"type": "depot",
+		"urls": [
+			{
+				"endpoint_name": "akamai_edgecast_proxy",
+				"url_format": "{base_url}/token=nbf={expires_at}~hmac={token}{path}",
+				"parameters": {"base_url": "https://gog-cdn-lumen.secure2.footprint.net", "path": "/content-system/v2/store/1207658930", "expires_at": 1700000000, "token": "abc"},
+				"priority": 2,
+				"fallback_only": true
+			},
+			{
+				"endpoint_name": "fastly",
+				"url_format": "{base_url}{path}?{token}",
+				"parameters": {"base_url": "https://gog-cdn-fastly.gog.com", "path": "/content-system/v2/store/1207658930", "expires_at": 1700000000, "token": "exp=1700000000~hmac=def"},
+				"priority": 1,
+				"fallback_only": false
+			}
+		]
+	}`
+
+	var links SecureLinks
+	err := json.Unmarshal([]byte(body), &links)
+	if err != nil {
+		t.Fatalf("Could not parse secure links: %s", err.Error())
+	}
+
+	chunkUrl, err := links.GetChunkUrl("0123456789abcdef")
+	if err != nil {
+		t.Fatalf("Could not get chunk url: %s", err.Error())
+	}
+	expected := "https://gog-cdn-fastly.gog.com/content-system/v2/store/1207658930/01/23/0123456789abcdef?exp=1700000000~hmac=def"
+	if chunkUrl != expected {
+		t.Errorf("Expected %s and got %s", expected, chunkUrl)
+	}
+
+	if links.IsExpiredAt(time.Unix(1699999999, 0)) || (!links.IsExpiredAt(time.Unix(1700000000, 0))) {
+		t.Errorf("Expiry was not computed properly")
+	}
+
+	depotUrl := GetDepotUrl(1207658930, "2ffb7c7ba5ca5c6a9b4b5a2e7c2f3ba3", FormatChunks)
+	productId, hash, format, err := ParseDepotUrl(depotUrl)
+	if err != nil || productId != "1207658930" || hash != "2ffb7c7ba5ca5c6a9b4b5a2e7c2f3ba3" || format != FormatChunks {
+		t.Errorf("Depot url %s was not parsed properly", depotUrl)
+	}
+}
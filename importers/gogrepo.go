@@ -0,0 +1,133 @@
+package importers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"path"
+)
+
+//Language codes of gogrepo mapped to the language names of the manifest
+var gogrepoLanguages = map[string]string{
+	"en": "english",
+	"fr": "french",
+	"nl": "dutch",
+	"es": "spanish",
+	"br": "portuguese_brazilian",
+	"ru": "russian",
+	"ko": "korean",
+	"cn": "chinese_simplified",
+	"jp": "japanese",
+	"pl": "polish",
+	"it": "italian",
+	"de": "german",
+	"cz": "czech",
+	"hu": "hungarian",
+	"pt": "portuguese",
+	"da": "danish",
+	"fi": "finnish",
+	"sv": "swedish",
+	"tr": "turkish",
+	"ar": "arabic",
+	"ro": "romanian",
+}
+
+type gogrepoFile struct {
+	Name      string `json:"name"`
+	Desc      string `json:"desc"`
+	Lang      string `json:"lang"`
+	OsType    string `json:"os_type"`
+	Version   string `json:"version"`
+	ManualUrl string `json:"manual_url"`
+	Size      int64  `json:"size"`
+	Md5       string `json:"md5"`
+}
+
+type gogrepoGame struct {
+	Id        int64         `json:"id"`
+	Title     string        `json:"title"`
+	LongTitle string        `json:"long_title"`
+	Serial    string        `json:"serial"`
+	Downloads []gogrepoFile `json:"downloads"`
+	Extras    []gogrepoFile `json:"extras"`
+}
+
+//The file name is only filled by gogrepo once the file's metadata was retrieved
+func (f *gogrepoFile) getName() string {
+	if (*f).Name != "" {
+		return (*f).Name
+	}
+	return path.Base((*f).ManualUrl)
+}
+
+func getGogrepoInstaller(file gogrepoFile) manifest.ManifestGameInstaller {
+	language, ok := gogrepoLanguages[file.Lang]
+	if !ok {
+		language = "unknown"
+	}
+
+	installer := manifest.ManifestGameInstaller{
+		Languages:    []string{language},
+		Os:           file.OsType,
+		Url:          file.ManualUrl,
+		Title:        file.Desc,
+		Name:         file.getName(),
+		Type:         getInstallerType(file.ManualUrl),
+		Version:      file.Version,
+		VerifiedSize: file.Size,
+		Checksum:     file.Md5,
+	}
+	return installer
+}
+
+//Converts the content of gogrepo's gog-manifest.dat file, which is a python literal of the list of games
+func ImportGogrepoManifest(content []byte) (*manifest.Manifest, error) {
+	value, err := ParsePythonLiteral(string(content))
+	if err != nil {
+		return nil, err
+	}
+
+	//The literal is converted to json to be decoded in typed structures
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("ImportGogrepoManifest(...) -> Error occured while converting the manifest: %s", err.Error()))
+	}
+
+	var games []gogrepoGame
+	err = json.Unmarshal(bs, &games)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("ImportGogrepoManifest(...) -> Manifest does not have the expected structure: %s", err.Error()))
+	}
+
+	m := newImportedManifest()
+	for _, game := range games {
+		mGame := manifest.ManifestGame{
+			Id:         game.Id,
+			Slug:       game.Title,
+			Title:      game.LongTitle,
+			CdKey:      game.Serial,
+			Tags:       []string{},
+			Installers: []manifest.ManifestGameInstaller{},
+			Extras:     []manifest.ManifestGameExtra{},
+		}
+
+		for _, download := range game.Downloads {
+			mGame.Installers = append(mGame.Installers, getGogrepoInstaller(download))
+		}
+
+		for _, extra := range game.Extras {
+			mGame.Extras = append(mGame.Extras, manifest.ManifestGameExtra{
+				Url:          extra.ManualUrl,
+				Title:        extra.Desc,
+				Name:         extra.getName(),
+				VerifiedSize: extra.Size,
+				Checksum:     extra.Md5,
+			})
+		}
+
+		(*m).Games = append((*m).Games, mGame)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,75 @@
+package importers
+
+import (
+	"gogcli/manifest"
+	"testing"
+)
+
+const gogrepoManifest = `[{'downloads': [{'desc': u'Some Game',
+                 'lang': 'en',
+                 'manual_url': '/downloads/some_game/en1installer0',
+                 'md5': 'aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa',
+                 'name': u'setup_some_game_1.0.exe',
+                 'os_type': 'windows',
+                 'size': 1000L,
+                 'version': u'1.0'},
+                {'desc': u'Some Game Patch',
+                 'lang': 'fr',
+                 'manual_url': '/downloads/some_game/fr1patch1',
+                 'md5': None,
+                 'name': None,
+                 'os_type': 'linux',
+                 'size': 200,
+                 'version': None}],
+  'extras': [{'desc': u'Manual',
+              'manual_url': '/downloads/some_game/1234',
+              'md5': 'bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb',
+              'name': u'manual.pdf',
+              'size': 50}],
+  'id': 1,
+  'long_title': u'Some Game: '
+                u'The Sequel',
+  'serial': '',
+  'title': 'some_game'}]`
+
+func TestImportGogrepoManifest(t *testing.T) {
+	m, err := ImportGogrepoManifest([]byte(gogrepoManifest))
+	if err != nil {
+		t.Fatalf("Import failed: %s", err.Error())
+	}
+
+	if len((*m).Games) != 1 {
+		t.Fatalf("Expected 1 game and got %d", len((*m).Games))
+	}
+
+	game := (*m).Games[0]
+	if game.Id != 1 || game.Slug != "some_game" || game.Title != "Some Game: The Sequel" {
+		t.Errorf("Game has unexpected properties: %v", game)
+	}
+
+	if len(game.Installers) != 2 || len(game.Extras) != 1 {
+		t.Fatalf("Expected 2 installers and 1 extra and got %d installers and %d extras", len(game.Installers), len(game.Extras))
+	}
+
+	installer := game.Installers[0]
+	if installer.Name != "setup_some_game_1.0.exe" || installer.Languages[0] != "english" || installer.Os != "windows" || installer.VerifiedSize != 1000 || installer.Checksum != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || installer.Type != manifest.InstallerTypeInstaller {
+		t.Errorf("Installer has unexpected properties: %v", installer)
+	}
+
+	patch := game.Installers[1]
+	if patch.Name != "fr1patch1" || patch.Languages[0] != "french" || patch.Type != manifest.InstallerTypePatch || patch.Checksum != "" {
+		t.Errorf("Patch has unexpected properties: %v", patch)
+	}
+
+	extra := game.Extras[0]
+	if extra.Name != "manual.pdf" || extra.VerifiedSize != 50 || extra.Checksum != "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" {
+		t.Errorf("Extra has unexpected properties: %v", extra)
+	}
+}
+
+func TestImportGogrepoManifestInvalid(t *testing.T) {
+	_, err := ImportGogrepoManifest([]byte("{'id': 1}"))
+	if err == nil {
+		t.Errorf("Expected a manifest that is not a list of games to be rejected")
+	}
+}
@@ -0,0 +1,241 @@
+package importers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+//Language bits of lgogdownloader, in the order of its language constants. Languages gog doesn't list are unknown.
+var lgogdownloaderLanguages = []string{
+	"english",
+	"german",
+	"french",
+	"polish",
+	"russian",
+	"chinese_simplified",
+	"czech",
+	"spanish",
+	"hungarian",
+	"italian",
+	"japanese",
+	"turkish",
+	"portuguese",
+	"korean",
+	"dutch",
+	"swedish",
+	"",
+	"danish",
+	"finnish",
+	"portuguese_brazilian",
+	"",
+	"",
+	"",
+	"",
+	"arabic",
+	"romanian",
+}
+
+var lgogdownloaderPlatforms = []string{"windows", "mac", "linux"}
+
+//Values that lgogdownloader stored either as strings or as numbers depending on its version
+type lgogdownloaderScalar string
+
+func (s *lgogdownloaderScalar) UnmarshalJSON(bs []byte) error {
+	if len(bs) > 0 && bs[0] == '"' {
+		var str string
+		err := json.Unmarshal(bs, &str)
+		(*s) = lgogdownloaderScalar(str)
+		return err
+	}
+	if string(bs) == "null" {
+		(*s) = ""
+		return nil
+	}
+	(*s) = lgogdownloaderScalar(bs)
+	return nil
+}
+
+type lgogdownloaderFile struct {
+	Id       lgogdownloaderScalar `json:"id"`
+	Name     string               `json:"name"`
+	Path     string               `json:"path"`
+	Size     lgogdownloaderScalar `json:"size"`
+	Language uint64               `json:"language"`
+	Platform uint64               `json:"platform"`
+	Version  string               `json:"version"`
+	Gamename string               `json:"gamename"`
+}
+
+type lgogdownloaderGame struct {
+	Gamename      string               `json:"gamename"`
+	ProductId     lgogdownloaderScalar `json:"product_id"`
+	Title         string               `json:"title"`
+	Installers    []lgogdownloaderFile `json:"installers"`
+	Extras        []lgogdownloaderFile `json:"extras"`
+	Patches       []lgogdownloaderFile `json:"patches"`
+	LanguagePacks []lgogdownloaderFile `json:"languagepacks"`
+	Dlcs          []lgogdownloaderGame `json:"dlcs"`
+}
+
+type lgogdownloaderDetails struct {
+	Games []lgogdownloaderGame `json:"games"`
+}
+
+type lgogdownloaderXmlFile struct {
+	XMLName  xml.Name `xml:"file"`
+	Name     string   `xml:"name,attr"`
+	Checksum string   `xml:"md5,attr"`
+	Size     int64    `xml:"total_size,attr"`
+}
+
+func getLgogdownloaderLanguages(bits uint64) []string {
+	languages := []string{}
+	for idx, language := range lgogdownloaderLanguages {
+		if bits&(1<<uint(idx)) != 0 {
+			if language == "" {
+				language = "unknown"
+			}
+			languages = manifest.ConcatStringSlicesUnique(languages, []string{language})
+		}
+	}
+	if len(languages) == 0 {
+		languages = append(languages, "unknown")
+	}
+	return languages
+}
+
+func getLgogdownloaderPlatform(bits uint64) string {
+	for idx, platform := range lgogdownloaderPlatforms {
+		if bits&(1<<uint(idx)) != 0 {
+			return platform
+		}
+	}
+	return ""
+}
+
+func (f *lgogdownloaderFile) getUrl(gamename string) string {
+	if (*f).Gamename != "" {
+		gamename = (*f).Gamename
+	}
+	return fmt.Sprintf("/downloads/%s/%s", gamename, string((*f).Id))
+}
+
+//Files whose size is not a number of bytes only have an estimated size
+func (f *lgogdownloaderFile) getSizes() (string, int64) {
+	size, err := strconv.ParseInt(string((*f).Size), 10, 64)
+	if err != nil {
+		return string((*f).Size), 0
+	}
+	return "", size
+}
+
+//lgogdownloader keeps the xml metadata of the files it downloaded, which has their checksum
+func getLgogdownloaderXml(xmlDir string, gamename string, name string) (lgogdownloaderXmlFile, bool) {
+	var xmlFile lgogdownloaderXmlFile
+	if xmlDir == "" {
+		return xmlFile, false
+	}
+
+	for _, xmlPath := range []string{filepath.Join(xmlDir, gamename, name+".xml"), filepath.Join(xmlDir, name+".xml")} {
+		bs, err := ioutil.ReadFile(xmlPath)
+		if err != nil {
+			continue
+		}
+
+		err = xml.Unmarshal(bs, &xmlFile)
+		if err == nil && xmlFile.Name == name {
+			return xmlFile, true
+		}
+	}
+	return xmlFile, false
+}
+
+func addLgogdownloaderFiles(game *manifest.ManifestGame, files lgogdownloaderGame, dlc string, xmlDir string) {
+	installers := []lgogdownloaderFile{}
+	installers = append(installers, files.Installers...)
+	installers = append(installers, files.Patches...)
+	installers = append(installers, files.LanguagePacks...)
+	for _, file := range installers {
+		name := path.Base(file.Path)
+		estimatedSize, verifiedSize := file.getSizes()
+		installer := manifest.ManifestGameInstaller{
+			Languages:     getLgogdownloaderLanguages(file.Language),
+			Os:            getLgogdownloaderPlatform(file.Platform),
+			Url:           file.getUrl(files.Gamename),
+			Title:         file.Name,
+			Name:          name,
+			Type:          getInstallerType(file.getUrl(files.Gamename)),
+			Dlc:           dlc,
+			Version:       file.Version,
+			EstimatedSize: estimatedSize,
+			VerifiedSize:  verifiedSize,
+		}
+		if xmlFile, ok := getLgogdownloaderXml(xmlDir, files.Gamename, name); ok {
+			installer.Checksum = xmlFile.Checksum
+			installer.VerifiedSize = xmlFile.Size
+		}
+		(*game).Installers = append((*game).Installers, installer)
+	}
+
+	for _, file := range files.Extras {
+		name := path.Base(file.Path)
+		estimatedSize, verifiedSize := file.getSizes()
+		extra := manifest.ManifestGameExtra{
+			Url:           file.getUrl(files.Gamename),
+			Title:         file.Name,
+			Name:          name,
+			Dlc:           dlc,
+			EstimatedSize: estimatedSize,
+			VerifiedSize:  verifiedSize,
+		}
+		if xmlFile, ok := getLgogdownloaderXml(xmlDir, files.Gamename, name); ok {
+			extra.Checksum = xmlFile.Checksum
+			extra.VerifiedSize = xmlFile.Size
+		}
+		(*game).Extras = append((*game).Extras, extra)
+	}
+}
+
+//Converts the content of lgogdownloader's gamedetails.json cache.
+//The cache doesn't have checksums, which are taken from the xml directory of lgogdownloader if it is given.
+func ImportLgogdownloaderDetails(content []byte, xmlDir string) (*manifest.Manifest, error) {
+	var details lgogdownloaderDetails
+	err := json.Unmarshal(content, &details)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("ImportLgogdownloaderDetails(...) -> Game details do not have the expected structure: %s", err.Error()))
+	}
+
+	m := newImportedManifest()
+	for _, game := range details.Games {
+		id, err := strconv.ParseInt(strings.TrimSpace(string(game.ProductId)), 10, 64)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("ImportLgogdownloaderDetails(...) -> Game %s has an invalid product id: %s", game.Gamename, string(game.ProductId)))
+		}
+
+		mGame := manifest.ManifestGame{
+			Id:         id,
+			Slug:       game.Gamename,
+			Title:      game.Title,
+			Tags:       []string{},
+			Installers: []manifest.ManifestGameInstaller{},
+			Extras:     []manifest.ManifestGameExtra{},
+		}
+
+		addLgogdownloaderFiles(&mGame, game, "", xmlDir)
+		for _, dlc := range game.Dlcs {
+			addLgogdownloaderFiles(&mGame, dlc, dlc.Title, xmlDir)
+		}
+
+		(*m).Games = append((*m).Games, mGame)
+	}
+
+	return m, nil
+}
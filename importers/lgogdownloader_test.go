@@ -0,0 +1,86 @@
+package importers
+
+import (
+	"gogcli/manifest"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const lgogdownloaderDetailsContent = `{
+  "games": [{
+    "gamename": "some_game",
+    "product_id": "1",
+    "title": "Some Game",
+    "installers": [{"id": "en1installer0", "name": "Some Game", "path": "/some_game/setup_some_game.exe", "size": "1000", "language": 3, "platform": 1, "version": "1.0"}],
+    "patches": [{"id": "en1patch1", "name": "Patch", "path": "/some_game/patch_some_game.sh", "size": 200, "language": 65536, "platform": 4}],
+    "languagepacks": [],
+    "extras": [{"id": "1234", "name": "Manual", "path": "/some_game/manual.pdf", "size": "1 MB"}],
+    "dlcs": [{
+      "gamename": "some_game_dlc",
+      "product_id": "2",
+      "title": "Some Dlc",
+      "installers": [{"id": "en1installer0", "name": "Some Dlc", "path": "/some_game_dlc/setup_some_dlc.exe", "size": "300", "language": 1, "platform": 1}]
+    }]
+  }]
+}`
+
+func TestImportLgogdownloaderDetails(t *testing.T) {
+	xmlDir, err := ioutil.TempDir("", "lgogdownloader")
+	if err != nil {
+		t.Fatalf("Could not create xml directory: %s", err.Error())
+	}
+	defer os.RemoveAll(xmlDir)
+
+	err = os.Mkdir(filepath.Join(xmlDir, "some_game"), 0755)
+	if err != nil {
+		t.Fatalf("Could not create xml directory: %s", err.Error())
+	}
+	xml := `<file name="setup_some_game.exe" available="1" notavailablemsg="" md5="aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" chunks="1" total_size="1000"></file>`
+	err = ioutil.WriteFile(filepath.Join(xmlDir, "some_game", "setup_some_game.exe.xml"), []byte(xml), 0644)
+	if err != nil {
+		t.Fatalf("Could not write xml file: %s", err.Error())
+	}
+
+	m, err := ImportLgogdownloaderDetails([]byte(lgogdownloaderDetailsContent), xmlDir)
+	if err != nil {
+		t.Fatalf("Import failed: %s", err.Error())
+	}
+
+	if len((*m).Games) != 1 {
+		t.Fatalf("Expected 1 game and got %d", len((*m).Games))
+	}
+
+	game := (*m).Games[0]
+	if game.Id != 1 || game.Slug != "some_game" || game.Title != "Some Game" {
+		t.Errorf("Game has unexpected properties: %v", game)
+	}
+
+	if len(game.Installers) != 3 || len(game.Extras) != 1 {
+		t.Fatalf("Expected 3 installers and 1 extra and got %d installers and %d extras", len(game.Installers), len(game.Extras))
+	}
+
+	installer := game.Installers[0]
+	if installer.Name != "setup_some_game.exe" || installer.Os != "windows" || installer.Url != "/downloads/some_game/en1installer0" || installer.Checksum != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || installer.VerifiedSize != 1000 {
+		t.Errorf("Installer has unexpected properties: %v", installer)
+	}
+	if len(installer.Languages) != 2 || installer.Languages[0] != "english" || installer.Languages[1] != "german" {
+		t.Errorf("Expected installer languages to be english and german and got %v", installer.Languages)
+	}
+
+	patch := game.Installers[1]
+	if patch.Type != manifest.InstallerTypePatch || patch.Os != "linux" || patch.Languages[0] != "unknown" || patch.VerifiedSize != 200 || patch.Checksum != "" {
+		t.Errorf("Patch has unexpected properties: %v", patch)
+	}
+
+	dlc := game.Installers[2]
+	if dlc.Dlc != "Some Dlc" || dlc.Url != "/downloads/some_game_dlc/en1installer0" {
+		t.Errorf("Dlc installer has unexpected properties: %v", dlc)
+	}
+
+	extra := game.Extras[0]
+	if extra.Name != "manual.pdf" || extra.EstimatedSize != "1 MB" || extra.VerifiedSize != 0 {
+		t.Errorf("Extra has unexpected properties: %v", extra)
+	}
+}
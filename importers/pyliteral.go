@@ -0,0 +1,256 @@
+package importers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//Parser for the subset of python literals that pprint outputs: dicts, lists, tuples, strings, numbers, booleans and None.
+//Adjacent strings are concatenated, like pprint does to wrap long strings over several lines.
+type pyParser struct {
+	input string
+	pos   int
+}
+
+func ParsePythonLiteral(input string) (interface{}, error) {
+	p := pyParser{input: input, pos: 0}
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, p.errorf("Unexpected content after the literal")
+	}
+	return value, nil
+}
+
+func (p *pyParser) errorf(format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	return errors.New(fmt.Sprintf("ParsePythonLiteral(...) -> %s at position %d", msg, (*p).pos))
+}
+
+func (p *pyParser) skipSpaces() {
+	for (*p).pos < len((*p).input) {
+		c := (*p).input[(*p).pos]
+		if c == '#' {
+			for (*p).pos < len((*p).input) && (*p).input[(*p).pos] != '\n' {
+				(*p).pos++
+			}
+		} else if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\\' {
+			(*p).pos++
+		} else {
+			return
+		}
+	}
+}
+
+func (p *pyParser) peek() byte {
+	p.skipSpaces()
+	if (*p).pos >= len((*p).input) {
+		return 0
+	}
+	return (*p).input[(*p).pos]
+}
+
+func (p *pyParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf("Expected '%c'", c)
+	}
+	(*p).pos++
+	return nil
+}
+
+func (p *pyParser) parseValue() (interface{}, error) {
+	c := p.peek()
+	switch {
+	case c == '{':
+		return p.parseDict()
+	case c == '[':
+		return p.parseSequence('[', ']')
+	case c == '(':
+		return p.parseParenthesis()
+	case c == '\'' || c == '"' || (p.hasStringPrefix()):
+		return p.parseStrings()
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c == 0:
+		return nil, p.errorf("Unexpected end of input")
+	}
+
+	for keyword, value := range map[string]interface{}{"None": nil, "True": true, "False": false} {
+		if strings.HasPrefix((*p).input[(*p).pos:], keyword) {
+			(*p).pos += len(keyword)
+			return value, nil
+		}
+	}
+	return nil, p.errorf("Unexpected character '%c'", c)
+}
+
+func (p *pyParser) parseDict() (interface{}, error) {
+	dict := make(map[string]interface{})
+	(*p).pos++
+	for p.peek() != '}' {
+		key, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		err = p.expect(':')
+		if err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		dict[fmt.Sprint(key)] = value
+
+		if p.peek() != ',' {
+			break
+		}
+		(*p).pos++
+	}
+	return dict, p.expect('}')
+}
+
+func (p *pyParser) parseSequence(open byte, close byte) (interface{}, error) {
+	list := []interface{}{}
+	(*p).pos++
+	for p.peek() != close {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, value)
+
+		if p.peek() != ',' {
+			break
+		}
+		(*p).pos++
+	}
+	return list, p.expect(close)
+}
+
+//Parenthesis either group the wrapped pieces of a long string or delimit a tuple
+func (p *pyParser) parseParenthesis() (interface{}, error) {
+	start := (*p).pos
+	(*p).pos++
+	if c := p.peek(); c == '\'' || c == '"' || p.hasStringPrefix() {
+		value, err := p.parseStrings()
+		if err == nil && p.peek() == ')' {
+			(*p).pos++
+			return value, nil
+		}
+	}
+
+	(*p).pos = start
+	return p.parseSequence('(', ')')
+}
+
+func (p *pyParser) hasStringPrefix() bool {
+	rest := (*p).input[(*p).pos:]
+	for _, prefix := range []string{"u'", "u\"", "r'", "r\"", "b'", "b\""} {
+		if strings.HasPrefix(rest, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *pyParser) parseStrings() (interface{}, error) {
+	var builder strings.Builder
+	for {
+		c := p.peek()
+		if !(c == '\'' || c == '"' || p.hasStringPrefix()) {
+			break
+		}
+
+		str, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		builder.WriteString(str)
+	}
+	return builder.String(), nil
+}
+
+func (p *pyParser) parseString() (string, error) {
+	raw := false
+	if c := (*p).input[(*p).pos]; c == 'u' || c == 'r' || c == 'b' {
+		raw = c == 'r'
+		(*p).pos++
+	}
+
+	quote := (*p).input[(*p).pos]
+	(*p).pos++
+
+	var builder strings.Builder
+	for (*p).pos < len((*p).input) {
+		c := (*p).input[(*p).pos]
+		if c == quote {
+			(*p).pos++
+			return builder.String(), nil
+		}
+
+		if c != '\\' || raw {
+			builder.WriteByte(c)
+			(*p).pos++
+			continue
+		}
+
+		if (*p).pos+1 >= len((*p).input) {
+			break
+		}
+		escaped := (*p).input[(*p).pos+1]
+		(*p).pos += 2
+		switch escaped {
+		case 'n':
+			builder.WriteByte('\n')
+		case 't':
+			builder.WriteByte('\t')
+		case 'r':
+			builder.WriteByte('\r')
+		case '0':
+			builder.WriteByte(0)
+		case '\n':
+		case 'x', 'u', 'U':
+			length := map[byte]int{'x': 2, 'u': 4, 'U': 8}[escaped]
+			if (*p).pos+length > len((*p).input) {
+				return "", p.errorf("Truncated escape sequence")
+			}
+			code, err := strconv.ParseUint((*p).input[(*p).pos:(*p).pos+length], 16, 32)
+			if err != nil {
+				return "", p.errorf("Invalid escape sequence")
+			}
+			(*p).pos += length
+			var buf [utf8.UTFMax]byte
+			n := utf8.EncodeRune(buf[:], rune(code))
+			builder.Write(buf[:n])
+		default:
+			builder.WriteByte(escaped)
+		}
+	}
+	return "", p.errorf("Unterminated string")
+}
+
+func (p *pyParser) parseNumber() (interface{}, error) {
+	start := (*p).pos
+	for (*p).pos < len((*p).input) && strings.IndexByte("+-0123456789.eEL", (*p).input[(*p).pos]) >= 0 {
+		(*p).pos++
+	}
+
+	literal := strings.TrimSuffix((*p).input[start:(*p).pos], "L")
+	if i, err := strconv.ParseInt(literal, 10, 64); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		(*p).pos = start
+		return nil, p.errorf("Invalid number %s", literal)
+	}
+	return f, nil
+}
@@ -0,0 +1,48 @@
+package importers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePythonLiteral(t *testing.T) {
+	input := `[{'a': 1L,
+  'b': u'caf\xe9',
+  'c': ('first '
+        'second'),
+  'd': (1, 2.5, -3),
+  "e": None, # comment
+  'f': [True, False],
+  'g': r'C:\dir',
+  'h': 'it\'s'}]`
+
+	value, err := ParsePythonLiteral(input)
+	if err != nil {
+		t.Fatalf("Parsing failed: %s", err.Error())
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"a": int64(1),
+			"b": "café",
+			"c": "first second",
+			"d": []interface{}{int64(1), 2.5, int64(-3)},
+			"e": nil,
+			"f": []interface{}{true, false},
+			"g": "C:\\dir",
+			"h": "it's",
+		},
+	}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("Expected %v and got %v", expected, value)
+	}
+}
+
+func TestParsePythonLiteralErrors(t *testing.T) {
+	for _, input := range []string{"[1, 2", "{'a' 1}", "'unterminated", "[1] 2", "nothing"} {
+		_, err := ParsePythonLiteral(input)
+		if err == nil {
+			t.Errorf("Expected parsing of %s to fail", input)
+		}
+	}
+}
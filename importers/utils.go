@@ -0,0 +1,25 @@
+package importers
+
+import (
+	"gogcli/manifest"
+	"regexp"
+)
+
+var patchUrlRegex = regexp.MustCompile(`/[a-z]{2,2}[0-9]+patch[0-9]+$`)
+var languagePackUrlRegex = regexp.MustCompile(`/[a-z]{2,2}[0-9]+langpack[0-9]+$`)
+
+//Download urls end with the language, kind and number of the file, ex: /downloads/some_game/en1patch2
+func getInstallerType(manualUrl string) string {
+	if patchUrlRegex.MatchString(manualUrl) {
+		return manifest.InstallerTypePatch
+	} else if languagePackUrlRegex.MatchString(manualUrl) {
+		return manifest.InstallerTypeLanguagePack
+	}
+	return manifest.InstallerTypeInstaller
+}
+
+//The filter of imported manifests keeps all their files, as it is stored with them when they are applied and would
+//otherwise trim the manifests planned against the storage later on
+func newImportedManifest() *manifest.Manifest {
+	return manifest.NewEmptyManifest(manifest.ManifestFilter{Installers: true, Extras: true, Patches: manifest.PatchesAll})
+}
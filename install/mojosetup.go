@@ -0,0 +1,258 @@
+package install
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//Files of the game in the zip that MojoSetup installers append to their shell script
+const mojoSetupDataPrefix = "data/noarch/"
+
+const (
+	zipDirectoryEndSignature       = 0x06054b50
+	zipDirectoryEndLength          = 22
+	zipMaxCommentLength            = 65535
+	zip64DirectoryEndSignature     = 0x06064b50
+	zip64DirectoryEndLength        = 56
+	zip64DirectoryLocatorSignature = 0x07064b50
+	zip64DirectoryLocatorLength    = 20
+)
+
+//Archives too large for the fields of the end of central directory record keep the size and offset of their central
+//directory in a zip64 record, followed by a locator, right before it. Records with extensible data are not supported.
+func readZip64Directory(r io.ReaderAt, directoryEnd int64) (int64, int64, bool) {
+	locatorStart := directoryEnd - zip64DirectoryLocatorLength
+	recordStart := locatorStart - zip64DirectoryEndLength
+	if recordStart < 0 {
+		return 0, 0, false
+	}
+
+	buf := make([]byte, zip64DirectoryEndLength+zip64DirectoryLocatorLength)
+	_, err := r.ReadAt(buf, recordStart)
+	if err != nil && err != io.EOF {
+		return 0, 0, false
+	}
+	if binary.LittleEndian.Uint32(buf[zip64DirectoryEndLength:]) != zip64DirectoryLocatorSignature {
+		return 0, 0, false
+	}
+	if binary.LittleEndian.Uint32(buf) != zip64DirectoryEndSignature {
+		return 0, 0, false
+	}
+
+	directorySize := int64(binary.LittleEndian.Uint64(buf[40:]))
+	directoryOffset := int64(binary.LittleEndian.Uint64(buf[48:]))
+	if directorySize < 0 || directoryOffset < 0 {
+		return 0, 0, false
+	}
+	return directorySize, directoryOffset, true
+}
+
+//Finds where the zip appended to the installer starts, from the position and size of its central directory,
+//which the zip's own offsets are relative to
+func findZipOffset(r io.ReaderAt, size int64) (int64, error) {
+	searchLength := int64(zipDirectoryEndLength + zipMaxCommentLength)
+	if searchLength > size {
+		searchLength = size
+	}
+
+	buf := make([]byte, searchLength)
+	_, err := r.ReadAt(buf, size-searchLength)
+	if err != nil && err != io.EOF {
+		return 0, err
+	}
+
+	for idx := len(buf) - zipDirectoryEndLength; idx >= 0; idx-- {
+		if binary.LittleEndian.Uint32(buf[idx:]) != zipDirectoryEndSignature {
+			continue
+		}
+
+		directorySize := int64(binary.LittleEndian.Uint32(buf[idx+12:]))
+		directoryOffset := int64(binary.LittleEndian.Uint32(buf[idx+16:]))
+		directoryEnd := size - searchLength + int64(idx)
+		if directorySize == 0xffffffff || directoryOffset == 0xffffffff {
+			var ok bool
+			directorySize, directoryOffset, ok = readZip64Directory(r, directoryEnd)
+			if !ok {
+				continue
+			}
+			//The central directory is followed by the zip64 record and its locator instead
+			directoryEnd = directoryEnd - zip64DirectoryLocatorLength - zip64DirectoryEndLength
+		}
+		offset := directoryEnd - directorySize - directoryOffset
+		if offset >= 0 {
+			return offset, nil
+		}
+	}
+	return 0, errors.New("findZipOffset(...) -> The installer does not contain a zip archive")
+}
+
+//Returns the path of the entry in the destination, making sure it doesn't escape it
+func getExtractionPath(dest string, name string) (string, bool) {
+	relPath := filepath.FromSlash(strings.TrimPrefix(name, mojoSetupDataPrefix))
+	if relPath == "" || filepath.IsAbs(relPath) {
+		return "", false
+	}
+
+	cleanPath := filepath.Clean(relPath)
+	if cleanPath == ".." || strings.HasPrefix(cleanPath, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.Join(dest, cleanPath), true
+}
+
+func isInsideDirectory(dir string, fPath string) bool {
+	relPath, err := filepath.Rel(dir, fPath)
+	return err == nil && relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator))
+}
+
+//Symlinks extracted earlier could otherwise send the files of later entries outside of the destination
+func hasSymlinkParent(dest string, fPath string) (bool, error) {
+	relPath, err := filepath.Rel(dest, filepath.Dir(fPath))
+	if err != nil || relPath == "." {
+		return false, err
+	}
+
+	current := dest
+	for _, part := range strings.Split(relPath, string(filepath.Separator)) {
+		current = filepath.Join(current, part)
+		info, err := os.Lstat(current)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func extractZipFile(file *zip.File, fPath string, destDir string) error {
+	hasSymlink, err := hasSymlinkParent(destDir, fPath)
+	if err != nil {
+		return err
+	}
+	if hasSymlink {
+		return errors.New(fmt.Sprintf("extractZipFile(...) -> %s would be extracted through a symlink", fPath))
+	}
+
+	err = os.MkdirAll(filepath.Dir(fPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	handle, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+
+	if file.Mode()&os.ModeSymlink != 0 {
+		target, err := ioutil.ReadAll(handle)
+		if err != nil {
+			return err
+		}
+		//Links are relative to their directory and must not lead outside of the destination
+		linkTarget := filepath.FromSlash(string(target))
+		if linkTarget == "" || filepath.IsAbs(linkTarget) || !isInsideDirectory(destDir, filepath.Join(filepath.Dir(fPath), linkTarget)) {
+			return errors.New(fmt.Sprintf("extractZipFile(...) -> Symlink %s points to %s outside of the destination", fPath, string(target)))
+		}
+		os.Remove(fPath)
+		return os.Symlink(linkTarget, fPath)
+	}
+
+	mode := file.Mode().Perm()
+	if mode == 0 {
+		mode = 0644
+	}
+	dest, err := os.OpenFile(fPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dest, handle)
+	closeErr := dest.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+	return os.Chmod(fPath, mode)
+}
+
+//Extracts the game files of a MojoSetup installer in the destination without running its shell script.
+//Returns the paths of the extracted files, relative to the destination.
+func ExtractMojoSetupData(r io.ReaderAt, size int64, dest string) ([]string, error) {
+	offset, err := findZipOffset(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := zip.NewReader(io.NewSectionReader(r, offset, size-offset), size-offset)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("ExtractMojoSetupData(...) -> Error occured while reading the zip archive of the installer: %s", err.Error()))
+	}
+
+	files := []string{}
+	for _, file := range archive.File {
+		if !strings.HasPrefix(file.Name, mojoSetupDataPrefix) {
+			continue
+		}
+
+		fPath, ok := getExtractionPath(dest, file.Name)
+		if !ok {
+			continue
+		}
+
+		if file.FileInfo().IsDir() {
+			hasSymlink, err := hasSymlinkParent(dest, fPath)
+			if err == nil && hasSymlink {
+				err = errors.New(fmt.Sprintf("ExtractMojoSetupData(...) -> %s would be extracted through a symlink", file.Name))
+			}
+			if err == nil {
+				err = os.MkdirAll(fPath, 0755)
+			}
+			if err != nil {
+				return files, err
+			}
+			continue
+		}
+
+		err = extractZipFile(file, fPath, dest)
+		if err != nil {
+			return files, errors.New(fmt.Sprintf("ExtractMojoSetupData(...) -> Error occured while extracting %s: %s", file.Name, err.Error()))
+		}
+
+		relPath, _ := filepath.Rel(dest, fPath)
+		files = append(files, filepath.ToSlash(relPath))
+	}
+
+	if len(files) == 0 {
+		return files, errors.New("ExtractMojoSetupData(...) -> The installer does not contain game files")
+	}
+	return files, nil
+}
+
+func ExtractMojoSetupInstaller(installerPath string, dest string) ([]string, error) {
+	handle, err := os.Open(installerPath)
+	if err != nil {
+		return nil, err
+	}
+	defer handle.Close()
+
+	info, err := handle.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractMojoSetupData(handle, info.Size(), dest)
+}
@@ -0,0 +1,232 @@
+package install
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+type testZipEntry struct {
+	name    string
+	content string
+	mode    os.FileMode
+}
+
+func buildZipArchive(t *testing.T, entries []testZipEntry) []byte {
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		header.SetMode(entry.mode)
+		w, err := writer.CreateHeader(header)
+		if err != nil {
+			t.Fatalf("Error creating zip entry: %s", err.Error())
+		}
+		w.Write([]byte(entry.content))
+	}
+	err := writer.Close()
+	if err != nil {
+		t.Fatalf("Error closing zip: %s", err.Error())
+	}
+	return archive.Bytes()
+}
+
+//Moves the size and offset of the central directory of the archive to a zip64 record, as archives too large for
+//the end of central directory record have them
+func convertToZip64(t *testing.T, archive []byte) []byte {
+	directoryEnd := len(archive) - 22
+	if binary.LittleEndian.Uint32(archive[directoryEnd:]) != 0x06054b50 {
+		t.Fatalf("Expected the archive to end with its end of central directory record")
+	}
+	records := uint64(binary.LittleEndian.Uint16(archive[directoryEnd+10:]))
+	directorySize := uint64(binary.LittleEndian.Uint32(archive[directoryEnd+12:]))
+	directoryOffset := uint64(binary.LittleEndian.Uint32(archive[directoryEnd+16:]))
+
+	record := make([]byte, 56)
+	binary.LittleEndian.PutUint32(record, 0x06064b50)
+	binary.LittleEndian.PutUint64(record[4:], 44)
+	binary.LittleEndian.PutUint16(record[12:], 45)
+	binary.LittleEndian.PutUint16(record[14:], 45)
+	binary.LittleEndian.PutUint64(record[24:], records)
+	binary.LittleEndian.PutUint64(record[32:], records)
+	binary.LittleEndian.PutUint64(record[40:], directorySize)
+	binary.LittleEndian.PutUint64(record[48:], directoryOffset)
+
+	locator := make([]byte, 20)
+	binary.LittleEndian.PutUint32(locator, 0x07064b50)
+	binary.LittleEndian.PutUint64(locator[8:], uint64(directoryEnd))
+	binary.LittleEndian.PutUint32(locator[16:], 1)
+
+	end := make([]byte, 22)
+	copy(end, archive[directoryEnd:])
+	binary.LittleEndian.PutUint16(end[8:], 0xffff)
+	binary.LittleEndian.PutUint16(end[10:], 0xffff)
+	binary.LittleEndian.PutUint32(end[12:], 0xffffffff)
+	binary.LittleEndian.PutUint32(end[16:], 0xffffffff)
+
+	converted := append([]byte{}, archive[:directoryEnd]...)
+	converted = append(converted, record...)
+	converted = append(converted, locator...)
+	return append(converted, end...)
+}
+
+//The zip's offsets are relative to its own start, like those MojoSetup appends to its script
+var mojoSetupScript = []byte("#!/bin/sh\necho 'makeself header'\nexit 0\n")
+
+func buildMojoSetupInstaller(t *testing.T, files map[string]string, modes map[string]os.FileMode) []byte {
+	names := []string{}
+	for name, _ := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	entries := []testZipEntry{}
+	for _, name := range names {
+		mode, ok := modes[name]
+		if !ok {
+			mode = 0644
+		}
+		entries = append(entries, testZipEntry{name, files[name], mode})
+	}
+	return append(append([]byte{}, mojoSetupScript...), buildZipArchive(t, entries)...)
+}
+
+func TestExtractMojoSetupData(t *testing.T) {
+	installer := buildMojoSetupInstaller(t, map[string]string{
+		"scripts/mojosetup_init.lua":   "ignored",
+		"data/noarch/start.sh":         "#!/bin/sh",
+		"data/noarch/game/data.bin":    "data",
+		"data/noarch/../../escaped.sh": "escaped",
+	}, map[string]os.FileMode{"data/noarch/start.sh": 0755})
+
+	dest := t.TempDir()
+	files, err := ExtractMojoSetupData(bytes.NewReader(installer), int64(len(installer)), filepath.Join(dest, "game"))
+	if err != nil {
+		t.Fatalf("Extraction failed: %s", err.Error())
+	}
+	if len(files) != 2 {
+		t.Errorf("Expected 2 extracted files and got %v", files)
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(dest, "game", "game", "data.bin"))
+	if err != nil || string(content) != "data" {
+		t.Errorf("Expected game/data.bin to be extracted")
+	}
+
+	info, err := os.Stat(filepath.Join(dest, "game", "start.sh"))
+	if err != nil || info.Mode().Perm()&0100 == 0 {
+		t.Errorf("Expected start.sh to be extracted as an executable")
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "game", "scripts")); err == nil {
+		t.Errorf("Expected files outside of data/noarch not to be extracted")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "escaped.sh")); err == nil {
+		t.Errorf("Expected files escaping the destination not to be extracted")
+	}
+}
+
+func TestExtractMojoSetupDataWithoutZip(t *testing.T) {
+	installer := []byte("#!/bin/sh\nexit 0\n")
+	_, err := ExtractMojoSetupData(bytes.NewReader(installer), int64(len(installer)), t.TempDir())
+	if err == nil {
+		t.Errorf("Expected an error for an installer without a zip archive")
+	}
+}
+
+func TestExtractMojoSetupDataZip64(t *testing.T) {
+	archive := buildZipArchive(t, []testZipEntry{
+		testZipEntry{"data/noarch/start.sh", "#!/bin/sh", 0755},
+		testZipEntry{"data/noarch/game/data.bin", "data", 0644},
+	})
+	installer := append(append([]byte{}, mojoSetupScript...), convertToZip64(t, archive)...)
+
+	offset, err := findZipOffset(bytes.NewReader(installer), int64(len(installer)))
+	if err != nil || offset != int64(len(mojoSetupScript)) {
+		t.Errorf("Expected the zip64 archive to be found after the script and got offset %d: %v", offset, err)
+	}
+
+	dest := t.TempDir()
+	files, err := ExtractMojoSetupData(bytes.NewReader(installer), int64(len(installer)), dest)
+	if err != nil {
+		t.Fatalf("Extraction of the zip64 archive failed: %s", err.Error())
+	}
+	if len(files) != 2 {
+		t.Errorf("Expected 2 extracted files and got %v", files)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dest, "game", "data.bin"))
+	if err != nil || string(content) != "data" {
+		t.Errorf("Expected game/data.bin to be extracted")
+	}
+}
+
+func TestExtractMojoSetupDataSymlinks(t *testing.T) {
+	archive := buildZipArchive(t, []testZipEntry{
+		testZipEntry{"data/noarch/lib/libgame.so.1", "library", 0644},
+		testZipEntry{"data/noarch/lib/libgame.so", "libgame.so.1", os.ModeSymlink | 0777},
+		testZipEntry{"data/noarch/bin/lib", "../lib", os.ModeSymlink | 0777},
+	})
+	installer := append(append([]byte{}, mojoSetupScript...), archive...)
+
+	dest := t.TempDir()
+	files, err := ExtractMojoSetupData(bytes.NewReader(installer), int64(len(installer)), dest)
+	if err != nil {
+		t.Fatalf("Extraction failed: %s", err.Error())
+	}
+	if len(files) != 3 {
+		t.Errorf("Expected 3 extracted files and got %v", files)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dest, "bin", "lib", "libgame.so"))
+	if err != nil || string(content) != "library" {
+		t.Errorf("Expected the symlinks inside the destination to be extracted")
+	}
+}
+
+func TestExtractMojoSetupDataMaliciousSymlinks(t *testing.T) {
+	archives := map[string][]testZipEntry{
+		"symlink to an absolute path": []testZipEntry{
+			testZipEntry{"data/noarch/start.sh", "#!/bin/sh", 0755},
+			testZipEntry{"data/noarch/etc", "/etc", os.ModeSymlink | 0777},
+		},
+		"symlink leading outside": []testZipEntry{
+			testZipEntry{"data/noarch/start.sh", "#!/bin/sh", 0755},
+			testZipEntry{"data/noarch/game/outside", "../../outside", os.ModeSymlink | 0777},
+		},
+		"file written through a symlink": []testZipEntry{
+			testZipEntry{"data/noarch/game/start.sh", "#!/bin/sh", 0755},
+			testZipEntry{"data/noarch/link", "game", os.ModeSymlink | 0777},
+			testZipEntry{"data/noarch/link/payload.sh", "payload", 0755},
+		},
+		"directory created through a symlink": []testZipEntry{
+			testZipEntry{"data/noarch/game/start.sh", "#!/bin/sh", 0755},
+			testZipEntry{"data/noarch/link", "game", os.ModeSymlink | 0777},
+			testZipEntry{"data/noarch/link/payload/", "", os.ModeDir | 0755},
+		},
+	}
+
+	for name, entries := range archives {
+		installer := append(append([]byte{}, mojoSetupScript...), buildZipArchive(t, entries)...)
+		root := t.TempDir()
+		dest := filepath.Join(root, "game")
+
+		_, err := ExtractMojoSetupData(bytes.NewReader(installer), int64(len(installer)), dest)
+		if err == nil {
+			t.Errorf("Expected the archive with a %s to be rejected", name)
+		}
+		for _, escaped := range []string{filepath.Join(dest, "etc"), filepath.Join(root, "outside"), filepath.Join(dest, "game", "outside")} {
+			if _, err := os.Lstat(escaped); err == nil {
+				t.Errorf("Expected no link leading outside to be created for the archive with a %s", name)
+			}
+		}
+		for _, payload := range []string{filepath.Join(dest, "game", "payload.sh"), filepath.Join(dest, "game", "payload")} {
+			if _, err := os.Lstat(payload); err == nil {
+				t.Errorf("Expected nothing to be extracted through a symlink for the archive with a %s", name)
+			}
+		}
+	}
+}
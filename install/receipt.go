@@ -0,0 +1,165 @@
+package install
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//Receipt kept in the installation directory of a game
+const ReceiptFileName = ".gogcli-install.json"
+
+type ReceiptInstaller struct {
+	Name     string
+	Version  string
+	Checksum string
+	Dlc      string `json:",omitempty"`
+}
+
+type Receipt struct {
+	GameId     int64
+	Slug       string
+	Title      string
+	Installers []ReceiptInstaller
+	Files      []string
+}
+
+//Returns the linux installer of the game and those of its dlcs that can be extracted, in the order they should be installed
+func SelectLinuxInstallers(game *manifest.ManifestGame, languages []string, dlcs bool) ([]manifest.ManifestGameInstaller, error) {
+	base := []manifest.ManifestGameInstaller{}
+	dlcInstallers := []manifest.ManifestGameInstaller{}
+	for _, installer := range (*game).Installers {
+		if installer.Os != "linux" || installer.GetType() != manifest.InstallerTypeInstaller || !strings.HasSuffix(installer.Name, ".sh") {
+			continue
+		}
+		if len(languages) > 0 && !installer.HasOneOfLanguages(languages) {
+			continue
+		}
+
+		if installer.Dlc == "" {
+			base = append(base, installer)
+		} else if dlcs {
+			dlcInstallers = append(dlcInstallers, installer)
+		}
+	}
+
+	if len(base) == 0 {
+		msg := fmt.Sprintf("SelectLinuxInstallers(game={Id=%d, ...}, ...) -> The game does not have a linux installer in the selected languages", (*game).Id)
+		return nil, errors.New(msg)
+	}
+	if len(base) > 1 {
+		names := []string{}
+		for _, installer := range base {
+			names = append(names, installer.Name)
+		}
+		msg := fmt.Sprintf("SelectLinuxInstallers(game={Id=%d, ...}, ...) -> The game has several linux installers (%s). Select a language to pick one", (*game).Id, strings.Join(names, ", "))
+		return nil, errors.New(msg)
+	}
+
+	return append(base, dlcInstallers...), nil
+}
+
+func NewReceipt(game *manifest.ManifestGame, installers []manifest.ManifestGameInstaller, files []string) *Receipt {
+	receipt := Receipt{
+		GameId:     (*game).Id,
+		Slug:       (*game).Slug,
+		Title:      (*game).Title,
+		Installers: []ReceiptInstaller{},
+		Files:      files,
+	}
+	for _, installer := range installers {
+		receipt.Installers = append(receipt.Installers, ReceiptInstaller{
+			Name:     installer.Name,
+			Version:  installer.Version,
+			Checksum: installer.Checksum,
+			Dlc:      installer.Dlc,
+		})
+	}
+	return &receipt
+}
+
+//The installation is up to date if it was done from the same installers
+func (r *Receipt) IsUpToDate(installers []manifest.ManifestGameInstaller) bool {
+	if len((*r).Installers) != len(installers) {
+		return false
+	}
+
+	installed := make(map[string]string)
+	for _, installer := range (*r).Installers {
+		installed[installer.Name] = installer.Checksum
+	}
+	for _, installer := range installers {
+		checksum, ok := installed[installer.Name]
+		if !ok || checksum != installer.Checksum {
+			return false
+		}
+	}
+	return true
+}
+
+//Returns the files of the previous installation that the new one doesn't have, which an upgrade should remove
+func (r *Receipt) GetStaleFiles(files []string) []string {
+	current := make(map[string]bool)
+	for _, file := range files {
+		current[file] = true
+	}
+
+	stale := []string{}
+	for _, file := range (*r).Files {
+		if !current[file] {
+			stale = append(stale, file)
+		}
+	}
+	return stale
+}
+
+//Removes the files of the previous installation that the new one doesn't have from the destination.
+//The receipt is a file of the installation that could have been altered, so entries leading outside of it are rejected.
+func (r *Receipt) RemoveStaleFiles(dest string, files []string) error {
+	stale := r.GetStaleFiles(files)
+	for _, file := range stale {
+		fPath := filepath.Join(dest, filepath.FromSlash(file))
+		if fPath == filepath.Clean(dest) || !isInsideDirectory(dest, fPath) {
+			msg := fmt.Sprintf("RemoveStaleFiles(dest=%s, ...) -> Receipt file %s is not inside of the installation directory", dest, file)
+			return errors.New(msg)
+		}
+	}
+
+	for _, file := range stale {
+		err := os.Remove(filepath.Join(dest, filepath.FromSlash(file)))
+		if err != nil && (!os.IsNotExist(err)) {
+			return err
+		}
+	}
+	return nil
+}
+
+func LoadReceipt(dest string) (*Receipt, error) {
+	var receipt Receipt
+	bs, err := ioutil.ReadFile(filepath.Join(dest, ReceiptFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	err = json.Unmarshal(bs, &receipt)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("LoadReceipt(dest=%s) -> Error occured while parsing the receipt: %s", dest, err.Error()))
+	}
+	return &receipt, nil
+}
+
+func StoreReceipt(receipt *Receipt, dest string) error {
+	bs, err := json.MarshalIndent(receipt, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dest, ReceiptFileName), bs, 0644)
+}
@@ -0,0 +1,136 @@
+package install
+
+import (
+	"gogcli/manifest"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func getInstallTestGame() manifest.ManifestGame {
+	return manifest.ManifestGame{
+		Id:    1,
+		Slug:  "some_game",
+		Title: "Some Game",
+		Installers: []manifest.ManifestGameInstaller{
+			manifest.ManifestGameInstaller{Name: "setup_some_game.exe", Os: "windows", Languages: []string{"english"}, Checksum: "a"},
+			manifest.ManifestGameInstaller{Name: "some_game_dlc.sh", Os: "linux", Languages: []string{"english"}, Dlc: "Some Dlc", Checksum: "b"},
+			manifest.ManifestGameInstaller{Name: "some_game_en.sh", Os: "linux", Languages: []string{"english"}, Checksum: "c", Version: "1.0"},
+			manifest.ManifestGameInstaller{Name: "some_game_fr.sh", Os: "linux", Languages: []string{"french"}, Checksum: "d", Version: "1.0"},
+			manifest.ManifestGameInstaller{Name: "some_game_patch.sh", Os: "linux", Languages: []string{"english"}, Type: manifest.InstallerTypePatch, Checksum: "e"},
+		},
+	}
+}
+
+func TestSelectLinuxInstallers(t *testing.T) {
+	game := getInstallTestGame()
+
+	_, err := SelectLinuxInstallers(&game, []string{}, true)
+	if err == nil {
+		t.Errorf("Expected an error when several linux installers match")
+	}
+
+	installers, err := SelectLinuxInstallers(&game, []string{"english"}, true)
+	if err != nil {
+		t.Fatalf("Selection failed: %s", err.Error())
+	}
+	if len(installers) != 2 || installers[0].Name != "some_game_en.sh" || installers[1].Name != "some_game_dlc.sh" {
+		t.Errorf("Expected the base installer followed by the dlc and got %v", installers)
+	}
+
+	installers, err = SelectLinuxInstallers(&game, []string{"french"}, false)
+	if err != nil || len(installers) != 1 || installers[0].Name != "some_game_fr.sh" {
+		t.Errorf("Expected only the french installer to be selected")
+	}
+
+	_, err = SelectLinuxInstallers(&game, []string{"german"}, true)
+	if err == nil {
+		t.Errorf("Expected an error when no linux installer matches")
+	}
+}
+
+func TestReceiptIsUpToDate(t *testing.T) {
+	game := getInstallTestGame()
+	installers, _ := SelectLinuxInstallers(&game, []string{"english"}, true)
+	receipt := NewReceipt(&game, installers, []string{"start.sh"})
+
+	if !receipt.IsUpToDate(installers) {
+		t.Errorf("Expected the receipt to be up to date with its own installers")
+	}
+
+	updated := []manifest.ManifestGameInstaller{installers[0], installers[1]}
+	updated[0].Checksum = "f"
+	if receipt.IsUpToDate(updated) {
+		t.Errorf("Expected the receipt not to be up to date when an installer changed")
+	}
+	if receipt.IsUpToDate(installers[:1]) {
+		t.Errorf("Expected the receipt not to be up to date when a dlc is no longer installed")
+	}
+}
+
+func TestReceiptGetStaleFiles(t *testing.T) {
+	receipt := Receipt{Files: []string{"start.sh", "game/old.bin", "game/data.bin"}}
+	stale := receipt.GetStaleFiles([]string{"start.sh", "game/data.bin", "game/new.bin"})
+	if len(stale) != 1 || stale[0] != "game/old.bin" {
+		t.Errorf("Expected game/old.bin to be the only stale file and got %v", stale)
+	}
+}
+
+func TestReceiptRemoveStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "game")
+	for _, file := range []string{filepath.Join(dest, "start.sh"), filepath.Join(dest, "game", "old.bin"), filepath.Join(dir, "outside.txt")} {
+		os.MkdirAll(filepath.Dir(file), 0755)
+		ioutil.WriteFile(file, []byte("content"), 0644)
+	}
+
+	receipt := Receipt{Files: []string{"start.sh", "game/old.bin", "game/missing.bin"}}
+	err := receipt.RemoveStaleFiles(dest, []string{"start.sh"})
+	if err != nil {
+		t.Fatalf("Removing the stale files failed: %s", err.Error())
+	}
+	if _, err := os.Stat(filepath.Join(dest, "game", "old.bin")); !os.IsNotExist(err) {
+		t.Errorf("Expected the stale file to be removed")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "start.sh")); err != nil {
+		t.Errorf("Expected the files of the new installation to be kept")
+	}
+
+	for _, escaping := range []string{"../outside.txt", "game/../../outside.txt", "."} {
+		receipt = Receipt{Files: []string{"start.sh", escaping}}
+		err = receipt.RemoveStaleFiles(dest, []string{})
+		if err == nil {
+			t.Errorf("Expected the receipt file %s leading outside of the installation to be rejected", escaping)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "outside.txt")); err != nil {
+		t.Errorf("Expected the file outside of the installation to be kept")
+	}
+	if _, err := os.Stat(filepath.Join(dest, "start.sh")); err != nil {
+		t.Errorf("Expected nothing to be removed from a receipt with files outside of the installation")
+	}
+}
+
+func TestStoreAndLoadReceipt(t *testing.T) {
+	dest := t.TempDir()
+	receipt, err := LoadReceipt(dest)
+	if err != nil || receipt != nil {
+		t.Errorf("Expected no receipt in an empty directory")
+	}
+
+	game := getInstallTestGame()
+	installers, _ := SelectLinuxInstallers(&game, []string{"french"}, true)
+	err = StoreReceipt(NewReceipt(&game, installers, []string{"start.sh"}), dest)
+	if err != nil {
+		t.Fatalf("Storing the receipt failed: %s", err.Error())
+	}
+
+	receipt, err = LoadReceipt(dest)
+	if err != nil || receipt == nil {
+		t.Fatalf("Expected the stored receipt to be loaded")
+	}
+	if (*receipt).Slug != "some_game" || len((*receipt).Installers) != 1 || (*receipt).Installers[0].Version != "1.0" {
+		t.Errorf("Loaded receipt does not match the stored one: %v", *receipt)
+	}
+}
@@ -9,6 +9,8 @@ import (
 type Source struct {
 	logLevel string
 	mutex    sync.Mutex
+	tee      io.Writer
+	out      io.Writer
 }
 
 func CreateSource(logLevel string) *Source {
@@ -16,7 +18,25 @@ func CreateSource(logLevel string) *Source {
 	return &source
 }
 
+//Loggers created from the returned source will also write their output to tee
+func CreateTeeSource(logLevel string, tee io.Writer) *Source {
+	source := Source{logLevel: logLevel, tee: tee}
+	return &source
+}
+
+//Loggers created from the returned source will write their output to out instead of the output they are created with
+func CreateRedirectedSource(logLevel string, out io.Writer) *Source {
+	source := Source{logLevel: logLevel, out: out}
+	return &source
+}
+
 func (s *Source) CreateLogger(out io.Writer, prefix string, flag int) *Logger {
+	if s.out != nil {
+		out = s.out
+	}
+	if s.tee != nil {
+		out = io.MultiWriter(out, s.tee)
+	}
 	logger := Logger{s, log.New(out, prefix, flag)}
 	return &logger
 }
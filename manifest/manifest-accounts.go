@@ -0,0 +1,80 @@
+package manifest
+
+//Records that the game and all its files come from the given account
+func (g *ManifestGame) SetAccount(account string) {
+	(*g).Accounts = []string{account}
+	for idx, _ := range (*g).Installers {
+		(*g).Installers[idx].Account = account
+	}
+	for idx, _ := range (*g).Extras {
+		(*g).Extras[idx].Account = account
+	}
+	for idx, _ := range (*g).Depots {
+		(*g).Depots[idx].Account = account
+	}
+}
+
+//Returns the accounts that can download a file of the game: the account whose url is in the manifest first
+//and then the other accounts owning the game
+func (g *ManifestGame) GetDownloadAccounts(account string) []string {
+	accounts := []string{}
+	if account != "" {
+		accounts = append(accounts, account)
+	}
+	for _, owner := range (*g).Accounts {
+		if owner != account {
+			accounts = append(accounts, owner)
+		}
+	}
+	return accounts
+}
+
+//Adds the owners of the other game along with the files it has that the game doesn't.
+//Files are matched by url as different accounts get the same download urls for the same files.
+func (g *ManifestGame) MergeAccountGame(o *ManifestGame) {
+	(*g).Accounts = ConcatStringSlicesUnique((*g).Accounts, (*o).Accounts)
+
+	installerUrls := map[string]bool{}
+	for _, installer := range (*g).Installers {
+		installerUrls[installer.Url] = true
+	}
+	for _, installer := range (*o).Installers {
+		if !installerUrls[installer.Url] {
+			(*g).Installers = append((*g).Installers, installer)
+		}
+	}
+
+	extraUrls := map[string]bool{}
+	for _, extra := range (*g).Extras {
+		extraUrls[extra.Url] = true
+	}
+	for _, extra := range (*o).Extras {
+		if !extraUrls[extra.Url] {
+			(*g).Extras = append((*g).Extras, extra)
+		}
+	}
+
+	for _, depot := range (*o).Depots {
+		if !(*g).HasDepotNamed(depot.Name) {
+			(*g).Depots = append((*g).Depots, depot)
+		}
+	}
+}
+
+//Merges the games generated with another account in the manifest.
+//Games of the manifest keep precedence and games it doesn't have are added.
+func (m *Manifest) MergeAccountGames(games []ManifestGame) {
+	positions := map[int64]int{}
+	for idx, game := range (*m).Games {
+		positions[game.Id] = idx
+	}
+
+	for _, game := range games {
+		if idx, ok := positions[game.Id]; ok {
+			(*m).Games[idx].MergeAccountGame(&game)
+		} else {
+			positions[game.Id] = len((*m).Games)
+			(*m).Games = append((*m).Games, game)
+		}
+	}
+}
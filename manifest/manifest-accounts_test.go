@@ -0,0 +1,66 @@
+package manifest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func getAccountGameFixture(id int64, installerUrls []string, account string) ManifestGame {
+	game := ManifestGame{Id: id, Title: "Some Game"}
+	for _, url := range installerUrls {
+		game.Installers = append(game.Installers, ManifestGameInstaller{Url: url, Name: url + ".exe", VerifiedSize: 10})
+	}
+	game.Extras = []ManifestGameExtra{ManifestGameExtra{Url: "/extra", Name: "manual.pdf", VerifiedSize: 1}}
+	game.SetAccount(account)
+	return game
+}
+
+func TestManifestMergeAccountGames(t *testing.T) {
+	m := &Manifest{Games: []ManifestGame{
+		getAccountGameFixture(1, []string{"/base"}, "alice"),
+		getAccountGameFixture(2, []string{"/other"}, "alice"),
+	}}
+
+	m.MergeAccountGames([]ManifestGame{
+		getAccountGameFixture(1, []string{"/base", "/dlc"}, "bob"),
+		getAccountGameFixture(3, []string{"/third"}, "bob"),
+	})
+
+	if len(m.Games) != 3 {
+		t.Fatalf("Expected 3 games after the merge and got %d", len(m.Games))
+	}
+
+	shared := m.Games[0]
+	if !reflect.DeepEqual(shared.Accounts, []string{"alice", "bob"}) {
+		t.Errorf("Expected the shared game to be owned by both accounts and got %v", shared.Accounts)
+	}
+	if len(shared.Installers) != 2 || len(shared.Extras) != 1 {
+		t.Fatalf("Expected the files of both accounts without duplicates, got %d installers and %d extras", len(shared.Installers), len(shared.Extras))
+	}
+	if shared.Installers[0].Account != "alice" || shared.Installers[1].Account != "bob" {
+		t.Errorf("Expected files to keep the account whose url they have")
+	}
+
+	if !reflect.DeepEqual(m.Games[1].Accounts, []string{"alice"}) || !reflect.DeepEqual(m.Games[2].Accounts, []string{"bob"}) {
+		t.Errorf("Expected games owned by a single account to only list it")
+	}
+
+	if !reflect.DeepEqual(shared.GetDownloadAccounts("bob"), []string{"bob", "alice"}) {
+		t.Errorf("Expected the account of the file to be tried before the other owners, got %v", shared.GetDownloadAccounts("bob"))
+	}
+
+	info, err := m.GetFileActionFileInfo(GameInfo{Id: 1}, FileAction{Kind: "installer", Name: "/dlc.exe"})
+	if err != nil {
+		t.Fatalf("Expected the file info of a merged installer and got error %s", err.Error())
+	}
+	if !reflect.DeepEqual(info.Accounts, []string{"bob", "alice"}) {
+		t.Errorf("Expected the file info to list the accounts that can download it, got %v", info.Accounts)
+	}
+}
+
+func TestManifestGameGetDownloadAccountsSingleAccount(t *testing.T) {
+	game := ManifestGame{Id: 1}
+	if len(game.GetDownloadAccounts("")) != 0 {
+		t.Errorf("Expected games of single account manifests not to have download accounts")
+	}
+}
@@ -19,6 +19,7 @@ type ActionsIterator struct {
 	currentGameActionDone bool
 	installerNames        []string
 	extraNames            []string
+	depotNames            []string
 	maxGames              int
 	processedGames        int
 }
@@ -92,9 +93,12 @@ func (i *ActionsIterator) Sort(gamesSort ActionsIteratorSort, m *Manifest) {
 	sort.Strings(installerNames)
 	extraNames := currentGameAction.GetExtraNames()
 	sort.Strings(extraNames)
+	depotNames := currentGameAction.GetDepotNames()
+	sort.Strings(depotNames)
 	(*i).currentGameActionDone = (currentGameAction.Action == "update")
 	(*i).installerNames = installerNames
 	(*i).extraNames = extraNames
+	(*i).depotNames = depotNames
 }
 
 func NewActionsIterator(a GameActions, maxGames int) *ActionsIterator {
@@ -107,6 +111,7 @@ func NewActionsIterator(a GameActions, maxGames int) *ActionsIterator {
 			currentGameActionDone: true,
 			installerNames:        []string{},
 			extraNames:            []string{},
+			depotNames:            []string{},
 			maxGames:              maxGames,
 			processedGames:        0,
 		}
@@ -118,12 +123,15 @@ func NewActionsIterator(a GameActions, maxGames int) *ActionsIterator {
 	sort.Strings(installerNames)
 	extraNames := currentGameAction.GetExtraNames()
 	sort.Strings(extraNames)
+	depotNames := currentGameAction.GetDepotNames()
+	sort.Strings(depotNames)
 	new := &ActionsIterator{
 		gameActions:           a,
 		gameIds:               gameIds,
 		currentGameActionDone: currentGameAction.Action == "update",
 		installerNames:        installerNames,
 		extraNames:            extraNames,
+		depotNames:            depotNames,
 		maxGames:              maxGames,
 		processedGames:        0,
 	}
@@ -133,11 +141,12 @@ func NewActionsIterator(a GameActions, maxGames int) *ActionsIterator {
 
 func (i *ActionsIterator) Stringify() string {
 	return fmt.Sprintf(
-		"{'gameId': %d, 'gameActionDone': %t, 'installersLeft': %d, 'extrasLeft': %d, 'processedGames': %d, 'maxGames': %d}",
+		"{'gameId': %d, 'gameActionDone': %t, 'installersLeft': %d, 'extrasLeft': %d, 'depotsLeft': %d, 'processedGames': %d, 'maxGames': %d}",
 		(*i).gameIds[0],
 		(*i).currentGameActionDone,
 		len((*i).installerNames),
 		len((*i).extraNames),
+		len((*i).depotNames),
 		(*i).processedGames,
 		(*i).maxGames,
 	)
@@ -156,7 +165,7 @@ func (i *ActionsIterator) HasMore() bool {
 		return false
 	}
 
-	moreFileActions := len(i.gameIds) > 1 || len(i.installerNames) > 0 || len(i.extraNames) > 0
+	moreFileActions := len(i.gameIds) > 1 || len(i.installerNames) > 0 || len(i.extraNames) > 0 || len(i.depotNames) > 0
 	if moreFileActions {
 		return true
 	}
@@ -178,7 +187,7 @@ func (i *ActionsIterator) Next() (Action, error) {
 	currentGame := i.gameActions[currentGameId]
 	currentGameInfo := GameInfo{Id: currentGame.Id, Slug: currentGame.Slug, Title: currentGame.Title}
 
-	remainingFileActions := len(i.extraNames) + len(i.installerNames)
+	remainingFileActions := len(i.extraNames) + len(i.installerNames) + len(i.depotNames)
 	onlyOneFileActionRemains := i.currentGameActionDone && remainingFileActions == 1
 	onlyOneGameActionRemains := (!i.currentGameActionDone) && remainingFileActions == 0
 	if onlyOneFileActionRemains || onlyOneGameActionRemains {
@@ -219,6 +228,18 @@ func (i *ActionsIterator) Next() (Action, error) {
 		}, nil
 	}
 
+	if len(i.depotNames) > 0 {
+		name := i.depotNames[0]
+		i.depotNames = i.depotNames[1:]
+		fileAction := currentGame.DepotActions[name]
+		return Action{
+			Game:          currentGameInfo,
+			IsFileAction:  true,
+			FileActionPtr: &fileAction,
+			GameAction:    "",
+		}, nil
+	}
+
 	if (!i.currentGameActionDone) && currentGame.Action == "remove" {
 		i.currentGameActionDone = true
 		return Action{
@@ -236,7 +257,10 @@ func (i *ActionsIterator) Next() (Action, error) {
 	sort.Strings(installerNames)
 	extraNames := currentGameAction.GetExtraNames()
 	sort.Strings(extraNames)
+	depotNames := currentGameAction.GetDepotNames()
+	sort.Strings(depotNames)
 	i.installerNames = installerNames
 	i.extraNames = extraNames
+	i.depotNames = depotNames
 	return i.Next()
 }
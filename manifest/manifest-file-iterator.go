@@ -15,6 +15,11 @@ type FileInfo struct {
 	Checksum string
 	Size     int64
 	Url      string
+	//Accounts that can download the file, in order of preference. Empty for single account manifests.
+	Accounts []string
+	//Os and languages of installers, for storages that sort installers in directories by os and language
+	Os        string   `json:",omitempty"`
+	Languages []string `json:",omitempty"`
 }
 
 type ManifestFileIterator struct {
@@ -22,6 +27,7 @@ type ManifestFileIterator struct {
 	currentGame      int
 	currentInstaller int
 	currentExtra     int
+	currentDepot     int
 }
 
 func NewManifestFileInterator(m *Manifest) ManifestFileIterator {
@@ -30,6 +36,7 @@ func NewManifestFileInterator(m *Manifest) ManifestFileIterator {
 		currentGame:      0,
 		currentInstaller: 0,
 		currentExtra:     0,
+		currentDepot:     0,
 	}
 
 	return new
@@ -41,11 +48,12 @@ func (i *ManifestFileIterator) HasMore() bool {
 		return true
 	}
 
-	//If its the last game, check to see if there is an installer or extra left to fetch
+	//If its the last game, check to see if there is an installer, extra or depot left to fetch
 	currentGame := (*(*i).manifestPtr).Games[(*i).currentGame]
 	notLastInstaller := (*i).currentInstaller < len(currentGame.Installers)
 	notLastExtra := (*i).currentExtra < len(currentGame.Extras)
-	return notLastInstaller || notLastExtra
+	notLastDepot := (*i).currentDepot < len(currentGame.Depots)
+	return notLastInstaller || notLastExtra || notLastDepot
 }
 
 func (i *ManifestFileIterator) Next() (FileInfo, error) {
@@ -63,12 +71,15 @@ func (i *ManifestFileIterator) Next() (FileInfo, error) {
 	currentGame := (*(*i).manifestPtr).Games[(*i).currentGame]
 	if (*i).currentInstaller < len(currentGame.Installers) {
 		new := FileInfo{
-			Game:     GameInfo{Id: currentGame.Id, Slug: currentGame.Slug, Title: currentGame.Title},
-			Kind:     "installer",
-			Name:     currentGame.Installers[(*i).currentInstaller].Name,
-			Checksum: currentGame.Installers[(*i).currentInstaller].Checksum,
-			Size:     currentGame.Installers[(*i).currentInstaller].VerifiedSize,
-			Url:      currentGame.Installers[(*i).currentInstaller].Url,
+			Game:      GameInfo{Id: currentGame.Id, Slug: currentGame.Slug, Title: currentGame.Title},
+			Kind:      "installer",
+			Name:      currentGame.Installers[(*i).currentInstaller].Name,
+			Checksum:  currentGame.Installers[(*i).currentInstaller].Checksum,
+			Size:      currentGame.Installers[(*i).currentInstaller].VerifiedSize,
+			Url:       currentGame.Installers[(*i).currentInstaller].Url,
+			Accounts:  currentGame.GetDownloadAccounts(currentGame.Installers[(*i).currentInstaller].Account),
+			Os:        currentGame.Installers[(*i).currentInstaller].Os,
+			Languages: currentGame.Installers[(*i).currentInstaller].Languages,
 		}
 		(*i).currentInstaller++
 		return new, nil
@@ -80,13 +91,27 @@ func (i *ManifestFileIterator) Next() (FileInfo, error) {
 			Checksum: currentGame.Extras[(*i).currentExtra].Checksum,
 			Size:     currentGame.Extras[(*i).currentExtra].VerifiedSize,
 			Url:      currentGame.Extras[(*i).currentExtra].Url,
+			Accounts: currentGame.GetDownloadAccounts(currentGame.Extras[(*i).currentExtra].Account),
 		}
 		(*i).currentExtra++
 		return new, nil
+	} else if (*i).currentDepot < len(currentGame.Depots) {
+		new := FileInfo{
+			Game:     GameInfo{Id: currentGame.Id, Slug: currentGame.Slug, Title: currentGame.Title},
+			Kind:     "depot",
+			Name:     currentGame.Depots[(*i).currentDepot].Name,
+			Checksum: currentGame.Depots[(*i).currentDepot].Checksum,
+			Size:     currentGame.Depots[(*i).currentDepot].VerifiedSize,
+			Url:      currentGame.Depots[(*i).currentDepot].Url,
+			Accounts: currentGame.GetDownloadAccounts(currentGame.Depots[(*i).currentDepot].Account),
+		}
+		(*i).currentDepot++
+		return new, nil
 	} else {
 		(*i).currentGame++
 		(*i).currentInstaller = 0
 		(*i).currentExtra = 0
+		(*i).currentDepot = 0
 		return i.Next()
 	}
 }
@@ -5,24 +5,65 @@ import (
 	"sync"
 )
 
+//An empty patches value keeps all patches so that filters of older manifests keep their behavior
+const (
+	PatchesAll    string = "all"
+	PatchesLatest        = "latest"
+	PatchesNone          = "none"
+)
+
+func getPatchesRank(patches string) int {
+	if patches == PatchesNone {
+		return 0
+	} else if patches == PatchesLatest {
+		return 1
+	}
+	return 2
+}
+
+//Files are kept by the os, language, type and url predicates and games by the title, tag, url, id, owned games fields,
+//release year, installer date and size predicates. MaxGameSize applies to the files left after the others are trimmed.
+//Installer dates are given as YYYY-MM-DD and, like the other bounds, a zero value leaves them open.
+//Policy rules override the file selection for the games they match.
 type ManifestFilter struct {
-	Titles          []string
-	Oses            []string
-	Languages       []string
-	Tags            []string
-	Installers      bool
-	Extras          bool
-	ExtraTypes      []string
-	SkipUrls        []string
-	HasUrls         []string
-	Intersections   []ManifestFilter
-	hasUrlsRegexes  []*regexp.Regexp
-	skipUrlsRegexes []*regexp.Regexp
-	hasUrlsOnce     sync.Once
-	skipUrlsOnce    sync.Once
-}
-
-func NewManifestFilter(titles []string, oses []string, languages []string, tags []string, installers bool, extras bool, extraTypes []string, skipUrls []string, hasUrls []string) ManifestFilter {
+	Titles                 []string
+	Oses                   []string
+	Languages              []string
+	Tags                   []string
+	Installers             bool
+	Extras                 bool
+	Depots                 bool
+	DepotFormat            string
+	Patches                string
+	SkipLanguagePacks      bool
+	SkipDlcs               bool
+	SkipOrphanDlcs         bool
+	ExtraTypes             []string
+	SkipUrls               []string
+	HasUrls                []string
+	SkipTitles             []string             `json:",omitempty"`
+	SkipTags               []string             `json:",omitempty"`
+	SkipIds                []int64              `json:",omitempty"`
+	MaxGameSize            int64                `json:",omitempty"`
+	InstallersSince        string               `json:",omitempty"`
+	InstallersUntil        string               `json:",omitempty"`
+	MinReleaseYear         int                  `json:",omitempty"`
+	MaxReleaseYear         int                  `json:",omitempty"`
+	Categories             []string             `json:",omitempty"`
+	MinRating              int                  `json:",omitempty"`
+	WorksOn                []string             `json:",omitempty"`
+	SkipHidden             bool                 `json:",omitempty"`
+	SkipInDevelopment      bool                 `json:",omitempty"`
+	SkipGalaxyIncompatible bool                 `json:",omitempty"`
+	PolicyRules            []ManifestPolicyRule `json:",omitempty"`
+	Intersections          []ManifestFilter
+	hasUrlsRegexes         []*regexp.Regexp
+	skipUrlsRegexes        []*regexp.Regexp
+	hasUrlsOnce            sync.Once
+	skipUrlsOnce           sync.Once
+}
+
+func NewManifestFilter(titles []string, oses []string, languages []string, tags []string, installers bool, extras bool, depots bool, extraTypes []string, skipUrls []string, hasUrls []string) ManifestFilter {
 	newFilter := ManifestFilter{
 		Titles:          titles,
 		Oses:            oses,
@@ -30,6 +71,7 @@ func NewManifestFilter(titles []string, oses []string, languages []string, tags
 		Tags:            tags,
 		Installers:      installers,
 		Extras:          extras,
+		Depots:          depots,
 		ExtraTypes:      extraTypes,
 		SkipUrls:        skipUrls,
 		HasUrls:         hasUrls,
@@ -48,10 +90,31 @@ func (f *ManifestFilter) Copy() *ManifestFilter {
 		f.Tags,
 		f.Installers,
 		f.Extras,
+		f.Depots,
 		f.ExtraTypes,
 		f.SkipUrls,
 		f.HasUrls,
 	)
+	newFilter.DepotFormat = f.DepotFormat
+	newFilter.Patches = f.Patches
+	newFilter.SkipLanguagePacks = f.SkipLanguagePacks
+	newFilter.SkipDlcs = f.SkipDlcs
+	newFilter.SkipOrphanDlcs = f.SkipOrphanDlcs
+	newFilter.SkipTitles = f.SkipTitles
+	newFilter.SkipTags = f.SkipTags
+	newFilter.SkipIds = f.SkipIds
+	newFilter.MaxGameSize = f.MaxGameSize
+	newFilter.InstallersSince = f.InstallersSince
+	newFilter.InstallersUntil = f.InstallersUntil
+	newFilter.MinReleaseYear = f.MinReleaseYear
+	newFilter.MaxReleaseYear = f.MaxReleaseYear
+	newFilter.Categories = f.Categories
+	newFilter.MinRating = f.MinRating
+	newFilter.WorksOn = f.WorksOn
+	newFilter.SkipHidden = f.SkipHidden
+	newFilter.SkipInDevelopment = f.SkipInDevelopment
+	newFilter.SkipGalaxyIncompatible = f.SkipGalaxyIncompatible
+	newFilter.PolicyRules = f.PolicyRules
 	return &newFilter
 }
 
@@ -112,7 +175,86 @@ func (f *ManifestFilter) IsEmpty() bool {
 	isEmpty = isEmpty && len((*f).ExtraTypes) == 0 && len((*f).Intersections) == 0
 	isEmpty = isEmpty && len((*f).SkipUrls) == 0
 	isEmpty = isEmpty && len((*f).HasUrls) == 0
-	return isEmpty
+	isEmpty = isEmpty && len((*f).PolicyRules) == 0
+	return isEmpty && (!f.HasGamePredicates())
+}
+
+//Returns the filter that selects the files of the game and the name of the policy rule it comes from, if any
+func (f *ManifestFilter) GetGameFilter(g *ManifestGame) (*ManifestFilter, string) {
+	for idx, _ := range (*f).PolicyRules {
+		rule := &(*f).PolicyRules[idx]
+		if rule.Matches(g) {
+			return rule.ApplyTo(f), (*rule).Name
+		}
+	}
+	return f, ""
+}
+
+//Whether depots are kept for some games, by the filter or one of its policy rules
+func (f *ManifestFilter) KeepsDepots() bool {
+	if (*f).Depots {
+		return true
+	}
+	for _, rule := range (*f).PolicyRules {
+		if rule.Depots != nil && *rule.Depots {
+			return true
+		}
+	}
+	return false
+}
+
+//Whether the filter has predicates on the games, beyond its titles, tags and urls
+func (f *ManifestFilter) HasGamePredicates() bool {
+	hasPredicates := len((*f).SkipTitles) > 0 || len((*f).SkipTags) > 0 || len((*f).SkipIds) > 0
+	hasPredicates = hasPredicates || (*f).MaxGameSize > 0 || (*f).InstallersSince != "" || (*f).InstallersUntil != ""
+	hasPredicates = hasPredicates || (*f).MinReleaseYear > 0 || (*f).MaxReleaseYear > 0
+	hasPredicates = hasPredicates || len((*f).Categories) > 0 || (*f).MinRating > 0 || len((*f).WorksOn) > 0
+	hasPredicates = hasPredicates || (*f).SkipHidden || (*f).SkipInDevelopment || (*f).SkipGalaxyIncompatible
+	return hasPredicates
+}
+
+func concatIdsUnique(ids []int64, otherIds []int64) []int64 {
+	result := append([]int64{}, ids...)
+	for _, otherId := range otherIds {
+		found := false
+		for _, id := range ids {
+			if id == otherId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, otherId)
+		}
+	}
+	return result
+}
+
+//Keeps the tightest of two bounds, zero values being unbounded
+func getTighterBound(bound int64, otherBound int64, isUpper bool) int64 {
+	if bound == 0 {
+		return otherBound
+	}
+	if otherBound == 0 {
+		return bound
+	}
+	if (isUpper && otherBound < bound) || ((!isUpper) && otherBound > bound) {
+		return otherBound
+	}
+	return bound
+}
+
+func getTighterDate(date string, otherDate string, isUpper bool) string {
+	if date == "" {
+		return otherDate
+	}
+	if otherDate == "" {
+		return date
+	}
+	if (isUpper && otherDate < date) || ((!isUpper) && otherDate > date) {
+		return otherDate
+	}
+	return date
 }
 
 func (f *ManifestFilter) Intersect(other ManifestFilter) *ManifestFilter {
@@ -145,6 +287,24 @@ func (f *ManifestFilter) Intersect(other ManifestFilter) *ManifestFilter {
 	if newFilter.Extras {
 		newFilter.Extras = otherCopy.Extras
 	}
+	if newFilter.Depots {
+		newFilter.Depots = otherCopy.Depots
+	}
+	if newFilter.DepotFormat == "" {
+		newFilter.DepotFormat = otherCopy.DepotFormat
+	}
+	if getPatchesRank(otherCopy.Patches) < getPatchesRank(newFilter.Patches) {
+		newFilter.Patches = otherCopy.Patches
+	}
+	if otherCopy.SkipLanguagePacks {
+		newFilter.SkipLanguagePacks = true
+	}
+	if otherCopy.SkipDlcs {
+		newFilter.SkipDlcs = true
+	}
+	if otherCopy.SkipOrphanDlcs {
+		newFilter.SkipOrphanDlcs = true
+	}
 	if len(newFilter.SkipUrls) == 0 {
 		newFilter.SkipUrls = otherCopy.SkipUrls
 		otherCopy.SkipUrls = []string{}
@@ -153,6 +313,44 @@ func (f *ManifestFilter) Intersect(other ManifestFilter) *ManifestFilter {
 		newFilter.HasUrls = otherCopy.HasUrls
 		otherCopy.HasUrls = []string{}
 	}
+	if len(newFilter.Categories) == 0 {
+		newFilter.Categories = otherCopy.Categories
+		otherCopy.Categories = []string{}
+	}
+	if len(newFilter.WorksOn) == 0 {
+		newFilter.WorksOn = otherCopy.WorksOn
+		otherCopy.WorksOn = []string{}
+	}
+	//Policy rules are not merged as only the first matching rule applies to a game
+	if len(newFilter.PolicyRules) == 0 {
+		newFilter.PolicyRules = otherCopy.PolicyRules
+	}
+	otherCopy.PolicyRules = []ManifestPolicyRule{}
+	//Exclusions and bounds combine without needing an intersection
+	newFilter.SkipTitles = ConcatStringSlicesUnique(append([]string{}, newFilter.SkipTitles...), otherCopy.SkipTitles)
+	newFilter.SkipTags = ConcatStringSlicesUnique(append([]string{}, newFilter.SkipTags...), otherCopy.SkipTags)
+	newFilter.SkipIds = concatIdsUnique(newFilter.SkipIds, otherCopy.SkipIds)
+	newFilter.MaxGameSize = getTighterBound(newFilter.MaxGameSize, otherCopy.MaxGameSize, true)
+	newFilter.InstallersSince = getTighterDate(newFilter.InstallersSince, otherCopy.InstallersSince, false)
+	newFilter.InstallersUntil = getTighterDate(newFilter.InstallersUntil, otherCopy.InstallersUntil, true)
+	newFilter.MinReleaseYear = int(getTighterBound(int64(newFilter.MinReleaseYear), int64(otherCopy.MinReleaseYear), false))
+	newFilter.MaxReleaseYear = int(getTighterBound(int64(newFilter.MaxReleaseYear), int64(otherCopy.MaxReleaseYear), true))
+	newFilter.MinRating = int(getTighterBound(int64(newFilter.MinRating), int64(otherCopy.MinRating), false))
+	newFilter.SkipHidden = newFilter.SkipHidden || otherCopy.SkipHidden
+	newFilter.SkipInDevelopment = newFilter.SkipInDevelopment || otherCopy.SkipInDevelopment
+	newFilter.SkipGalaxyIncompatible = newFilter.SkipGalaxyIncompatible || otherCopy.SkipGalaxyIncompatible
+	otherCopy.SkipTitles = []string{}
+	otherCopy.SkipTags = []string{}
+	otherCopy.SkipIds = []int64{}
+	otherCopy.MaxGameSize = 0
+	otherCopy.InstallersSince = ""
+	otherCopy.InstallersUntil = ""
+	otherCopy.MinReleaseYear = 0
+	otherCopy.MaxReleaseYear = 0
+	otherCopy.MinRating = 0
+	otherCopy.SkipHidden = false
+	otherCopy.SkipInDevelopment = false
+	otherCopy.SkipGalaxyIncompatible = false
 	if (!otherCopy.IsEmpty()) || len(otherCopy.Intersections) > 0 {
 		intersections := otherCopy.Intersections
 		otherCopy.Intersections = []ManifestFilter{}
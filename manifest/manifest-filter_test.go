@@ -0,0 +1,49 @@
+package manifest
+
+import "testing"
+
+func TestManifestFilterIntersectPredicates(t *testing.T) {
+	filter := ManifestFilter{
+		SkipTitles:      []string{"demo"},
+		SkipIds:         []int64{1},
+		MaxGameSize:     2000,
+		InstallersSince: "2020-01-01",
+		MinReleaseYear:  1990,
+		Categories:      []string{"Strategy"},
+	}
+	result := filter.Intersect(ManifestFilter{
+		SkipTitles:      []string{"demo", "soundtrack"},
+		SkipIds:         []int64{2},
+		MaxGameSize:     1000,
+		InstallersSince: "2019-01-01",
+		InstallersUntil: "2021-01-01",
+		MaxReleaseYear:  2000,
+		SkipHidden:      true,
+	})
+	if len((*result).SkipTitles) != 2 || len((*result).SkipIds) != 2 {
+		t.Errorf("Expected exclusions to be combined and got %v and %v", (*result).SkipTitles, (*result).SkipIds)
+	}
+	if (*result).MaxGameSize != 1000 || (*result).InstallersSince != "2020-01-01" || (*result).InstallersUntil != "2021-01-01" {
+		t.Errorf("Expected the tightest bounds to be kept")
+	}
+	if (*result).MinReleaseYear != 1990 || (*result).MaxReleaseYear != 2000 || (!(*result).SkipHidden) {
+		t.Errorf("Expected the release years and flags of both filters to be kept")
+	}
+	if len((*result).Intersections) != 0 {
+		t.Errorf("Expected predicates to combine without intersections")
+	}
+	if len(filter.SkipTitles) != 1 {
+		t.Errorf("Expected the intersected filter not to be modified")
+	}
+}
+
+func TestManifestFilterIsEmptyWithPredicates(t *testing.T) {
+	filter := ManifestFilter{}
+	if !filter.IsEmpty() {
+		t.Errorf("Expected a filter without predicates to be empty")
+	}
+	filter.SkipGalaxyIncompatible = true
+	if filter.IsEmpty() || !filter.HasGamePredicates() {
+		t.Errorf("Expected a filter with predicates not to be empty")
+	}
+}
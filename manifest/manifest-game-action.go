@@ -17,6 +17,7 @@ type GameAction struct {
 	Action           string
 	InstallerActions map[string]FileAction
 	ExtraActions     map[string]FileAction
+	DepotActions     map[string]FileAction `json:",omitempty"`
 }
 
 func (g *GameAction) Update(n *GameAction) error {
@@ -36,6 +37,14 @@ func (g *GameAction) Update(n *GameAction) error {
 		(*g).ExtraActions[name] = (*n).ExtraActions[name]
 	}
 
+	if (*g).DepotActions == nil && len((*n).DepotActions) > 0 {
+		(*g).DepotActions = make(map[string]FileAction)
+	}
+
+	for name, _ := range (*n).DepotActions {
+		(*g).DepotActions[name] = (*n).DepotActions[name]
+	}
+
 	return nil
 }
 
@@ -67,8 +76,20 @@ func (g *GameAction) GetExtraNames() []string {
 	return extraNames
 }
 
+func (g *GameAction) GetDepotNames() []string {
+	depotNames := make([]string, len((*g).DepotActions))
+
+	idx := 0
+	for name, _ := range (*g).DepotActions {
+		depotNames[idx] = name
+		idx++
+	}
+
+	return depotNames
+}
+
 func (g *GameAction) CountFileActions() int {
-	return len((*g).InstallerActions) + len((*g).ExtraActions)
+	return len((*g).InstallerActions) + len((*g).ExtraActions) + len((*g).DepotActions)
 }
 
 func (g *GameAction) ActionsLeft() int {
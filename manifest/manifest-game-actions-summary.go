@@ -8,6 +8,8 @@ type GamesActionsSummary struct {
 	InstallerDeletions int
 	ExtraUpserts       int
 	ExtraDeletions     int
+	DepotUpserts       int
+	DepotDeletions     int
 }
 
 type GameFilesActionsSummary struct {
@@ -15,10 +17,12 @@ type GameFilesActionsSummary struct {
 	InstallerDeletions int
 	ExtraUpserts       int
 	ExtraDeletions     int
+	DepotUpserts       int
+	DepotDeletions     int
 }
 
 func (g *GameAction) GetFilesActionSummary() GameFilesActionsSummary {
-	summary := GameFilesActionsSummary{InstallerUpserts: 0, InstallerDeletions: 0, ExtraUpserts: 0, ExtraDeletions: 0}
+	summary := GameFilesActionsSummary{InstallerUpserts: 0, InstallerDeletions: 0, ExtraUpserts: 0, ExtraDeletions: 0, DepotUpserts: 0, DepotDeletions: 0}
 	for _, fileAction := range (*g).InstallerActions {
 		if fileAction.Action == "add" {
 			summary.InstallerUpserts += 1
@@ -35,6 +39,14 @@ func (g *GameAction) GetFilesActionSummary() GameFilesActionsSummary {
 		}
 	}
 
+	for _, fileAction := range (*g).DepotActions {
+		if fileAction.Action == "add" {
+			summary.DepotUpserts += 1
+		} else {
+			summary.DepotDeletions += 1
+		}
+	}
+
 	return summary
 }
 
@@ -47,6 +59,8 @@ func (g *GameActions) GetSummary() GamesActionsSummary {
 		InstallerDeletions: 0,
 		ExtraUpserts:       0,
 		ExtraDeletions:     0,
+		DepotUpserts:       0,
+		DepotDeletions:     0,
 	}
 
 	for _, gameAction := range *g {
@@ -62,6 +76,8 @@ func (g *GameActions) GetSummary() GamesActionsSummary {
 		summary.InstallerDeletions += gameSummary.InstallerDeletions
 		summary.ExtraUpserts += gameSummary.ExtraUpserts
 		summary.ExtraDeletions += gameSummary.ExtraDeletions
+		summary.DepotUpserts += gameSummary.DepotUpserts
+		summary.DepotDeletions += gameSummary.DepotDeletions
 	}
 
 	return summary
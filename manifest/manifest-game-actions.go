@@ -45,6 +45,8 @@ func (g *GameActions) ApplyAction(a Action) {
 		game := (*g)[gameId]
 		if (*a.FileActionPtr).Kind == "installer" {
 			delete(game.InstallerActions, (*a.FileActionPtr).Name)
+		} else if (*a.FileActionPtr).Kind == "depot" {
+			delete(game.DepotActions, (*a.FileActionPtr).Name)
 		} else {
 			delete(game.ExtraActions, (*a.FileActionPtr).Name)
 		}
@@ -87,6 +89,7 @@ func (g *GameActions) DeepCopy() *GameActions {
 			Action:           (*g)[id].Action,
 			InstallerActions: make(map[string]FileAction),
 			ExtraActions:     make(map[string]FileAction),
+			DepotActions:     make(map[string]FileAction),
 		}
 
 		for name, inst := range (*g)[id].InstallerActions {
@@ -97,6 +100,10 @@ func (g *GameActions) DeepCopy() *GameActions {
 			newGame.ExtraActions[name] = extr
 		}
 
+		for name, depot := range (*g)[id].DepotActions {
+			newGame.DepotActions[name] = depot
+		}
+
 		new[id] = newGame
 	}
 
@@ -111,6 +118,7 @@ func planManifestGameAddOrRemove(m *ManifestGame, action string) (GameAction, er
 		Action:           action,
 		InstallerActions: make(map[string]FileAction),
 		ExtraActions:     make(map[string]FileAction),
+		DepotActions:     make(map[string]FileAction),
 	}
 
 	if action != "add" && action != "remove" {
@@ -137,6 +145,16 @@ func planManifestGameAddOrRemove(m *ManifestGame, action string) (GameAction, er
 		}
 	}
 
+	for _, d := range (*m).Depots {
+		g.DepotActions[d.Name] = FileAction{
+			Title:  d.Title,
+			Name:   d.Name,
+			Url:    d.Url,
+			Kind:   "depot",
+			Action: action,
+		}
+	}
+
 	return g, nil
 }
 
@@ -148,6 +166,7 @@ func planManifestGameUpdate(curr *ManifestGame, next *ManifestGame, checksumVali
 		Action:           "update",
 		InstallerActions: make(map[string]FileAction),
 		ExtraActions:     make(map[string]FileAction),
+		DepotActions:     make(map[string]FileAction),
 	}
 
 	currentInstallers := make(map[string]ManifestGameInstaller)
@@ -210,6 +229,36 @@ func planManifestGameUpdate(curr *ManifestGame, next *ManifestGame, checksumVali
 		}
 	}
 
+	currentDepots := make(map[string]ManifestGameDepot)
+	futureDepots := make(map[string]ManifestGameDepot)
+
+	for _, d := range (*curr).Depots {
+		currentDepots[d.Name] = d
+	}
+
+	for _, d := range (*next).Depots {
+		futureDepots[d.Name] = d
+	}
+
+	for name, depot := range futureDepots {
+		if val, ok := currentDepots[name]; ok {
+			if !depot.IsEquivalentTo(&val, checksumValidation, ignoreMetadata) {
+				//Overwrite
+				g.DepotActions[name] = FileAction{Title: depot.Title, Name: depot.Name, Url: depot.Url, Kind: "depot", Action: "add"}
+			}
+		} else {
+			//Add missing file
+			g.DepotActions[name] = FileAction{Title: depot.Title, Name: depot.Name, Url: depot.Url, Kind: "depot", Action: "add"}
+		}
+	}
+
+	for name, depot := range currentDepots {
+		if _, ok := futureDepots[name]; !ok {
+			//Remove dangling file
+			g.DepotActions[name] = FileAction{Title: depot.Title, Name: depot.Name, Url: depot.Url, Kind: "depot", Action: "remove"}
+		}
+	}
+
 	return g
 }
 
@@ -258,13 +307,30 @@ func (m *Manifest) GetFileActionFileInfo(gameInfo GameInfo, action FileAction) (
 				if err != nil {
 					return FileInfo{}, err
 				}
+				return FileInfo{
+					Game:      gameInfo,
+					Kind:      "installer",
+					Name:      installer.Name,
+					Checksum:  installer.Checksum,
+					Size:      installer.VerifiedSize,
+					Url:       installer.Url,
+					Accounts:  game.GetDownloadAccounts(installer.Account),
+					Os:        installer.Os,
+					Languages: installer.Languages,
+				}, nil
+			} else if action.Kind == "depot" {
+				depot, err := game.GetDepotNamed(action.Name)
+				if err != nil {
+					return FileInfo{}, err
+				}
 				return FileInfo{
 					Game:     gameInfo,
-					Kind:     "installer",
-					Name:     installer.Name,
-					Checksum: installer.Checksum,
-					Size:     installer.VerifiedSize,
-					Url:      installer.Url,
+					Kind:     "depot",
+					Name:     depot.Name,
+					Checksum: depot.Checksum,
+					Size:     depot.VerifiedSize,
+					Url:      depot.Url,
+					Accounts: game.GetDownloadAccounts(depot.Account),
 				}, nil
 			} else {
 				extra, err := game.GetExtraNamed(action.Name)
@@ -278,6 +344,7 @@ func (m *Manifest) GetFileActionFileInfo(gameInfo GameInfo, action FileAction) (
 					Checksum: extra.Checksum,
 					Size:     extra.VerifiedSize,
 					Url:      extra.Url,
+					Accounts: game.GetDownloadAccounts(extra.Account),
 				}, err
 			}
 		}
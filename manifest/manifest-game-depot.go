@@ -0,0 +1,64 @@
+package manifest
+
+//Galaxy depots are stored as a single tar archive, either of the reassembled files or of the depot's chunks
+const (
+	DepotFormatFiles  string = "files"
+	DepotFormatChunks        = "chunks"
+)
+
+type ManifestGameDepot struct {
+	Languages     []string
+	Os            string
+	Url           string
+	Title         string
+	Name          string
+	ProductId     int64
+	BuildId       string
+	Manifest      string
+	Format        string
+	Version       string
+	Date          string
+	EstimatedSize string
+	VerifiedSize  int64
+	Checksum      string
+	Account       string `json:",omitempty"`
+}
+
+func (d *ManifestGameDepot) HasOneOfOses(oses []string) bool {
+	for _, os := range oses {
+		if os == d.Os {
+			return true
+		}
+	}
+	return false
+}
+
+//Depots without languages contain files shared by all languages
+func (d *ManifestGameDepot) HasOneOfLanguages(languages []string) bool {
+	if len(d.Languages) == 0 {
+		return true
+	}
+
+	for _, l := range languages {
+		for _, l2 := range d.Languages {
+			if l == l2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (d *ManifestGameDepot) IsEquivalentTo(o *ManifestGameDepot, checksumValidation string, ignoreMetadata bool) bool {
+	sameName := (*d).Name == (*o).Name
+	sameTitle := ((*d).Title == (*o).Title) || ignoreMetadata
+	sameUrl := ((*d).Url == (*o).Url) || ignoreMetadata
+	sameVerifiedSize := (*o).VerifiedSize != 0 && (*d).VerifiedSize == (*o).VerifiedSize
+	checksumIsEmptyAndItsOk := checksumValidation == ChecksumValidationIfPresent && ((*d).Checksum == "" || (*o).Checksum == "")
+	sameChecksum := (*o).Checksum != "" && (*d).Checksum == (*o).Checksum
+	return sameName && sameTitle && sameUrl && sameVerifiedSize && (checksumValidation == ChecksumNoValidation || sameChecksum || checksumIsEmptyAndItsOk)
+}
+
+func (d *ManifestGameDepot) GetEstimatedSizeInBytes() (int64, error) {
+	return GetEstimateToBytes((*d).EstimatedSize)
+}
@@ -0,0 +1,83 @@
+package manifest
+
+import (
+	"testing"
+)
+
+func getDepotFixture() ManifestGameDepot {
+	return ManifestGameDepot{
+		Languages:     []string{"french"},
+		Os:            "linux",
+		Url:           "galaxy://1/abcdef?format=files",
+		Title:         "linux depot (fr-FR)",
+		Name:          "depot_linux_abcdef.tar",
+		ProductId:     1,
+		BuildId:       "123",
+		Manifest:      "abcdef",
+		Format:        DepotFormatFiles,
+		Version:       "vDontKnow",
+		Date:          "2111-11-11",
+		EstimatedSize: "1 KB",
+		VerifiedSize:  1000,
+		Checksum:      "sdfdsfsdfdsfwe",
+	}
+}
+
+func TestManifestGameDepotHasOneOfLanguages(t *testing.T) {
+	depot := getDepotFixture()
+
+	if depot.HasOneOfLanguages([]string{"english", "german"}) {
+		t.Errorf("Should not indicate it has a language it doesn't have")
+	}
+
+	if !depot.HasOneOfLanguages([]string{"english", "french"}) {
+		t.Errorf("Should indicate it has a language it has")
+	}
+
+	depot.Languages = []string{}
+	if !depot.HasOneOfLanguages([]string{"english"}) {
+		t.Errorf("Depot without languages should match any language")
+	}
+}
+
+func TestManifestGameDepotIsEquivalentTo(t *testing.T) {
+	depot := getDepotFixture()
+	other := getDepotFixture()
+
+	if !depot.IsEquivalentTo(&other, ChecksumValidation, false) {
+		t.Errorf("Identical depots should be equivalent")
+	}
+
+	other.Url = "galaxy://1/abcdef?format=chunks"
+	if depot.IsEquivalentTo(&other, ChecksumValidation, false) {
+		t.Errorf("Depots with different urls should not be equivalent")
+	}
+	if !depot.IsEquivalentTo(&other, ChecksumValidation, true) {
+		t.Errorf("Depots with different urls should be equivalent when metadata is ignored")
+	}
+
+	other = getDepotFixture()
+	other.Checksum = ""
+	if depot.IsEquivalentTo(&other, ChecksumValidation, false) {
+		t.Errorf("Depot without checksum should not be equivalent with checksum validation")
+	}
+	if !depot.IsEquivalentTo(&other, ChecksumValidationIfPresent, false) {
+		t.Errorf("Depot without checksum should be equivalent when validating checksums if present")
+	}
+}
+
+func TestManifestGameTrimDepots(t *testing.T) {
+	windows := getDepotFixture()
+	windows.Os = "windows"
+	windows.Name = "depot_windows_abcdef.tar"
+	shared := getDepotFixture()
+	shared.Languages = []string{}
+	shared.Name = "depot_linux_shared.tar"
+
+	game := ManifestGame{Id: 1, Title: "Game", Depots: []ManifestGameDepot{getDepotFixture(), windows, shared}}
+	game.TrimDepots([]string{"linux"}, []string{"english"}, true, func(url string) bool { return false })
+
+	if len(game.Depots) != 1 || game.Depots[0].Name != "depot_linux_shared.tar" {
+		t.Errorf("Expected only the shared linux depot to remain and got %v", game.Depots)
+	}
+}
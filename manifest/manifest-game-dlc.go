@@ -0,0 +1,83 @@
+package manifest
+
+import "strings"
+
+//Files of dlcs are stored with their base game and reference the dlc they belong to by its title.
+//The dlcs of a game are recomputed from its files whenever the manifest is finalized.
+type ManifestGameDlc struct {
+	Title        string
+	Installers   []string
+	Extras       []string
+	VerifiedSize int64
+}
+
+func (g *ManifestGame) ComputeDlcs() {
+	dlcs := []ManifestGameDlc{}
+	indexes := map[string]int{}
+
+	getDlcIndex := func(title string) int {
+		idx, ok := indexes[title]
+		if !ok {
+			idx = len(dlcs)
+			indexes[title] = idx
+			dlcs = append(dlcs, ManifestGameDlc{Title: title, Installers: []string{}, Extras: []string{}})
+		}
+		return idx
+	}
+
+	for _, installer := range (*g).Installers {
+		if installer.Dlc == "" {
+			continue
+		}
+		idx := getDlcIndex(installer.Dlc)
+		dlcs[idx].Installers = append(dlcs[idx].Installers, installer.Name)
+		dlcs[idx].VerifiedSize += installer.VerifiedSize
+	}
+
+	for _, extra := range (*g).Extras {
+		if extra.Dlc == "" {
+			continue
+		}
+		idx := getDlcIndex(extra.Dlc)
+		dlcs[idx].Extras = append(dlcs[idx].Extras, extra.Name)
+		dlcs[idx].VerifiedSize += extra.VerifiedSize
+	}
+
+	(*g).Dlcs = dlcs
+}
+
+func (g *ManifestGame) HasDlcTitleTerms(titleTerms []string) bool {
+	for _, dlc := range (*g).Dlcs {
+		for _, term := range titleTerms {
+			if strings.Contains(strings.ToLower(dlc.Title), strings.ToLower(term)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//Leaves only the files of the base game
+func (g *ManifestGame) TrimDlcs(skipDlcs bool) {
+	if !skipDlcs {
+		return
+	}
+
+	filteredInstallers := make([]ManifestGameInstaller, 0)
+	for _, installer := range (*g).Installers {
+		if installer.Dlc == "" {
+			filteredInstallers = append(filteredInstallers, installer)
+		}
+	}
+
+	filteredExtras := make([]ManifestGameExtra, 0)
+	for _, extra := range (*g).Extras {
+		if extra.Dlc == "" {
+			filteredExtras = append(filteredExtras, extra)
+		}
+	}
+
+	(*g).Installers = filteredInstallers
+	(*g).Extras = filteredExtras
+	(*g).Dlcs = []ManifestGameDlc{}
+}
@@ -0,0 +1,72 @@
+package manifest
+
+import (
+	"testing"
+)
+
+func getGameWithDlcsFixture() ManifestGame {
+	return ManifestGame{
+		Id:    1,
+		Title: "The Base Game",
+		Installers: []ManifestGameInstaller{
+			ManifestGameInstaller{Os: "windows", Name: "setup_base.exe", VerifiedSize: 100},
+			ManifestGameInstaller{Os: "windows", Name: "setup_dlc1.exe", Dlc: "First Expansion", VerifiedSize: 10},
+			ManifestGameInstaller{Os: "linux", Name: "dlc1.sh", Dlc: "First Expansion", VerifiedSize: 20},
+		},
+		Extras: []ManifestGameExtra{
+			ManifestGameExtra{Name: "manual.pdf", VerifiedSize: 1},
+			ManifestGameExtra{Name: "soundtrack.zip", Dlc: "Soundtrack", VerifiedSize: 5},
+		},
+	}
+}
+
+func TestManifestGameComputeDlcs(t *testing.T) {
+	game := getGameWithDlcsFixture()
+	game.ComputeDlcs()
+
+	if len(game.Dlcs) != 2 {
+		t.Fatalf("Expected 2 dlcs and got %d", len(game.Dlcs))
+	}
+
+	if game.Dlcs[0].Title != "First Expansion" || len(game.Dlcs[0].Installers) != 2 || game.Dlcs[0].VerifiedSize != 30 {
+		t.Errorf("First dlc was not grouped properly: %v", game.Dlcs[0])
+	}
+
+	if game.Dlcs[1].Title != "Soundtrack" || len(game.Dlcs[1].Extras) != 1 || len(game.Dlcs[1].Installers) != 0 {
+		t.Errorf("Second dlc was not grouped properly: %v", game.Dlcs[1])
+	}
+
+	if !game.HasDlcTitleTerms([]string{"expansion"}) {
+		t.Errorf("Game should match the title of its dlcs")
+	}
+}
+
+func TestManifestGameTrimDlcs(t *testing.T) {
+	game := getGameWithDlcsFixture()
+	game.ComputeDlcs()
+	game.TrimDlcs(true)
+
+	if len(game.Installers) != 1 || len(game.Extras) != 1 || len(game.Dlcs) != 0 {
+		t.Errorf("Only the files of the base game should remain")
+	}
+}
+
+func TestManifestTrimOrphanDlcs(t *testing.T) {
+	orphan := getGameWithDlcsFixture()
+	orphan.Id = 2
+	orphan.IsBaseProductMissing = true
+	m := Manifest{Games: []ManifestGame{getGameWithDlcsFixture(), orphan}}
+	m.Filter.Installers = true
+	m.Filter.Extras = true
+
+	m.Trim()
+	if len(m.Games) != 2 {
+		t.Errorf("Dlc without base game should be kept by a filter that keeps them")
+	}
+
+	m.Filter.SkipOrphanDlcs = true
+	m.Trim()
+	if len(m.Games) != 1 || m.Games[0].Id != 1 {
+		t.Errorf("Dlc without base game should be trimmed by a filter that skips them")
+	}
+}
@@ -7,10 +7,12 @@ type ManifestGameExtra struct {
 	Title         string
 	Name          string
 	Type          string
+	Dlc           string `json:",omitempty"`
 	Info          int
 	EstimatedSize string
 	VerifiedSize  int64
 	Checksum      string
+	Account       string `json:",omitempty"`
 }
 
 func (e *ManifestGameExtra) HasOneOfTypeTerms(typeTerms []string) bool {
@@ -1,16 +1,52 @@
 package manifest
 
+//Installer entries are either full installers, patches between two versions or language packs.
+//Entries from older manifests have an empty type and are full installers.
+const (
+	InstallerTypeInstaller    string = "installer"
+	InstallerTypePatch               = "patch"
+	InstallerTypeLanguagePack        = "language_pack"
+)
+
 type ManifestGameInstaller struct {
 	Languages     []string
 	Os            string
 	Url           string
 	Title         string
 	Name          string
+	Type          string `json:",omitempty"`
+	Dlc           string `json:",omitempty"`
 	Version       string
+	FromVersion   string `json:",omitempty"`
+	ToVersion     string `json:",omitempty"`
 	Date          string
 	EstimatedSize string
 	VerifiedSize  int64
 	Checksum      string
+	Account       string `json:",omitempty"`
+}
+
+func (i *ManifestGameInstaller) GetType() string {
+	if (*i).Type == "" {
+		return InstallerTypeInstaller
+	}
+	return (*i).Type
+}
+
+func (i *ManifestGameInstaller) IsPatch() bool {
+	return i.GetType() == InstallerTypePatch
+}
+
+func (i *ManifestGameInstaller) IsLanguagePack() bool {
+	return i.GetType() == InstallerTypeLanguagePack
+}
+
+//Language packs and patches are only meaningful alongside the installer for the same os and languages
+func (i *ManifestGameInstaller) SharesPlatformWith(o *ManifestGameInstaller) bool {
+	if (*i).Os != (*o).Os {
+		return false
+	}
+	return len((*i).Languages) == 0 || len((*o).Languages) == 0 || i.HasOneOfLanguages((*o).Languages)
 }
 
 func (i *ManifestGameInstaller) HasOneOfOses(oses []string) bool {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -14,16 +15,30 @@ type GameFilenameDuplicates struct {
 	Extras     []string
 }
 
+//Category, rating, oses, release year and the flags that follow come from the owned games of the account.
+//PolicyRule is the name of the policy rule that selected the files of the game, if any.
 type ManifestGame struct {
-	Id            int64
-	Slug          string
-	Title         string
-	CdKey         string
-	Tags          []string
-	Installers    []ManifestGameInstaller
-	Extras        []ManifestGameExtra
-	EstimatedSize string
-	VerifiedSize  int64
+	Id                   int64
+	Slug                 string
+	Title                string
+	CdKey                string
+	Tags                 []string
+	Category             string   `json:",omitempty"`
+	Rating               int      `json:",omitempty"`
+	WorksOn              []string `json:",omitempty"`
+	ReleaseYear          int      `json:",omitempty"`
+	IsHidden             bool     `json:",omitempty"`
+	IsInDevelopment      bool     `json:",omitempty"`
+	IsGalaxyCompatible   bool     `json:",omitempty"`
+	IsBaseProductMissing bool     `json:",omitempty"`
+	PolicyRule           string   `json:",omitempty"`
+	Installers           []ManifestGameInstaller
+	Extras               []ManifestGameExtra
+	Depots               []ManifestGameDepot `json:",omitempty"`
+	Dlcs                 []ManifestGameDlc   `json:",omitempty"`
+	Accounts             []string            `json:",omitempty"`
+	EstimatedSize        string
+	VerifiedSize         int64
 }
 
 func (g *ManifestGame) CompressIdenticalInstallers() {
@@ -116,6 +131,7 @@ func (g *ManifestGame) RenameDuplicateFilenames() GameFilenameDuplicates {
 func (g *ManifestGame) TrimIncompleteFiles() {
 	installers := make([]ManifestGameInstaller, 0)
 	extras := make([]ManifestGameExtra, 0)
+	depots := make([]ManifestGameDepot, 0)
 
 	for _, installer := range (*g).Installers {
 		if installer.Name != "" && installer.VerifiedSize > 0 {
@@ -129,8 +145,15 @@ func (g *ManifestGame) TrimIncompleteFiles() {
 		}
 	}
 
+	for _, depot := range (*g).Depots {
+		if depot.Name != "" && depot.VerifiedSize > 0 {
+			depots = append(depots, depot)
+		}
+	}
+
 	(*g).Installers = installers
 	(*g).Extras = extras
+	(*g).Depots = depots
 }
 
 func (g *ManifestGame) ImprintProtectedFiles(prev *ManifestGame, prot *ProtectedGameFiles) {
@@ -151,6 +174,15 @@ func (g *ManifestGame) ImprintProtectedFiles(prev *ManifestGame, prot *Protected
 			}
 		}
 	}
+
+	for _, depotName := range (*prot).Depots {
+		if !(*g).HasDepotNamed(depotName) {
+			depot, err := (*prev).GetDepotNamed(depotName)
+			if err == nil {
+				(*g).Depots = append((*g).Depots, depot)
+			}
+		}
+	}
 }
 
 func (g *ManifestGame) ImprintMissingChecksums(prev *ManifestGame) error {
@@ -160,6 +192,7 @@ func (g *ManifestGame) ImprintMissingChecksums(prev *ManifestGame) error {
 
 	previousInstallers := make(map[string]ManifestGameInstaller)
 	previousExtras := make(map[string]ManifestGameExtra)
+	previousDepots := make(map[string]ManifestGameDepot)
 
 	for _, installer := range (*prev).Installers {
 		previousInstallers[installer.Name] = installer
@@ -169,6 +202,10 @@ func (g *ManifestGame) ImprintMissingChecksums(prev *ManifestGame) error {
 		previousExtras[extra.Name] = extra
 	}
 
+	for _, depot := range (*prev).Depots {
+		previousDepots[depot.Name] = depot
+	}
+
 	for idx, installer := range (*g).Installers {
 		if prevInstaller, ok := previousInstallers[installer.Name]; ok {
 			if installer.IsEquivalentTo(&prevInstaller, ChecksumValidationIfPresent, false) {
@@ -191,6 +228,17 @@ func (g *ManifestGame) ImprintMissingChecksums(prev *ManifestGame) error {
 		}
 	}
 
+	for idx, depot := range (*g).Depots {
+		if prevDepot, ok := previousDepots[depot.Name]; ok {
+			if depot.IsEquivalentTo(&prevDepot, ChecksumValidationIfPresent, false) {
+				if depot.Checksum == "" && prevDepot.Checksum != "" {
+					depot.Checksum = prevDepot.Checksum
+					(*g).Depots[idx] = depot
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -236,6 +284,27 @@ func (g *ManifestGame) GetExtraNamed(name string) (ManifestGameExtra, error) {
 	return ManifestGameExtra{}, errors.New(msg)
 }
 
+func (g *ManifestGame) HasDepotNamed(name string) bool {
+	for idx, _ := range (*g).Depots {
+		if (*g).Depots[idx].Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *ManifestGame) GetDepotNamed(name string) (ManifestGameDepot, error) {
+	for idx, _ := range (*g).Depots {
+		if (*g).Depots[idx].Name == name {
+			return (*g).Depots[idx], nil
+		}
+	}
+
+	msg := fmt.Sprintf("*ManifestGame.GetDepotNamed(name=%s) -> No depot by that name", name)
+	return ManifestGameDepot{}, errors.New(msg)
+}
+
 func (g *ManifestGame) TrimInstallers(oses []string, languages []string, keepAny bool, skipUrl FilterSkipUrlFn) {
 	filteredInstallers := make([]ManifestGameInstaller, 0)
 
@@ -267,6 +336,102 @@ func (g *ManifestGame) TrimExtras(typeTerms []string, keepAny bool, skipUrl Filt
 	(*g).Extras = filteredExtras
 }
 
+func (g *ManifestGame) TrimDepots(oses []string, languages []string, keepAny bool, skipUrl FilterSkipUrlFn) {
+	filteredDepots := make([]ManifestGameDepot, 0)
+
+	if keepAny {
+		for _, d := range (*g).Depots {
+			hasOneOfOses := len(oses) == 0 || d.HasOneOfOses(oses)
+			hasOneOfLanguages := len(languages) == 0 || d.HasOneOfLanguages(languages)
+			urlOk := !skipUrl(d.Url)
+			if hasOneOfOses && hasOneOfLanguages && urlOk {
+				filteredDepots = append(filteredDepots, d)
+			}
+		}
+	}
+	(*g).Depots = filteredDepots
+}
+
+//With the latest policy, only the patches leading to the version of the installer for the same platform are kept.
+//If none of them match the installer version, the last patch listed for the platform is kept.
+func (g *ManifestGame) isLatestPatch(idx int) bool {
+	patch := (*g).Installers[idx]
+	candidates := []int{}
+	for pIdx, other := range (*g).Installers {
+		if other.IsPatch() && other.SharesPlatformWith(&patch) {
+			candidates = append(candidates, pIdx)
+		}
+	}
+
+	matching := false
+	for _, installer := range (*g).Installers {
+		if installer.GetType() != InstallerTypeInstaller || installer.Version == "" || (!installer.SharesPlatformWith(&patch)) {
+			continue
+		}
+
+		for _, cIdx := range candidates {
+			if IsSameVersion(installer.Version, (*g).Installers[cIdx].ToVersion) {
+				if cIdx == idx {
+					return true
+				}
+				matching = true
+			}
+		}
+	}
+
+	return (!matching) && candidates[len(candidates)-1] == idx
+}
+
+func (g *ManifestGame) TrimInstallerTypes(patches string, skipLanguagePacks bool) {
+	filteredInstallers := make([]ManifestGameInstaller, 0)
+
+	for idx, installer := range (*g).Installers {
+		if installer.IsLanguagePack() && skipLanguagePacks {
+			continue
+		}
+
+		if installer.IsPatch() {
+			if patches == PatchesNone {
+				continue
+			} else if patches == PatchesLatest && (!g.isLatestPatch(idx)) {
+				continue
+			}
+		}
+
+		filteredInstallers = append(filteredInstallers, installer)
+	}
+
+	(*g).Installers = filteredInstallers
+}
+
+//A patch can be applied on top of an installer of its base version or of another patch leading to it
+func (g *ManifestGame) HasPatchBase(patch *ManifestGameInstaller) bool {
+	for _, installer := range (*g).Installers {
+		isBase := installer.GetType() == InstallerTypeInstaller && IsSameVersion(installer.Version, (*patch).FromVersion)
+		isPreviousPatch := installer.IsPatch() && IsSameVersion(installer.ToVersion, (*patch).FromVersion)
+		if (isBase || isPreviousPatch) && installer.SharesPlatformWith(patch) {
+			return true
+		}
+	}
+	return false
+}
+
+//Keeps the installers of the previous manifest that patches of the game need as their base version
+func (g *ManifestGame) ImprintPatchBases(prev *ManifestGame) {
+	for _, patch := range (*g).Installers {
+		if (!patch.IsPatch()) || patch.FromVersion == "" || g.HasPatchBase(&patch) {
+			continue
+		}
+
+		for _, installer := range (*prev).Installers {
+			isBase := installer.GetType() == InstallerTypeInstaller && IsSameVersion(installer.Version, patch.FromVersion)
+			if isBase && installer.SharesPlatformWith(&patch) && (!(*g).HasInstallerNamed(installer.Name)) {
+				(*g).Installers = append((*g).Installers, installer)
+			}
+		}
+	}
+}
+
 func (g *ManifestGame) HasTitleTerms(titleTerms []string) bool {
 	if len(titleTerms) == 0 {
 		return true
@@ -281,6 +446,14 @@ func (g *ManifestGame) HasTitleTerms(titleTerms []string) bool {
 	return false
 }
 
+//A game is designated by its id, its slug or its whole title, regardless of case
+func (g *ManifestGame) IsDesignatedBy(term string) bool {
+	if id, err := strconv.ParseInt(term, 10, 64); err == nil && id == (*g).Id {
+		return true
+	}
+	return term == (*g).Slug || strings.EqualFold(term, (*g).Title)
+}
+
 func (g *ManifestGame) HasOneOfTags(tags []string) bool {
 	for _, t := range tags {
 		for _, gt := range (*g).Tags {
@@ -293,7 +466,7 @@ func (g *ManifestGame) HasOneOfTags(tags []string) bool {
 }
 
 func (g *ManifestGame) IsEmpty() bool {
-	return len((*g).Installers) == 0 && len((*g).Extras) == 0
+	return len((*g).Installers) == 0 && len((*g).Extras) == 0 && len((*g).Depots) == 0
 }
 
 func (g *ManifestGame) ComputeVerifiedSize() int64 {
@@ -306,6 +479,10 @@ func (g *ManifestGame) ComputeVerifiedSize() int64 {
 		accumulate += extr.VerifiedSize
 	}
 
+	for _, depot := range (*g).Depots {
+		accumulate += depot.VerifiedSize
+	}
+
 	(*g).VerifiedSize = accumulate
 	return accumulate
 }
@@ -328,6 +505,14 @@ func (g *ManifestGame) ComputeEstimatedSize() (int64, error) {
 		accumulate += size
 	}
 
+	for _, depot := range (*g).Depots {
+		size, err := depot.GetEstimatedSizeInBytes()
+		if err != nil {
+			return 0, err
+		}
+		accumulate += size
+	}
+
 	(*g).EstimatedSize = GetBytesToEstimate(accumulate)
 	return accumulate, nil
 }
@@ -353,16 +538,107 @@ func (g *ManifestGame) FillMissingFileInfo(fileKind string, fileName string, fil
 		}
 
 		return errors.New(fmt.Sprintf("File with name %s was not found in the extras of game with id %d", fileName, (*g).Id))
+	} else if fileKind == "depot" {
+		for idx, _ := range (*g).Depots {
+			if (*g).Depots[idx].Name == fileName {
+				(*g).Depots[idx].VerifiedSize = fileSize
+				(*g).Depots[idx].Checksum = fileChecksum
+				return nil
+			}
+		}
+
+		return errors.New(fmt.Sprintf("File with name %s was not found in the depots of game with id %d", fileName, (*g).Id))
 	}
 
 	return errors.New(fmt.Sprintf("%s is not a valid kind of file", fileKind))
 }
 
+func (g *ManifestGame) worksOnOneOf(oses []string) bool {
+	for _, os := range oses {
+		for _, gameOs := range (*g).WorksOn {
+			if strings.EqualFold(os, gameOs) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *ManifestGame) HasInstallerDatedWithin(since string, until string) bool {
+	if since == "" && until == "" {
+		return true
+	}
+
+	for _, installer := range (*g).Installers {
+		//Dates may have a time after the day, which the comparison on the day ignores
+		date := installer.Date
+		if len(date) > 10 {
+			date = date[:10]
+		}
+		if date == "" || (since != "" && date < since) || (until != "" && date > until) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+//Manifests generated before the fields of the owned games were kept don't have any of them
+func (g *ManifestGame) hasOwnedGameFields() bool {
+	return (*g).Category != "" || (*g).Rating > 0 || len((*g).WorksOn) > 0 || (*g).ReleaseYear > 0
+}
+
+//Checks the predicates of the filter that only need the fields of the owned games, before the details of the game are fetched.
+//Fields that the game doesn't have are unknown rather than failing, so that older manifests aren't emptied by the predicates.
+func (g *ManifestGame) PassesOwnedGameFilter(filter *ManifestFilter) bool {
+	for _, id := range (*filter).SkipIds {
+		if id == (*g).Id {
+			return false
+		}
+	}
+	if len((*filter).SkipTitles) > 0 && (*g).HasTitleTerms((*filter).SkipTitles) {
+		return false
+	}
+
+	if len((*filter).Categories) > 0 && (*g).Category != "" {
+		hasCategory := false
+		for _, category := range (*filter).Categories {
+			if strings.EqualFold(category, (*g).Category) {
+				hasCategory = true
+				break
+			}
+		}
+		if !hasCategory {
+			return false
+		}
+	}
+
+	if (*g).Rating > 0 && (*g).Rating < (*filter).MinRating {
+		return false
+	}
+	if len((*filter).WorksOn) > 0 && len((*g).WorksOn) > 0 && (!g.worksOnOneOf((*filter).WorksOn)) {
+		return false
+	}
+	if (*filter).MinReleaseYear > 0 && (*g).ReleaseYear > 0 && (*g).ReleaseYear < (*filter).MinReleaseYear {
+		return false
+	}
+	if (*filter).MaxReleaseYear > 0 && (*g).ReleaseYear > (*filter).MaxReleaseYear {
+		return false
+	}
+
+	isKeptHidden := !((*filter).SkipHidden && (*g).IsHidden)
+	isKeptInDevelopment := !((*filter).SkipInDevelopment && (*g).IsInDevelopment)
+	isKeptGalaxyIncompatible := !((*filter).SkipGalaxyIncompatible && (!(*g).IsGalaxyCompatible) && g.hasOwnedGameFields())
+	return isKeptHidden && isKeptInDevelopment && isKeptGalaxyIncompatible
+}
+
 func (g *ManifestGame) PassesFilter(filter ManifestFilter) bool {
 	titles := filter.Titles
 	tags := filter.Tags
-	hasTitleTerm := len(titles) == 0 || (*g).HasTitleTerms(titles)
+	hasTitleTerm := len(titles) == 0 || (*g).HasTitleTerms(titles) || (*g).HasDlcTitleTerms(titles)
 	hasOneOfTags := len(tags) == 0 || (*g).HasOneOfTags(tags)
+	hasNoSkippedTag := len(filter.SkipTags) == 0 || (!(*g).HasOneOfTags(filter.SkipTags))
+	isKeptDlc := !(filter.SkipOrphanDlcs && (*g).IsBaseProductMissing)
 	hasUrl := len(filter.HasUrls) == 0
 	if len(filter.HasUrls) > 0 {
 		hasUrlFn := filter.GetHasUrlFn()
@@ -373,11 +649,57 @@ func (g *ManifestGame) PassesFilter(filter ManifestFilter) bool {
 			}
 		}
 	}
-	return hasTitleTerm && hasOneOfTags && hasUrl
+	hasDatedInstaller := g.HasInstallerDatedWithin(filter.InstallersSince, filter.InstallersUntil)
+	passesOwnedGameFilter := g.PassesOwnedGameFilter(&filter)
+	return hasTitleTerm && hasOneOfTags && hasNoSkippedTag && hasUrl && isKeptDlc && hasDatedInstaller && passesOwnedGameFilter
 }
 
-func (g *ManifestGame) TrimFilesFromFilter(filter ManifestFilter) {
+//Size of the files of the game, using the estimated size of files that were not measured yet
+func (g *ManifestGame) GetSize() int64 {
+	accumulate := int64(0)
+	for _, inst := range (*g).Installers {
+		size := inst.VerifiedSize
+		if size == 0 {
+			size, _ = inst.GetEstimatedSizeInBytes()
+		}
+		accumulate += size
+	}
+
+	for _, extr := range (*g).Extras {
+		size := extr.VerifiedSize
+		if size == 0 {
+			size, _ = extr.GetEstimatedSizeInBytes()
+		}
+		accumulate += size
+	}
+
+	for _, depot := range (*g).Depots {
+		size := depot.VerifiedSize
+		if size == 0 {
+			size, _ = depot.GetEstimatedSizeInBytes()
+		}
+		accumulate += size
+	}
+
+	return accumulate
+}
+
+func (g *ManifestGame) PassesSizeFilter(filter *ManifestFilter) bool {
+	return (*filter).MaxGameSize == 0 || g.GetSize() <= (*filter).MaxGameSize
+}
+
+func (g *ManifestGame) TrimFilesFromFilter(filter *ManifestFilter) {
 	skipUrlFn := filter.GetSkipUrlFn()
-	g.TrimInstallers(filter.Oses, filter.Languages, filter.Installers, skipUrlFn)
-	g.TrimExtras(filter.ExtraTypes, filter.Extras, skipUrlFn)
+	g.TrimInstallers((*filter).Oses, (*filter).Languages, (*filter).Installers, skipUrlFn)
+	g.TrimExtras((*filter).ExtraTypes, (*filter).Extras, skipUrlFn)
+	g.TrimDepots((*filter).Oses, (*filter).Languages, (*filter).Depots, skipUrlFn)
+	g.TrimInstallerTypes((*filter).Patches, (*filter).SkipLanguagePacks)
+	g.TrimDlcs((*filter).SkipDlcs)
+}
+
+//Trims the files of the game with the filter or the policy rule of the filter that matches it
+func (g *ManifestGame) TrimFilesFromPolicy(filter *ManifestFilter) {
+	gameFilter, rule := filter.GetGameFilter(g)
+	g.TrimFilesFromFilter(gameFilter)
+	(*g).PolicyRule = rule
 }
\ No newline at end of file
@@ -600,3 +600,206 @@ func TestManifestGameComputeVerifiedSize(t *testing.T) {
 func TestManifestGameFillMissingFileInfo(t *testing.T) {
 	//TODO
 }
+
+func getPatchedGameFixture() ManifestGame {
+	return ManifestGame{
+		Id:    1,
+		Title: "Patched",
+		Installers: []ManifestGameInstaller{
+			ManifestGameInstaller{Languages: []string{"english"}, Os: "windows", Name: "setup_1.3.exe", Version: "1.3 (gog-2)"},
+			ManifestGameInstaller{Languages: []string{"english"}, Os: "windows", Name: "patch_1.1_1.2.exe", Type: InstallerTypePatch, FromVersion: "1.1", ToVersion: "1.2"},
+			ManifestGameInstaller{Languages: []string{"english"}, Os: "windows", Name: "patch_1.2_1.3.exe", Type: InstallerTypePatch, FromVersion: "1.2", ToVersion: "1.3"},
+			ManifestGameInstaller{Languages: []string{"english"}, Os: "linux", Name: "patch_linux.sh", Type: InstallerTypePatch, ToVersion: "1.3"},
+			ManifestGameInstaller{Languages: []string{"french"}, Os: "windows", Name: "langpack_fr.exe", Type: InstallerTypeLanguagePack},
+		},
+	}
+}
+
+func TestManifestGameTrimInstallerTypes(t *testing.T) {
+	game := getPatchedGameFixture()
+	game.TrimInstallerTypes(PatchesAll, false)
+	if len(game.Installers) != 5 {
+		t.Errorf("Keeping all patches and language packs should not trim anything")
+	}
+
+	game = getPatchedGameFixture()
+	game.TrimInstallerTypes(PatchesNone, true)
+	if len(game.Installers) != 1 || game.Installers[0].Name != "setup_1.3.exe" {
+		t.Errorf("Expected only the installer to remain and got %v", game.Installers)
+	}
+
+	game = getPatchedGameFixture()
+	game.TrimInstallerTypes(PatchesLatest, false)
+	names := map[string]bool{}
+	for _, installer := range game.Installers {
+		names[installer.Name] = true
+	}
+	if len(names) != 4 || names["patch_1.1_1.2.exe"] || (!names["patch_1.2_1.3.exe"]) || (!names["patch_linux.sh"]) {
+		t.Errorf("Expected only the patches leading to the latest version to remain and got %v", game.Installers)
+	}
+}
+
+func TestManifestGameImprintPatchBases(t *testing.T) {
+	prev := ManifestGame{
+		Id:    1,
+		Title: "Patched",
+		Installers: []ManifestGameInstaller{
+			ManifestGameInstaller{Languages: []string{"english"}, Os: "windows", Name: "setup_1.1.exe", Version: "1.1"},
+			ManifestGameInstaller{Languages: []string{"english"}, Os: "windows", Name: "setup_1.0.exe", Version: "1.0"},
+		},
+	}
+
+	game := getPatchedGameFixture()
+	game.ImprintPatchBases(&prev)
+	if !game.HasInstallerNamed("setup_1.1.exe") {
+		t.Errorf("Installer that a patch is based on should be kept")
+	}
+	if game.HasInstallerNamed("setup_1.0.exe") {
+		t.Errorf("Installer that no patch is based on should not be kept")
+	}
+
+	game = getPatchedGameFixture()
+	game.TrimInstallerTypes(PatchesLatest, false)
+	game.ImprintPatchBases(&prev)
+	if game.HasInstallerNamed("setup_1.1.exe") {
+		t.Errorf("Installer should not be kept once the patch based on it is trimmed")
+	}
+}
+
+func TestManifestGameIsDesignatedBy(t *testing.T) {
+	game := ManifestGame{Id: 1207658924, Slug: "some_game", Title: "Some Game"}
+	for _, term := range []string{"1207658924", "some_game", "Some Game", "some game"} {
+		if !game.IsDesignatedBy(term) {
+			t.Errorf("Expected game to be designated by %s", term)
+		}
+	}
+	for _, term := range []string{"1", "some", "Some Game 2"} {
+		if game.IsDesignatedBy(term) {
+			t.Errorf("Expected game not to be designated by %s", term)
+		}
+	}
+}
+
+func TestManifestTrimGamesPredicates(t *testing.T) {
+	game := ManifestGame{
+		Id:                 1,
+		Title:              "Some Game",
+		Tags:               []string{"favorite"},
+		Category:           "Strategy",
+		Rating:             40,
+		WorksOn:            []string{"windows", "linux"},
+		ReleaseYear:        1998,
+		IsGalaxyCompatible: false,
+		IsInDevelopment:    true,
+		Installers: []ManifestGameInstaller{
+			ManifestGameInstaller{Name: "setup.exe", Date: "2020-05-10", EstimatedSize: "1 GB"},
+		},
+	}
+
+	passing := []func(f *ManifestFilter){
+		func(f *ManifestFilter) {},
+		func(f *ManifestFilter) {
+			(*f).SkipTitles = []string{"other"}
+			(*f).SkipTags = []string{"backlog"}
+			(*f).SkipIds = []int64{2}
+		},
+		func(f *ManifestFilter) {
+			(*f).Categories = []string{"strategy"}
+			(*f).MinRating = 40
+			(*f).WorksOn = []string{"linux"}
+		},
+		func(f *ManifestFilter) {
+			(*f).MinReleaseYear = 1990
+			(*f).MaxReleaseYear = 1998
+			(*f).SkipHidden = true
+		},
+		func(f *ManifestFilter) {
+			(*f).InstallersSince = "2020-05-10"
+			(*f).InstallersUntil = "2021-01-01"
+		},
+	}
+	for idx, setFilter := range passing {
+		m := Manifest{Games: []ManifestGame{game}}
+		setFilter(&m.Filter)
+		m.TrimGames()
+		if len(m.Games) != 1 {
+			t.Errorf("Expected game to pass filter %d", idx)
+		}
+	}
+
+	failing := []func(f *ManifestFilter){
+		func(f *ManifestFilter) { (*f).SkipTitles = []string{"some"} },
+		func(f *ManifestFilter) { (*f).SkipTags = []string{"favorite"} },
+		func(f *ManifestFilter) { (*f).SkipIds = []int64{1} },
+		func(f *ManifestFilter) { (*f).Categories = []string{"Action"} },
+		func(f *ManifestFilter) { (*f).MinRating = 45 },
+		func(f *ManifestFilter) { (*f).WorksOn = []string{"mac"} },
+		func(f *ManifestFilter) { (*f).MinReleaseYear = 1999 },
+		func(f *ManifestFilter) { (*f).MaxReleaseYear = 1997 },
+		func(f *ManifestFilter) { (*f).SkipInDevelopment = true },
+		func(f *ManifestFilter) { (*f).SkipGalaxyIncompatible = true },
+		func(f *ManifestFilter) { (*f).InstallersSince = "2020-05-11" },
+		func(f *ManifestFilter) { (*f).InstallersUntil = "2020-05-09" },
+	}
+	for idx, setFilter := range failing {
+		m := Manifest{Games: []ManifestGame{game}}
+		setFilter(&m.Filter)
+		m.TrimGames()
+		if len(m.Games) != 0 {
+			t.Errorf("Expected game not to pass filter %d", idx)
+		}
+	}
+}
+
+func TestManifestGamePassesOwnedGameFilterWithoutFields(t *testing.T) {
+	//Games of manifests generated before the fields of the owned games were kept
+	game := ManifestGame{Id: 1, Title: "Some Game"}
+	filter := ManifestFilter{
+		Categories:             []string{"Strategy"},
+		MinRating:              40,
+		WorksOn:                []string{"linux"},
+		MinReleaseYear:         1990,
+		MaxReleaseYear:         1999,
+		SkipHidden:             true,
+		SkipInDevelopment:      true,
+		SkipGalaxyIncompatible: true,
+	}
+	if !game.PassesOwnedGameFilter(&filter) {
+		t.Errorf("Expected a game without the fields of the owned games to pass their predicates")
+	}
+
+	//Fields that a game has are still checked when others are missing
+	game.WorksOn = []string{"windows"}
+	if game.PassesOwnedGameFilter(&filter) {
+		t.Errorf("Expected a game to fail the predicates on the fields it has")
+	}
+	game.WorksOn = []string{"linux"}
+	if game.PassesOwnedGameFilter(&filter) {
+		t.Errorf("Expected a game with some of the fields of the owned games to be checked for galaxy compatibility")
+	}
+	game.IsGalaxyCompatible = true
+	if !game.PassesOwnedGameFilter(&filter) {
+		t.Errorf("Expected a game with missing category, rating and release year to pass their predicates")
+	}
+}
+
+func TestManifestGamePassesSizeFilter(t *testing.T) {
+	game := ManifestGame{
+		Installers: []ManifestGameInstaller{
+			ManifestGameInstaller{Name: "setup.exe", EstimatedSize: "1 GB"},
+		},
+		Extras: []ManifestGameExtra{
+			ManifestGameExtra{Name: "manual.pdf", EstimatedSize: "1 GB", VerifiedSize: 1000},
+		},
+	}
+
+	if game.GetSize() != 1000001000 {
+		t.Errorf("Expected the size to use verified sizes when known and got %d", game.GetSize())
+	}
+	if !game.PassesSizeFilter(&ManifestFilter{}) || !game.PassesSizeFilter(&ManifestFilter{MaxGameSize: 1000001000}) {
+		t.Errorf("Expected game to pass size caps it doesn't exceed")
+	}
+	if game.PassesSizeFilter(&ManifestFilter{MaxGameSize: 1000000000}) {
+		t.Errorf("Expected game not to pass a size cap it exceeds")
+	}
+}
@@ -0,0 +1,63 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Record of an action completed on a storage, with the info of the uploaded file for file additions.
+//Entries are appended to a journal as actions complete and replayed on the last stored manifest and actions.
+type JournalEntry struct {
+	Game         GameInfo
+	GameAction   string      `json:",omitempty"`
+	FileAction   *FileAction `json:",omitempty"`
+	FileSize     int64       `json:",omitempty"`
+	FileChecksum string      `json:",omitempty"`
+}
+
+func NewJournalEntry(action Action, fileSize int64, fileChecksum string) JournalEntry {
+	entry := JournalEntry{Game: action.Game}
+	if action.IsFileAction {
+		fileAction := *action.FileActionPtr
+		entry.FileAction = &fileAction
+		if fileAction.Action == "add" {
+			entry.FileSize = fileSize
+			entry.FileChecksum = fileChecksum
+		}
+	} else {
+		entry.GameAction = action.GameAction
+	}
+	return entry
+}
+
+func (e *JournalEntry) GetAction() Action {
+	if (*e).FileAction != nil {
+		fileAction := *(*e).FileAction
+		return Action{Game: (*e).Game, IsFileAction: true, FileActionPtr: &fileAction}
+	}
+	return Action{Game: (*e).Game, GameAction: (*e).GameAction}
+}
+
+//Applies the entries on the manifest and actions. Either can be nil.
+//Replaying entries that were already applied has no effect, so a journal can safely be replayed after a
+//crash that happened between the storage of the manifest and the removal of the journal.
+func ReplayJournal(m *Manifest, a *GameActions, entries []JournalEntry) error {
+	for idx, _ := range entries {
+		entry := entries[idx]
+		if m != nil && entry.FileAction != nil && (*entry.FileAction).Action == "add" {
+			err := m.FillMissingFileInfo(entry.Game.Id, (*entry.FileAction).Kind, (*entry.FileAction).Name, entry.FileSize, entry.FileChecksum)
+			if err != nil {
+				msg := fmt.Sprintf("ReplayJournal(...) -> Error replaying entry %d: %s", idx, err.Error())
+				return errors.New(msg)
+			}
+		}
+
+		if a != nil {
+			if _, ok := (*a)[entry.Game.Id]; ok {
+				a.ApplyAction(entry.GetAction())
+			}
+		}
+	}
+
+	return nil
+}
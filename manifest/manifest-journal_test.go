@@ -0,0 +1,70 @@
+package manifest
+
+import (
+	"testing"
+)
+
+func getJournalFixtures() (*Manifest, *GameActions, []JournalEntry) {
+	m := &Manifest{Games: []ManifestGame{
+		ManifestGame{
+			Id:         1,
+			Installers: []ManifestGameInstaller{ManifestGameInstaller{Name: "setup.exe"}},
+			Extras:     []ManifestGameExtra{ManifestGameExtra{Name: "manual.pdf"}},
+		},
+	}}
+
+	a := &GameActions{
+		1: GameAction{
+			Id:               1,
+			Action:           "add",
+			InstallerActions: map[string]FileAction{"setup.exe": FileAction{Name: "setup.exe", Kind: "installer", Action: "add"}},
+			ExtraActions:     map[string]FileAction{"manual.pdf": FileAction{Name: "manual.pdf", Kind: "extra", Action: "add"}},
+		},
+		2: GameAction{Id: 2, Action: "remove", InstallerActions: map[string]FileAction{}, ExtraActions: map[string]FileAction{}},
+	}
+
+	entries := []JournalEntry{
+		NewJournalEntry(Action{Game: GameInfo{Id: 1}, GameAction: "add"}, 0, ""),
+		NewJournalEntry(Action{Game: GameInfo{Id: 1}, IsFileAction: true, FileActionPtr: &FileAction{Name: "setup.exe", Kind: "installer", Action: "add"}}, 100, "abc"),
+		NewJournalEntry(Action{Game: GameInfo{Id: 2}, GameAction: "remove"}, 0, ""),
+	}
+
+	return m, a, entries
+}
+
+func TestReplayJournal(t *testing.T) {
+	m, a, entries := getJournalFixtures()
+
+	err := ReplayJournal(m, a, entries)
+	if err != nil {
+		t.Fatalf("Replay failed: %s", err.Error())
+	}
+
+	installer := m.Games[0].Installers[0]
+	if installer.VerifiedSize != 100 || installer.Checksum != "abc" {
+		t.Errorf("Installer info was not filled from the journal: %v", installer)
+	}
+
+	game, ok := (*a)[1]
+	if !ok || game.Action != "update" || len(game.InstallerActions) != 0 || len(game.ExtraActions) != 1 {
+		t.Errorf("Actions of the first game were not applied properly: %v", game)
+	}
+
+	if _, ok := (*a)[2]; ok {
+		t.Errorf("Removed game should not have actions left")
+	}
+}
+
+func TestReplayJournalTwice(t *testing.T) {
+	m, a, entries := getJournalFixtures()
+
+	ReplayJournal(m, a, entries)
+	err := ReplayJournal(m, a, entries)
+	if err != nil {
+		t.Fatalf("Second replay failed: %s", err.Error())
+	}
+
+	if len(*a) != 1 || len((*a)[1].ExtraActions) != 1 {
+		t.Errorf("Replaying a journal twice should have the same result as replaying it once: %v", *a)
+	}
+}
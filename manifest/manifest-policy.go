@@ -0,0 +1,120 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//Games a policy rule applies to. A game matches if it has one of the ids, slugs or tags, or if its title contains one of the queries.
+type ManifestPolicyMatch struct {
+	Ids     []int64  `json:",omitempty"`
+	Slugs   []string `json:",omitempty"`
+	Tags    []string `json:",omitempty"`
+	Queries []string `json:",omitempty"`
+}
+
+//Overrides the file selection of the filter for the games it matches.
+//Settings that are left out keep the value of the filter and an empty list of oses, languages or extra types keeps them all.
+type ManifestPolicyRule struct {
+	Name              string
+	Match             ManifestPolicyMatch
+	Oses              []string `json:",omitempty"`
+	Languages         []string `json:",omitempty"`
+	ExtraTypes        []string `json:",omitempty"`
+	Installers        *bool    `json:",omitempty"`
+	Extras            *bool    `json:",omitempty"`
+	Depots            *bool    `json:",omitempty"`
+	Patches           string   `json:",omitempty"`
+	SkipLanguagePacks *bool    `json:",omitempty"`
+	SkipDlcs          *bool    `json:",omitempty"`
+}
+
+//Rules are tried in order and the first one that matches a game applies to it
+type ManifestPolicy struct {
+	Rules []ManifestPolicyRule
+}
+
+func ParseManifestPolicy(bs []byte) (*ManifestPolicy, error) {
+	var policy ManifestPolicy
+	err := json.Unmarshal(bs, &policy)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("ParseManifestPolicy(...) -> Error occured while parsing the policy: %s", err.Error()))
+	}
+
+	names := make(map[string]bool)
+	for idx, rule := range policy.Rules {
+		if rule.Name == "" {
+			return nil, errors.New(fmt.Sprintf("ParseManifestPolicy(...) -> Rule %d does not have a name", idx))
+		}
+		if names[rule.Name] {
+			return nil, errors.New(fmt.Sprintf("ParseManifestPolicy(...) -> Several rules are named %s", rule.Name))
+		}
+		names[rule.Name] = true
+
+		if rule.IsMatchEmpty() {
+			return nil, errors.New(fmt.Sprintf("ParseManifestPolicy(...) -> Rule %s does not match any game", rule.Name))
+		}
+		if rule.Patches != "" && rule.Patches != PatchesAll && rule.Patches != PatchesLatest && rule.Patches != PatchesNone {
+			return nil, errors.New(fmt.Sprintf("ParseManifestPolicy(...) -> Patches of rule %s must be either 'all', 'latest' or 'none'", rule.Name))
+		}
+	}
+	return &policy, nil
+}
+
+func (r *ManifestPolicyRule) IsMatchEmpty() bool {
+	match := (*r).Match
+	return len(match.Ids) == 0 && len(match.Slugs) == 0 && len(match.Tags) == 0 && len(match.Queries) == 0
+}
+
+func (r *ManifestPolicyRule) Matches(g *ManifestGame) bool {
+	match := (*r).Match
+	for _, id := range match.Ids {
+		if id == (*g).Id {
+			return true
+		}
+	}
+	for _, slug := range match.Slugs {
+		if strings.EqualFold(slug, (*g).Slug) {
+			return true
+		}
+	}
+	if len(match.Tags) > 0 && g.HasOneOfTags(match.Tags) {
+		return true
+	}
+	return len(match.Queries) > 0 && g.HasTitleTerms(match.Queries)
+}
+
+//Returns a copy of the filter with the settings of the rule
+func (r *ManifestPolicyRule) ApplyTo(f *ManifestFilter) *ManifestFilter {
+	newFilter := f.Copy()
+	if (*r).Oses != nil {
+		newFilter.Oses = (*r).Oses
+	}
+	if (*r).Languages != nil {
+		newFilter.Languages = (*r).Languages
+	}
+	if (*r).ExtraTypes != nil {
+		newFilter.ExtraTypes = (*r).ExtraTypes
+	}
+	if (*r).Installers != nil {
+		newFilter.Installers = *(*r).Installers
+	}
+	if (*r).Extras != nil {
+		newFilter.Extras = *(*r).Extras
+	}
+	if (*r).Depots != nil {
+		newFilter.Depots = *(*r).Depots
+	}
+	if (*r).Patches != "" {
+		newFilter.Patches = (*r).Patches
+	}
+	if (*r).SkipLanguagePacks != nil {
+		newFilter.SkipLanguagePacks = *(*r).SkipLanguagePacks
+	}
+	if (*r).SkipDlcs != nil {
+		newFilter.SkipDlcs = *(*r).SkipDlcs
+	}
+	return newFilter
+}
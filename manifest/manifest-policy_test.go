@@ -0,0 +1,166 @@
+package manifest
+
+import "testing"
+
+const testPolicy = `{
+  "Rules": [
+    {
+      "Name": "favourites",
+      "Match": {"Slugs": ["favourite_game"], "Tags": ["favourite"]},
+      "Languages": []
+    },
+    {
+      "Name": "aaa",
+      "Match": {"Ids": [2], "Queries": ["blockbuster"]},
+      "Extras": false
+    }
+  ]
+}`
+
+func getPolicyTestManifest(t *testing.T) *Manifest {
+	policy, err := ParseManifestPolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("Parsing the policy failed: %s", err.Error())
+	}
+
+	newGame := func(id int64, slug string, title string, tags []string) ManifestGame {
+		return ManifestGame{
+			Id:    id,
+			Slug:  slug,
+			Title: title,
+			Tags:  tags,
+			Installers: []ManifestGameInstaller{
+				ManifestGameInstaller{Name: "setup_en.exe", Os: "windows", Languages: []string{"english"}, VerifiedSize: 10},
+				ManifestGameInstaller{Name: "setup_fr.exe", Os: "windows", Languages: []string{"french"}, VerifiedSize: 10},
+			},
+			Extras: []ManifestGameExtra{
+				ManifestGameExtra{Name: "manual.pdf", Type: "manuals", VerifiedSize: 1},
+			},
+		}
+	}
+
+	m := Manifest{
+		Games: []ManifestGame{
+			newGame(1, "favourite_game", "Favourite Game", []string{}),
+			newGame(2, "big_game", "Big Game", []string{}),
+			newGame(3, "other_blockbuster", "Other Blockbuster", []string{"favourite"}),
+			newGame(4, "regular_game", "Regular Game", []string{}),
+		},
+		Filter: ManifestFilter{
+			Languages:   []string{"english"},
+			Installers:  true,
+			Extras:      true,
+			PolicyRules: (*policy).Rules,
+		},
+	}
+	return &m
+}
+
+func TestParseManifestPolicyErrors(t *testing.T) {
+	policies := []string{
+		`{"Rules": [{"Match": {"Ids": [1]}}]}`,
+		`{"Rules": [{"Name": "a", "Match": {"Ids": [1]}}, {"Name": "a", "Match": {"Ids": [2]}}]}`,
+		`{"Rules": [{"Name": "a", "Match": {}}]}`,
+		`{"Rules": [{"Name": "a", "Match": {"Ids": [1]}, "Patches": "some"}]}`,
+		`{"Rules": [`,
+	}
+	for idx, policy := range policies {
+		_, err := ParseManifestPolicy([]byte(policy))
+		if err == nil {
+			t.Errorf("Expected policy %d to be invalid", idx)
+		}
+	}
+}
+
+func TestManifestTrimWithPolicy(t *testing.T) {
+	m := getPolicyTestManifest(t)
+	m.Trim()
+
+	expected := map[int64]struct {
+		rule       string
+		installers int
+		extras     int
+	}{
+		1: {"favourites", 2, 1},
+		2: {"aaa", 1, 0},
+		3: {"favourites", 2, 1},
+		4: {"", 1, 1},
+	}
+
+	if len((*m).Games) != 4 {
+		t.Fatalf("Expected the policy to keep all 4 games and got %d", len((*m).Games))
+	}
+	for _, game := range (*m).Games {
+		exp := expected[game.Id]
+		if game.PolicyRule != exp.rule || len(game.Installers) != exp.installers || len(game.Extras) != exp.extras {
+			t.Errorf("Game %d has rule '%s', %d installers and %d extras instead of rule '%s', %d installers and %d extras", game.Id, game.PolicyRule, len(game.Installers), len(game.Extras), exp.rule, exp.installers, exp.extras)
+		}
+	}
+
+	if len((*m).Filter.Languages) != 1 || (!(*m).Filter.Extras) {
+		t.Errorf("Expected the policy rules not to modify the filter of the manifest")
+	}
+}
+
+func TestManifestTrimDropsEmptiedGames(t *testing.T) {
+	withPolicy := getPolicyTestManifest(t)
+	withoutPolicy := getPolicyTestManifest(t)
+	(*withoutPolicy).Filter.PolicyRules = []ManifestPolicyRule{}
+	for _, m := range []*Manifest{withPolicy, withoutPolicy} {
+		(*m).Filter.Languages = []string{"german"}
+		(*m).Filter.Extras = false
+		for idx, _ := range (*m).Games {
+			(*m).Games[idx].PolicyRule = "previous"
+		}
+		m.Trim()
+	}
+
+	//The favourites rule keeps the installers of its games in every language
+	if len((*withPolicy).Games) != 2 {
+		t.Errorf("Expected the games left without files by the policy to be dropped and got %d games", len((*withPolicy).Games))
+	}
+	for _, game := range (*withPolicy).Games {
+		if game.PolicyRule != "favourites" {
+			t.Errorf("Expected game %d to be kept by the favourites rule and got rule '%s'", game.Id, game.PolicyRule)
+		}
+	}
+
+	if len((*withoutPolicy).Games) != 0 {
+		t.Errorf("Expected the games left without files by the filter to be dropped and got %d games", len((*withoutPolicy).Games))
+	}
+}
+
+func TestManifestTrimWithoutPolicyClearsRules(t *testing.T) {
+	m := getPolicyTestManifest(t)
+	m.Trim()
+	(*m).Filter.PolicyRules = []ManifestPolicyRule{}
+	m.Trim()
+
+	if len((*m).Games) != 4 {
+		t.Fatalf("Expected all 4 games to be kept and got %d", len((*m).Games))
+	}
+	for _, game := range (*m).Games {
+		if game.PolicyRule != "" || len(game.Installers) != 1 {
+			t.Errorf("Expected game %d to be trimmed by the filter once the rules are removed and got rule '%s' with %d installers", game.Id, game.PolicyRule, len(game.Installers))
+		}
+	}
+}
+
+func TestManifestSummaryPolicyRules(t *testing.T) {
+	m := getPolicyTestManifest(t)
+	m.Trim()
+	m.ComputeVerifiedSize()
+
+	summary := m.GetSummary()
+	if len(summary.PolicyRules) != 2 {
+		t.Fatalf("Expected a summary for each of the 2 rules and got %d", len(summary.PolicyRules))
+	}
+	favourites := summary.PolicyRules[0]
+	if favourites.Rule != "favourites" || len(favourites.Games) != 2 || favourites.Size != 42 {
+		t.Errorf("Expected the favourites rule to have 2 games for 42 bytes and got %v", favourites)
+	}
+	aaa := summary.PolicyRules[1]
+	if aaa.Rule != "aaa" || len(aaa.Games) != 1 || aaa.Games[0].Id != 2 || aaa.Games[0].Extras != 0 {
+		t.Errorf("Expected the aaa rule to have the game without extras and got %v", aaa)
+	}
+}
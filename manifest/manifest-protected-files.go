@@ -3,9 +3,17 @@ package manifest
 type ProtectedGameFiles struct {
 	Installers []string
 	Extras     []string
+	Depots     []string `json:",omitempty"`
 }
 
 func (p *ProtectedGameFiles) AddGameFile(file FileInfo) {
+	if file.Kind == "depot" {
+		if !containsStr((*p).Depots, file.Name) {
+			(*p).Depots = append((*p).Depots, file.Name)
+		}
+		return
+	}
+
 	if file.Kind == "extra" {
 		if !containsStr((*p).Extras, file.Name) {
 			(*p).Extras = append((*p).Extras, file.Name)
@@ -19,6 +27,11 @@ func (p *ProtectedGameFiles) AddGameFile(file FileInfo) {
 }
 
 func (p *ProtectedGameFiles) RemoveGameFile(file FileInfo) {
+	if file.Kind == "depot" {
+		(*p).Depots = RemoveStrFromList((*p).Depots, file.Name)
+		return
+	}
+
 	if file.Kind == "extra" {
 		(*p).Extras = RemoveStrFromList((*p).Extras, file.Name)
 		return
@@ -39,6 +52,7 @@ func (p *ProtectedManifestFiles) AddGameFile(file FileInfo) {
 		game = ProtectedGameFiles{
 			Installers: []string{},
 			Extras:     []string{},
+			Depots:     []string{},
 		}
 	}
 	
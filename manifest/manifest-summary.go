@@ -7,32 +7,108 @@ type GameSummary struct {
 	SizeAsString string
 	Installers   int
 	Extras       int
+	Depots       int
+	Dlcs         int
+}
+
+type GameDlcsSummary struct {
+	Id    int64
+	Title string
+	Dlcs  []ManifestGameDlc
+}
+
+//Games whose files were selected by a rule of the policy of the manifest
+type PolicyRuleSummary struct {
+	Rule         string
+	Games        []GameSummary
+	Size         int64
+	SizeAsString string
 }
 
 type ManifestSummary struct {
 	Games               int
 	Files               int
 	Installers          int
+	Patches             int
+	LanguagePacks       int
 	Extras              int
+	Depots              int
+	Dlcs                int
+	OrphanDlcs          int
 	Size                int64
 	SizeAsString        string
 	SizeAverage         int64
 	SizeAverageAsString string
 	LargestGame         GameSummary
 	SmallestGame        GameSummary
+	GamesWithDlcs       []GameDlcsSummary
+	PolicyRules         []PolicyRuleSummary `json:",omitempty"`
+}
+
+func (m *Manifest) getPolicyRulesSummary() []PolicyRuleSummary {
+	summaries := []PolicyRuleSummary{}
+	indexes := make(map[string]int)
+	for _, rule := range (*m).Filter.PolicyRules {
+		indexes[rule.Name] = len(summaries)
+		summaries = append(summaries, PolicyRuleSummary{Rule: rule.Name, Games: []GameSummary{}})
+	}
+
+	for _, game := range (*m).Games {
+		idx, ok := indexes[game.PolicyRule]
+		if game.PolicyRule == "" || (!ok) {
+			continue
+		}
+		summaries[idx].Games = append(summaries[idx].Games, GameSummary{
+			Id:           game.Id,
+			Title:        game.Title,
+			Size:         game.VerifiedSize,
+			SizeAsString: GetBytesToEstimate(game.VerifiedSize),
+			Installers:   len(game.Installers),
+			Extras:       len(game.Extras),
+			Depots:       len(game.Depots),
+			Dlcs:         len(game.Dlcs),
+		})
+		summaries[idx].Size += game.VerifiedSize
+	}
+
+	for idx, _ := range summaries {
+		summaries[idx].SizeAsString = GetBytesToEstimate(summaries[idx].Size)
+	}
+	return summaries
 }
 
 func (m *Manifest) GetSummary() ManifestSummary {
 	filesCount := 0
 	installersCount := 0
 	extrasCount := 0
+	depotsCount := 0
+	patchesCount := 0
+	languagePacksCount := 0
+	dlcsCount := 0
+	orphanDlcsCount := 0
+	gamesWithDlcs := []GameDlcsSummary{}
 	var largestGame GameSummary
 	var smallestGame GameSummary
 
 	for _, game := range (*m).Games {
-		filesCount += (len(game.Installers) + len(game.Extras))
+		filesCount += (len(game.Installers) + len(game.Extras) + len(game.Depots))
 		installersCount += len(game.Installers)
 		extrasCount += len(game.Extras)
+		depotsCount += len(game.Depots)
+		dlcsCount += len(game.Dlcs)
+		if game.IsBaseProductMissing {
+			orphanDlcsCount++
+		}
+		if len(game.Dlcs) > 0 {
+			gamesWithDlcs = append(gamesWithDlcs, GameDlcsSummary{Id: game.Id, Title: game.Title, Dlcs: game.Dlcs})
+		}
+		for _, installer := range game.Installers {
+			if installer.IsPatch() {
+				patchesCount++
+			} else if installer.IsLanguagePack() {
+				languagePacksCount++
+			}
+		}
 
 		if largestGame.Id == 0 || (game.VerifiedSize > largestGame.Size) {
 			largestGame.Id = game.Id
@@ -40,6 +116,8 @@ func (m *Manifest) GetSummary() ManifestSummary {
 			largestGame.Size = game.VerifiedSize
 			largestGame.Installers = len(game.Installers)
 			largestGame.Extras = len(game.Extras)
+			largestGame.Depots = len(game.Depots)
+			largestGame.Dlcs = len(game.Dlcs)
 		}
 
 		if smallestGame.Id == 0 || (game.VerifiedSize < smallestGame.Size) {
@@ -48,6 +126,8 @@ func (m *Manifest) GetSummary() ManifestSummary {
 			smallestGame.Size = game.VerifiedSize
 			smallestGame.Installers = len(game.Installers)
 			smallestGame.Extras = len(game.Extras)
+			smallestGame.Depots = len(game.Depots)
+			smallestGame.Dlcs = len(game.Dlcs)
 		}
 	}
 
@@ -58,12 +138,19 @@ func (m *Manifest) GetSummary() ManifestSummary {
 		Games:               len((*m).Games),
 		Files:               filesCount,
 		Installers:          installersCount,
+		Patches:             patchesCount,
+		LanguagePacks:       languagePacksCount,
 		Extras:              extrasCount,
+		Depots:              depotsCount,
+		Dlcs:                dlcsCount,
+		OrphanDlcs:          orphanDlcsCount,
 		Size:                (*m).VerifiedSize,
 		SizeAsString:        GetBytesToEstimate((*m).VerifiedSize),
 		SizeAverage:         (*m).VerifiedSize / int64(len((*m).Games)),
 		SizeAverageAsString: GetBytesToEstimate((*m).VerifiedSize / int64(len((*m).Games))),
 		LargestGame:         largestGame,
 		SmallestGame:        smallestGame,
+		GamesWithDlcs:       gamesWithDlcs,
+		PolicyRules:         m.getPolicyRulesSummary(),
 	}
 }
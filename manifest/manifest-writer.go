@@ -12,6 +12,16 @@ type ManifestGamesWriterState struct {
 	Manifest Manifest
 	GameIds  []int64
 	Warnings []string
+	Accounts *ManifestAccountsProgress `json:",omitempty"`
+}
+
+//Progress of a generation over several accounts, whose games are generated in turn.
+//The games of the account being generated are in the manifest of the state until they are merged with those of the previous accounts.
+type ManifestAccountsProgress struct {
+	Accounts []string
+	Current  int
+	GameIds  []int64
+	Games    []ManifestGame
 }
 
 type ManifestGamesWriter struct {
@@ -96,3 +106,71 @@ func (w *ManifestGamesWriter) Write(getter ManifestGameGetter, persister Manifes
 
 	return errs
 }
+
+
+func isSameAccountsList(accounts []string, other []string) bool {
+	if len(accounts) != len(other) {
+		return false
+	}
+	for idx, _ := range accounts {
+		if accounts[idx] != other[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+//Generates the games of each account in turn and merges them in the order the accounts were given.
+//The progress over the accounts is part of the persisted state, so that a resumed generation only processes the remaining games and accounts.
+func (w *ManifestGamesWriter) WriteAccounts(accounts []string, getGetter func(account string) ManifestGameGetter, persister ManifestWriterStatePersister) []error {
+	if (*w).State.Accounts == nil {
+		if len((*w).State.Manifest.Games) > 0 {
+			return []error{errors.New("WriteAccounts(...) -> Progress is for a generation with a single account")}
+		}
+		(*w).State.Accounts = &ManifestAccountsProgress{
+			Accounts: accounts,
+			Current:  0,
+			GameIds:  (*w).State.GameIds,
+			Games:    []ManifestGame{},
+		}
+	} else if !isSameAccountsList((*(*w).State.Accounts).Accounts, accounts) {
+		msg := fmt.Sprintf("WriteAccounts(...) -> Progress is for accounts %v and not %v", (*(*w).State.Accounts).Accounts, accounts)
+		return []error{errors.New(msg)}
+	}
+
+	progress := (*w).State.Accounts
+	for (*progress).Current < len(accounts) {
+		name := accounts[(*progress).Current]
+
+		//Games left and no game ids to process means the generation was interrupted right after the last game of the account
+		if len((*w).State.Manifest.Games) == 0 || len((*w).State.GameIds) > 0 {
+			errs := w.Write(getGetter(name), persister)
+			if len(errs) > 0 {
+				return errs
+			}
+		}
+
+		accountGames := (*w).State.Manifest.Games
+		for idx, _ := range accountGames {
+			accountGames[idx].SetAccount(name)
+		}
+		merged := Manifest{Games: (*progress).Games}
+		merged.MergeAccountGames(accountGames)
+
+		(*progress).Games = merged.Games
+		(*progress).Current++
+		(*w).State.Manifest.Games = []ManifestGame{}
+		(*w).State.GameIds = (*progress).GameIds
+		(*w).logger.Info(fmt.Sprintf("Got all games of account %s", name))
+
+		persistErr := persister((*w).State)
+		if persistErr != nil {
+			return []error{errors.New(fmt.Sprintf("Failed to persist state due to error: %s", persistErr.Error()))}
+		}
+	}
+
+	(*w).State.Manifest.Games = (*progress).Games
+	(*w).State.GameIds = []int64{}
+	(*w).State.Accounts = nil
+	return []error{}
+}
@@ -0,0 +1,134 @@
+package manifest
+
+import (
+	"encoding/json"
+	"errors"
+	"gogcli/logging"
+	"testing"
+)
+
+//Returns a getter generating the given games, or only those whose ids are requested
+func getWriterTestGetter(games []ManifestGame) ManifestGameGetter {
+	return func(done <-chan struct{}, gameIds []int64, f ManifestFilter) (<-chan ManifestGameGetterGame, <-chan ManifestGameGetterGameIds) {
+		ids := []int64{}
+		selected := []ManifestGame{}
+		for _, game := range games {
+			if len(gameIds) == 0 || len(RemoveIdFromList(gameIds, game.Id)) < len(gameIds) {
+				ids = append(ids, game.Id)
+				selected = append(selected, game)
+			}
+		}
+
+		gameCh := make(chan ManifestGameGetterGame, len(selected))
+		idsCh := make(chan ManifestGameGetterGameIds, 1)
+		idsCh <- ManifestGameGetterGameIds{Ids: ids}
+		for _, game := range selected {
+			gameCh <- ManifestGameGetterGame{Game: game}
+		}
+		close(gameCh)
+		return gameCh, idsCh
+	}
+}
+
+func getWriterTestAccounts() map[string][]ManifestGame {
+	return map[string][]ManifestGame{
+		"alice": []ManifestGame{
+			getAccountGameFixture(1, []string{"/base"}, ""),
+			getAccountGameFixture(2, []string{"/other"}, ""),
+		},
+		"bob": []ManifestGame{
+			getAccountGameFixture(1, []string{"/base", "/dlc"}, ""),
+			getAccountGameFixture(3, []string{"/third"}, ""),
+		},
+	}
+}
+
+func checkWriterTestGames(t *testing.T, games []ManifestGame) {
+	if len(games) != 3 || games[0].Id != 1 || games[1].Id != 2 || games[2].Id != 3 {
+		t.Fatalf("Expected the games of both accounts to be merged in order and got %d games", len(games))
+	}
+	if len(games[0].Accounts) != 2 || len(games[0].Installers) != 2 || games[2].Accounts[0] != "bob" {
+		t.Errorf("Expected the games to be merged with their accounts")
+	}
+}
+
+func TestManifestGamesWriterWriteAccounts(t *testing.T) {
+	accountGames := getWriterTestAccounts()
+	getGetter := func(account string) ManifestGameGetter {
+		return getWriterTestGetter(accountGames[account])
+	}
+	persisted := 0
+	persister := func(state ManifestGamesWriterState) error {
+		persisted++
+		return nil
+	}
+
+	writer := NewManifestGamesWriter(NewManifestGamesWriterState(ManifestFilter{}, []int64{}), logging.CreateSource("error"))
+	errs := writer.WriteAccounts([]string{"alice", "bob"}, getGetter, persister)
+	if len(errs) > 0 {
+		t.Fatalf("Writing the games of the accounts failed: %v", errs)
+	}
+
+	checkWriterTestGames(t, writer.State.Manifest.Games)
+	if writer.State.Accounts != nil || len(writer.State.GameIds) != 0 {
+		t.Errorf("Expected the progress over the accounts to be cleared once done")
+	}
+	if persisted != 6 {
+		t.Errorf("Expected the state to be persisted after each game and account and got %d persists", persisted)
+	}
+}
+
+func TestManifestGamesWriterResumeAccounts(t *testing.T) {
+	accountGames := getWriterTestAccounts()
+	getGetter := func(account string) ManifestGameGetter {
+		return getWriterTestGetter(accountGames[account])
+	}
+
+	//Interrupts the generation at each possible point and resumes it from the last persisted state
+	for failAt := 1; failAt <= 6; failAt++ {
+		var saved []byte
+		persisted := 0
+		failing := func(state ManifestGamesWriterState) error {
+			persisted++
+			if persisted == failAt {
+				return errors.New("interrupted")
+			}
+			saved, _ = json.Marshal(&state)
+			return nil
+		}
+
+		writer := NewManifestGamesWriter(NewManifestGamesWriterState(ManifestFilter{}, []int64{}), logging.CreateSource("error"))
+		errs := writer.WriteAccounts([]string{"alice", "bob"}, getGetter, failing)
+		if len(errs) == 0 {
+			t.Fatalf("Expected the generation to be interrupted at persist %d", failAt)
+		}
+
+		resumed := NewManifestGamesWriter(NewManifestGamesWriterState(ManifestFilter{}, []int64{}), logging.CreateSource("error"))
+		if saved != nil {
+			json.Unmarshal(saved, &resumed.State)
+		}
+		errs = resumed.WriteAccounts([]string{"alice", "bob"}, getGetter, func(state ManifestGamesWriterState) error { return nil })
+		if len(errs) > 0 {
+			t.Fatalf("Resuming the generation interrupted at persist %d failed: %v", failAt, errs)
+		}
+		checkWriterTestGames(t, resumed.State.Manifest.Games)
+	}
+}
+
+func TestManifestGamesWriterResumeOtherAccounts(t *testing.T) {
+	writer := NewManifestGamesWriter(NewManifestGamesWriterState(ManifestFilter{}, []int64{}), logging.CreateSource("error"))
+	writer.State.Accounts = &ManifestAccountsProgress{Accounts: []string{"alice", "bob"}, Current: 1}
+
+	errs := writer.WriteAccounts([]string{"bob", "alice"}, nil, nil)
+	if len(errs) == 0 {
+		t.Errorf("Expected resuming with other accounts to be an error")
+	}
+
+	writer = NewManifestGamesWriter(NewManifestGamesWriterState(ManifestFilter{}, []int64{3}), logging.CreateSource("error"))
+	writer.State.Manifest.Games = []ManifestGame{getAccountGameFixture(1, []string{"/base"}, "")}
+
+	errs = writer.WriteAccounts([]string{"alice", "bob"}, nil, nil)
+	if len(errs) == 0 {
+		t.Errorf("Expected resuming a single account generation with several accounts to be an error")
+	}
+}
@@ -48,8 +48,10 @@ func (m *Manifest) ImprintProtectedFiles(prev *Manifest) {
 		if prevGame, ok := prevGames[game.Id]; ok {
 			if protectedGameFiles, ok := protectedFiles[game.Id]; ok {
 				game.ImprintProtectedFiles(&prevGame, &protectedGameFiles)
-				(*m).Games[idx] = game
 			}
+			//Installers that kept patches apply to are protected as well
+			game.ImprintPatchBases(&prevGame)
+			(*m).Games[idx] = game
 		}
 	}
 }
@@ -74,10 +76,25 @@ func (m *Manifest) ImprintMissingChecksums(prev *Manifest) error {
 	return nil
 }
 
+//Files are trimmed the same way with or without policy rules, so that games left without files are dropped either way
 func (m *Manifest) Trim() {
 	m.TrimGames()
-	m.TrimInstallers()
-	m.TrimExtras()
+	m.TrimFilesFromPolicy()
+	m.TrimGameSizes()
+}
+
+//Trims the files of each game with the policy rule that matches it, or with the filter if none does.
+//Games left without files are removed.
+func (m *Manifest) TrimFilesFromPolicy() {
+	filteredGames := make([]ManifestGame, 0)
+	for _, g := range (*m).Games {
+		g.TrimFilesFromPolicy(&(*m).Filter)
+		if !g.IsEmpty() {
+			filteredGames = append(filteredGames, g)
+		}
+	}
+
+	(*m).Games = filteredGames
 }
 
 func (m *Manifest) ImprintFilter(prev *Manifest) {
@@ -105,6 +122,9 @@ func (m *Manifest) Finalize() ManifestFilenameDuplicates {
 	(*m).Games = filteredGames
 
 	duplicates := m.HandleDuplicateFilenames()
+	for idx, _ := range (*m).Games {
+		(*m).Games[idx].ComputeDlcs()
+	}
 	m.ComputeEstimatedSize()
 	m.ComputeVerifiedSize()
 	return duplicates
@@ -113,7 +133,7 @@ func (m *Manifest) Finalize() ManifestFilenameDuplicates {
 func (m *Manifest) TrimGames() {
 	filteredGames := make([]ManifestGame, 0)
 
-	if len((*m).Filter.Titles) == 0 && len((*m).Filter.Tags) == 0 && len((*m).Filter.HasUrls) == 0 {
+	if len((*m).Filter.Titles) == 0 && len((*m).Filter.Tags) == 0 && len((*m).Filter.HasUrls) == 0 && (!(*m).Filter.SkipOrphanDlcs) && (!(*m).Filter.HasGamePredicates()) {
 		//Save some needless computation
 		return
 	}
@@ -127,6 +147,23 @@ func (m *Manifest) TrimGames() {
 	(*m).Games = filteredGames
 }
 
+//Runs after the files are trimmed, as the size cap applies to the files that are kept
+func (m *Manifest) TrimGameSizes() {
+	if (*m).Filter.MaxGameSize == 0 {
+		//Save some needless computation
+		return
+	}
+
+	filteredGames := make([]ManifestGame, 0)
+	for _, g := range (*m).Games {
+		if g.PassesSizeFilter(&(*m).Filter) {
+			filteredGames = append(filteredGames, g)
+		}
+	}
+
+	(*m).Games = filteredGames
+}
+
 func (m *Manifest) TrimInstallers() {
 	oses := (*m).Filter.Oses
 	languages := (*m).Filter.Languages
@@ -150,6 +187,43 @@ func (m *Manifest) TrimInstallers() {
 	(*m).Games = filteredGames
 }
 
+func (m *Manifest) TrimInstallerTypes() {
+	patches := (*m).Filter.Patches
+	skipLanguagePacks := (*m).Filter.SkipLanguagePacks
+	filteredGames := make([]ManifestGame, 0)
+
+	if getPatchesRank(patches) == getPatchesRank(PatchesAll) && (!skipLanguagePacks) {
+		//Save some needless computation
+		return
+	}
+
+	for _, g := range (*m).Games {
+		g.TrimInstallerTypes(patches, skipLanguagePacks)
+		if !g.IsEmpty() {
+			filteredGames = append(filteredGames, g)
+		}
+	}
+
+	(*m).Games = filteredGames
+}
+
+func (m *Manifest) TrimDlcs() {
+	if !(*m).Filter.SkipDlcs {
+		//Save some needless computation
+		return
+	}
+
+	filteredGames := make([]ManifestGame, 0)
+	for _, g := range (*m).Games {
+		g.TrimDlcs(true)
+		if !g.IsEmpty() {
+			filteredGames = append(filteredGames, g)
+		}
+	}
+
+	(*m).Games = filteredGames
+}
+
 func (m *Manifest) TrimExtras() {
 	typeTerms := (*m).Filter.ExtraTypes
 	keepAny := (*m).Filter.Extras
@@ -172,6 +246,24 @@ func (m *Manifest) TrimExtras() {
 	(*m).Games = filteredGames
 }
 
+func (m *Manifest) TrimDepots() {
+	oses := (*m).Filter.Oses
+	languages := (*m).Filter.Languages
+	keepAny := (*m).Filter.Depots
+	filteredGames := make([]ManifestGame, 0)
+
+	skipUrlFn := (*m).Filter.GetSkipUrlFn()
+
+	for _, g := range (*m).Games {
+		g.TrimDepots(oses, languages, keepAny, skipUrlFn)
+		if !g.IsEmpty() {
+			filteredGames = append(filteredGames, g)
+		}
+	}
+
+	(*m).Games = filteredGames
+}
+
 func (m *Manifest) OverwriteGames(games []ManifestGame) {
 	filteredGames := make([]ManifestGame, 0)
 	replaceMap := make(map[int64]ManifestGame)
@@ -9,11 +9,13 @@ import (
 )
 
 var estSizeRegex *regexp.Regexp
+var versionRangeRegex *regexp.Regexp
 var units map[string]int64
 var unitsList []string
 
 func init() {
 	estSizeRegex = regexp.MustCompile(`^(?P<amount>\d+(?:.\d+)?)[ ]*(?P<unit>[a-zA-Z]+)$`)
+	versionRangeRegex = regexp.MustCompile(`(?i)(?P<from>v?\d[^\s()_>→]*)[\s_]*(?:→|->|to)[\s_]*(?P<to>v?\d[^\s()_→]*)`)
 	units = map[string]int64{"kb": 1000, "mb": 1000000, "gb": 1000000000, "tb": 1000000000000}
 	unitsList = []string{"kb", "mb", "gb", "tb"}
 }
@@ -100,4 +102,27 @@ func RemoveStrFromList(list []string, elem string) []string {
 		}
 	}
 	return list
-}
\ No newline at end of file
+}
+
+//Extracts the versions of a patch from its title, ex: "Patch (1.2 to 1.3)", "patch 1.2 → 1.3" or "patch_game_1.2_to_1.3"
+func ParseVersionRange(title string) (string, string, bool) {
+	match := versionRangeRegex.FindStringSubmatch(title)
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+//Installer versions often carry a build suffix, ex: "1.3 (gog-2)", that patch titles do not have
+func IsSameVersion(version string, other string) bool {
+	normalize := func(v string) string {
+		fields := strings.Fields(strings.TrimSpace(v))
+		if len(fields) == 0 {
+			return ""
+		}
+		return strings.TrimPrefix(strings.ToLower(fields[0]), "v")
+	}
+
+	normalized := normalize(version)
+	return normalized != "" && normalized == normalize(other)
+}
@@ -57,3 +57,23 @@ func TestGetBytesToEstimate(t *testing.T) {
 		t.Errorf("Clearly fractional TB value %s doesn't match expected: 5.63 TB", GetBytesToEstimate(5630000000011))
 	}
 }
+
+func TestParseVersionRange(t *testing.T) {
+	titles := map[string][]string{
+		"Patch (1.2 to 1.3)":           []string{"1.2", "1.3"},
+		"patch 1.0.2 → 1.1":            []string{"1.0.2", "1.1"},
+		"patch_game_2.5.16.6_to_2.6":   []string{"2.5.16.6", "2.6"},
+		"Update v1.04->v1.05 (hotfix)": []string{"v1.04", "v1.05"},
+	}
+
+	for title, expected := range titles {
+		from, to, ok := ParseVersionRange(title)
+		if (!ok) || from != expected[0] || to != expected[1] {
+			t.Errorf("Expected versions %v from %s and got %s, %s", expected, title, from, to)
+		}
+	}
+
+	if _, _, ok := ParseVersionRange("Game Installer (part 1 of 2)"); ok {
+		t.Errorf("Title without version range should not yield versions")
+	}
+}
@@ -0,0 +1,19 @@
+package metrics
+
+var Default = NewRegistry()
+
+var (
+	BytesDownloaded = Default.NewCounter("gogcli_downloaded_bytes_total", "Bytes read from download sources while executing actions")
+	BytesUploaded   = Default.NewCounter("gogcli_uploaded_bytes_total", "Bytes of files successfully uploaded to a storage", "storage")
+	FilesProcessed  = Default.NewCounter("gogcli_files_processed_total", "Files successfully added or removed while executing actions", "storage", "action")
+	FilesFailed     = Default.NewCounter("gogcli_files_failed_total", "Files that could not be added or removed while executing actions", "storage", "action")
+	FileRetries     = Default.NewCounter("gogcli_file_retries_total", "Retries of file additions while executing actions", "storage")
+	SdkRequests     = Default.NewCounter("gogcli_sdk_requests_total", "Requests made to the gog api by endpoint and status code", "endpoint", "code")
+	SdkRetries      = Default.NewCounter("gogcli_sdk_retries_total", "Retried requests made to the gog api by endpoint", "endpoint")
+
+	ManifestGames  = Default.NewGauge("gogcli_manifest_games", "Number of games in the manifest of a storage", "storage")
+	ManifestFiles  = Default.NewGauge("gogcli_manifest_files", "Number of files in the manifest of a storage", "storage", "kind")
+	ManifestSize   = Default.NewGauge("gogcli_manifest_size_bytes", "Verified size of the files in the manifest of a storage", "storage")
+	ActionsPending = Default.NewGauge("gogcli_actions_pending", "Number of actions left to execute in a storage", "storage")
+	LastValidation = Default.NewGauge("gogcli_last_successful_validation_timestamp_seconds", "Unix time of the last successful validation of a storage", "storage")
+)
@@ -0,0 +1,167 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type sample struct {
+	labelValues []string
+	value       float64
+}
+
+type metric struct {
+	name    string
+	help    string
+	kind    string
+	labels  []string
+	samples map[string]*sample
+	mutex   sync.Mutex
+}
+
+func (m *metric) update(labelValues []string, fn func(float64) float64) {
+	if len(labelValues) != len(m.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values and got %d", m.name, len(m.labels), len(labelValues)))
+	}
+
+	key := strings.Join(labelValues, "\xff")
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	s, ok := m.samples[key]
+	if !ok {
+		s = &sample{labelValues: append([]string{}, labelValues...)}
+		m.samples[key] = s
+	}
+	s.value = fn(s.value)
+}
+
+func (m *metric) write(w io.Writer) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.name, escapeHelp(m.help), m.name, m.kind)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(m.samples))
+	for key, _ := range m.samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := m.samples[key]
+		_, err = fmt.Fprintf(w, "%s%s %s\n", m.name, formatLabels(m.labels, s.labelValues), strconv.FormatFloat(s.value, 'g', -1, 64))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type Counter struct {
+	metric
+}
+
+func (c *Counter) Add(value float64, labelValues ...string) {
+	c.update(labelValues, func(curr float64) float64 {
+		return curr + value
+	})
+}
+
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+type Gauge struct {
+	metric
+}
+
+func (g *Gauge) Set(value float64, labelValues ...string) {
+	g.update(labelValues, func(curr float64) float64 {
+		return value
+	})
+}
+
+type Registry struct {
+	metrics []interface{ write(io.Writer) error }
+	mutex   sync.Mutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{metrics: []interface{ write(io.Writer) error }{}}
+}
+
+func (r *Registry) NewCounter(name string, help string, labels ...string) *Counter {
+	c := &Counter{metric{name: name, help: help, kind: "counter", labels: labels, samples: make(map[string]*sample)}}
+	r.mutex.Lock()
+	r.metrics = append(r.metrics, c)
+	r.mutex.Unlock()
+	return c
+}
+
+func (r *Registry) NewGauge(name string, help string, labels ...string) *Gauge {
+	g := &Gauge{metric{name: name, help: help, kind: "gauge", labels: labels, samples: make(map[string]*sample)}}
+	r.mutex.Lock()
+	r.metrics = append(r.metrics, g)
+	r.mutex.Unlock()
+	return g
+}
+
+//Writes the metrics in the prometheus text exposition format
+func (r *Registry) Write(w io.Writer) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, m := range r.metrics {
+		err := m.write(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func escapeHelp(help string) string {
+	return strings.NewReplacer("\\", "\\\\", "\n", "\\n").Replace(help)
+}
+
+func formatLabels(labels []string, values []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	replacer := strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\"", "\\\"")
+	pairs := make([]string, len(labels))
+	for idx, label := range labels {
+		pairs[idx] = fmt.Sprintf("%s=\"%s\"", label, replacer.Replace(values[idx]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	counter     *Counter
+	labelValues []string
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	if n > 0 {
+		c.counter.Add(float64(n), c.labelValues...)
+	}
+	return n, err
+}
+
+//Returns a reader that adds the bytes read through it to the counter
+func NewCountingReadCloser(rc io.ReadCloser, counter *Counter, labelValues ...string) io.ReadCloser {
+	return &countingReadCloser{ReadCloser: rc, counter: counter, labelValues: labelValues}
+}
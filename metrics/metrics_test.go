@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_requests_total", "Requests", "endpoint", "code")
+	g := r.NewGauge("test_games", "Games")
+
+	c.Inc("GetProduct", "200")
+	c.Add(2, "GetProduct", "200")
+	c.Inc("GetOwnedGames", "error")
+	g.Set(5)
+	g.Set(3)
+
+	var buf bytes.Buffer
+	err := r.Write(&buf)
+	if err != nil {
+		t.Errorf("Writing the metrics returned an error: %s", err.Error())
+	}
+
+	expected := strings.Join([]string{
+		"# HELP test_requests_total Requests",
+		"# TYPE test_requests_total counter",
+		"test_requests_total{endpoint=\"GetOwnedGames\",code=\"error\"} 1",
+		"test_requests_total{endpoint=\"GetProduct\",code=\"200\"} 3",
+		"# HELP test_games Games",
+		"# TYPE test_games gauge",
+		"test_games 3",
+		"",
+	}, "\n")
+
+	if buf.String() != expected {
+		t.Errorf("Written metrics:\n%s\ndon't match expected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestLabelEscaping(t *testing.T) {
+	labels := formatLabels([]string{"storage"}, []string{"a\"b\\c\nd"})
+	if labels != "{storage=\"a\\\"b\\\\c\\nd\"}" {
+		t.Errorf("Escaped labels %s don't match expected value", labels)
+	}
+}
+
+func TestCountingReadCloser(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("test_bytes_total", "Bytes")
+	reader := NewCountingReadCloser(ioutil.NopCloser(strings.NewReader("abcdef")), c)
+	ioutil.ReadAll(reader)
+
+	var buf bytes.Buffer
+	r.Write(&buf)
+	if !strings.Contains(buf.String(), "test_bytes_total 6\n") {
+		t.Errorf("Counted bytes in:\n%s\ndon't match expected value of 6", buf.String())
+	}
+}
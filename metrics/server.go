@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"net/http"
+)
+
+type Collector func() error
+
+//Collectors are called on each scrape to refresh gauges before they are written
+func Handler(r *Registry, collectors ...Collector) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		for _, collect := range collectors {
+			err := collect()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.Write(w)
+	})
+}
+
+func ListenAndServe(address string, r *Registry, collectors ...Collector) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(r, collectors...))
+	return http.ListenAndServe(address, mux)
+}
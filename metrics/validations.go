@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+//Validation times are kept locally so that a standalone metrics server can report on validations done by other commands
+func GetValidationsFilePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gogcli", "validations.json"), nil
+}
+
+func LoadValidations(file string) (map[string]int64, error) {
+	validations := make(map[string]int64)
+
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return validations, nil
+		}
+		return validations, err
+	}
+
+	err = json.Unmarshal(bs, &validations)
+	return validations, err
+}
+
+func StoreValidation(file string, storage string, t time.Time) error {
+	validations, err := LoadValidations(file)
+	if err != nil {
+		return err
+	}
+	validations[storage] = t.Unix()
+
+	bs, err := json.MarshalIndent(validations, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(file), 0755)
+	if err != nil {
+		return err
+	}
+
+	LastValidation.Set(float64(t.Unix()), storage)
+	return ioutil.WriteFile(file, bs, 0644)
+}
+
+func ImprintValidations(file string) error {
+	validations, err := LoadValidations(file)
+	if err != nil {
+		return err
+	}
+
+	for storage, timestamp := range validations {
+		LastValidation.Set(float64(timestamp), storage)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	htmlTmpl "html/template"
+	"io"
+	"strings"
+	textTmpl "text/template"
+)
+
+const (
+	FormatMarkdown = "md"
+	FormatHtml     = "html"
+	FormatJson     = "json"
+)
+
+func IsValidFormat(format string) bool {
+	return format == FormatMarkdown || format == FormatHtml || format == FormatJson
+}
+
+func formatSize(size int64) string {
+	return manifest.GetBytesToEstimate(size)
+}
+
+func formatDelta(delta int64) string {
+	if delta < 0 {
+		return "-" + manifest.GetBytesToEstimate(-delta)
+	}
+	return "+" + manifest.GetBytesToEstimate(delta)
+}
+
+func formatVersion(version string, date string) string {
+	if version == "" && date == "" {
+		return "unversioned"
+	} else if version == "" {
+		return date
+	} else if date == "" {
+		return version
+	}
+	return fmt.Sprintf("%s (%s)", version, date)
+}
+
+func getFuncs() map[string]interface{} {
+	return map[string]interface{}{
+		"join":    strings.Join,
+		"size":    formatSize,
+		"delta":   formatDelta,
+		"version": formatVersion,
+	}
+}
+
+func Render(r *Report, format string, w io.Writer) error {
+	if format == FormatJson {
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	} else if format == FormatMarkdown {
+		tmpl, err := textTmpl.New("markdown").Funcs(getFuncs()).Parse(markdownTemplate)
+		if err != nil {
+			return err
+		}
+		return tmpl.Execute(w, r)
+	} else if format == FormatHtml {
+		tmpl, err := htmlTmpl.New("html").Funcs(getFuncs()).Parse(htmlTemplate)
+		if err != nil {
+			return err
+		}
+		return tmpl.Execute(w, r)
+	}
+
+	msg := fmt.Sprintf("Render(format=%s, ...) -> Format is not supported. Can be 'md', 'html' or 'json'", format)
+	return errors.New(msg)
+}
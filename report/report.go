@@ -0,0 +1,332 @@
+package report
+
+import (
+	"fmt"
+	"gogcli/manifest"
+	"sort"
+	"strings"
+)
+
+type FileReport struct {
+	Kind      string
+	Title     string
+	Name      string
+	Os        string   `json:",omitempty"`
+	Languages []string `json:",omitempty"`
+	Version   string   `json:",omitempty"`
+	Size      int64
+	Checksum  string
+}
+
+type VersionChange struct {
+	Title       string
+	Os          string
+	Languages   []string
+	Type        string
+	FromName    string
+	ToName      string
+	FromVersion string
+	ToVersion   string
+	FromDate    string
+	ToDate      string
+	SizeDelta   int64
+}
+
+//Files that kept their name but not their content, usually because GOG.com re-uploaded them without notice
+type ChecksumChange struct {
+	Kind         string
+	Title        string
+	Name         string
+	FromChecksum string
+	ToChecksum   string
+	FromSize     int64
+	ToSize       int64
+}
+
+type GameReport struct {
+	Id              int64
+	Title           string
+	FromSize        int64
+	ToSize          int64
+	SizeDelta       int64
+	NewFiles        []FileReport
+	RemovedFiles    []FileReport
+	VersionChanges  []VersionChange
+	ChecksumChanges []ChecksumChange
+}
+
+type Report struct {
+	FromGames    int
+	ToGames      int
+	FromSize     int64
+	ToSize       int64
+	SizeDelta    int64
+	NewGames     []GameReport
+	RemovedGames []GameReport
+	UpdatedGames []GameReport
+}
+
+func (g *GameReport) HasChanges() bool {
+	return len((*g).NewFiles) > 0 || len((*g).RemovedFiles) > 0 || len((*g).VersionChanges) > 0 || len((*g).ChecksumChanges) > 0 || (*g).SizeDelta != 0
+}
+
+func (r *Report) HasChanges() bool {
+	return len((*r).NewGames) > 0 || len((*r).RemovedGames) > 0 || len((*r).UpdatedGames) > 0
+}
+
+type reportFile struct {
+	report    FileReport
+	installer *manifest.ManifestGameInstaller
+}
+
+func getGameFiles(game *manifest.ManifestGame) []reportFile {
+	files := []reportFile{}
+	for idx, _ := range (*game).Installers {
+		installer := (*game).Installers[idx]
+		files = append(files, reportFile{
+			report: FileReport{
+				Kind:      installer.GetType(),
+				Title:     installer.Title,
+				Name:      installer.Name,
+				Os:        installer.Os,
+				Languages: installer.Languages,
+				Version:   installer.Version,
+				Size:      installer.VerifiedSize,
+				Checksum:  installer.Checksum,
+			},
+			installer: &installer,
+		})
+	}
+
+	for _, extra := range (*game).Extras {
+		files = append(files, reportFile{report: FileReport{
+			Kind:     "extra",
+			Title:    extra.Title,
+			Name:     extra.Name,
+			Size:     extra.VerifiedSize,
+			Checksum: extra.Checksum,
+		}})
+	}
+
+	for _, depot := range (*game).Depots {
+		files = append(files, reportFile{report: FileReport{
+			Kind:      "depot",
+			Title:     depot.Title,
+			Name:      depot.Name,
+			Os:        depot.Os,
+			Languages: depot.Languages,
+			Version:   depot.Version,
+			Size:      depot.VerifiedSize,
+			Checksum:  depot.Checksum,
+		}})
+	}
+
+	return files
+}
+
+func getFileKey(file FileReport) string {
+	return file.Kind + "|" + file.Name
+}
+
+//Installers for the same platform and with the same title are considered to be versions of the same installer
+func getInstallerKey(installer *manifest.ManifestGameInstaller) string {
+	languages := append([]string{}, (*installer).Languages...)
+	sort.Strings(languages)
+	return fmt.Sprintf("%s|%s|%s|%s", installer.GetType(), (*installer).Os, strings.Join(languages, ","), (*installer).Title)
+}
+
+func getFileReports(files []reportFile) []FileReport {
+	reports := make([]FileReport, len(files))
+	for idx, file := range files {
+		reports[idx] = file.report
+	}
+	return reports
+}
+
+func newGameReport(from *manifest.ManifestGame, to *manifest.ManifestGame) GameReport {
+	report := GameReport{
+		NewFiles:        []FileReport{},
+		RemovedFiles:    []FileReport{},
+		VersionChanges:  []VersionChange{},
+		ChecksumChanges: []ChecksumChange{},
+	}
+
+	fromFiles := []reportFile{}
+	toFiles := []reportFile{}
+	if from != nil {
+		report.Id = (*from).Id
+		report.Title = (*from).Title
+		report.FromSize = (*from).VerifiedSize
+		fromFiles = getGameFiles(from)
+	}
+	if to != nil {
+		report.Id = (*to).Id
+		report.Title = (*to).Title
+		report.ToSize = (*to).VerifiedSize
+		toFiles = getGameFiles(to)
+	}
+	report.SizeDelta = report.ToSize - report.FromSize
+
+	fromByKey := map[string]reportFile{}
+	for _, file := range fromFiles {
+		fromByKey[getFileKey(file.report)] = file
+	}
+	toByKey := map[string]reportFile{}
+	for _, file := range toFiles {
+		toByKey[getFileKey(file.report)] = file
+	}
+
+	removed := []reportFile{}
+	for _, file := range fromFiles {
+		next, ok := toByKey[getFileKey(file.report)]
+		if !ok {
+			removed = append(removed, file)
+			continue
+		}
+
+		if file.report.Checksum != "" && next.report.Checksum != "" && file.report.Checksum != next.report.Checksum {
+			report.ChecksumChanges = append(report.ChecksumChanges, ChecksumChange{
+				Kind:         file.report.Kind,
+				Title:        file.report.Title,
+				Name:         file.report.Name,
+				FromChecksum: file.report.Checksum,
+				ToChecksum:   next.report.Checksum,
+				FromSize:     file.report.Size,
+				ToSize:       next.report.Size,
+			})
+		}
+	}
+
+	added := []reportFile{}
+	for _, file := range toFiles {
+		if _, ok := fromByKey[getFileKey(file.report)]; !ok {
+			added = append(added, file)
+		}
+	}
+
+	//Installers replaced by another version of themselves are reported as version changes rather than additions and removals
+	removedInstallers := map[string]int{}
+	for idx, file := range removed {
+		if file.installer != nil {
+			removedInstallers[getInstallerKey(file.installer)] = idx
+		}
+	}
+
+	replaced := map[int]bool{}
+	for _, file := range added {
+		if file.installer != nil {
+			if idx, ok := removedInstallers[getInstallerKey(file.installer)]; ok && (!replaced[idx]) {
+				prev := removed[idx]
+				replaced[idx] = true
+				report.VersionChanges = append(report.VersionChanges, VersionChange{
+					Title:       file.report.Title,
+					Os:          file.report.Os,
+					Languages:   file.report.Languages,
+					Type:        file.report.Kind,
+					FromName:    prev.report.Name,
+					ToName:      file.report.Name,
+					FromVersion: (*prev.installer).Version,
+					ToVersion:   (*file.installer).Version,
+					FromDate:    (*prev.installer).Date,
+					ToDate:      (*file.installer).Date,
+					SizeDelta:   file.report.Size - prev.report.Size,
+				})
+				continue
+			}
+		}
+		report.NewFiles = append(report.NewFiles, file.report)
+	}
+
+	for idx, file := range removed {
+		if !replaced[idx] {
+			report.RemovedFiles = append(report.RemovedFiles, file.report)
+		}
+	}
+
+	//Installers that kept their name can still have a new version
+	for _, file := range fromFiles {
+		next, ok := toByKey[getFileKey(file.report)]
+		if ok && file.installer != nil && next.installer != nil {
+			if (*file.installer).Version != (*next.installer).Version || (*file.installer).Date != (*next.installer).Date {
+				report.VersionChanges = append(report.VersionChanges, VersionChange{
+					Title:       next.report.Title,
+					Os:          next.report.Os,
+					Languages:   next.report.Languages,
+					Type:        next.report.Kind,
+					FromName:    file.report.Name,
+					ToName:      next.report.Name,
+					FromVersion: (*file.installer).Version,
+					ToVersion:   (*next.installer).Version,
+					FromDate:    (*file.installer).Date,
+					ToDate:      (*next.installer).Date,
+					SizeDelta:   next.report.Size - file.report.Size,
+				})
+			}
+		}
+	}
+
+	if from == nil {
+		report.NewFiles = getFileReports(toFiles)
+	}
+	if to == nil {
+		report.RemovedFiles = getFileReports(fromFiles)
+	}
+
+	return report
+}
+
+func sortGameReports(reports []GameReport) {
+	sort.SliceStable(reports, func(i, j int) bool {
+		if reports[i].Title != reports[j].Title {
+			return reports[i].Title < reports[j].Title
+		}
+		return reports[i].Id < reports[j].Id
+	})
+}
+
+//Computes the changes needed to go from one manifest to the other, game by game
+func NewReport(from *manifest.Manifest, to *manifest.Manifest) Report {
+	report := Report{
+		FromGames:    len((*from).Games),
+		ToGames:      len((*to).Games),
+		FromSize:     (*from).VerifiedSize,
+		ToSize:       (*to).VerifiedSize,
+		NewGames:     []GameReport{},
+		RemovedGames: []GameReport{},
+		UpdatedGames: []GameReport{},
+	}
+	report.SizeDelta = report.ToSize - report.FromSize
+
+	fromGames := map[int64]*manifest.ManifestGame{}
+	for idx, _ := range (*from).Games {
+		fromGames[(*from).Games[idx].Id] = &(*from).Games[idx]
+	}
+	toGames := map[int64]*manifest.ManifestGame{}
+	for idx, _ := range (*to).Games {
+		toGames[(*to).Games[idx].Id] = &(*to).Games[idx]
+	}
+
+	for idx, _ := range (*to).Games {
+		game := &(*to).Games[idx]
+		if prev, ok := fromGames[(*game).Id]; ok {
+			gameReport := newGameReport(prev, game)
+			if gameReport.HasChanges() {
+				report.UpdatedGames = append(report.UpdatedGames, gameReport)
+			}
+		} else {
+			report.NewGames = append(report.NewGames, newGameReport(nil, game))
+		}
+	}
+
+	for idx, _ := range (*from).Games {
+		game := &(*from).Games[idx]
+		if _, ok := toGames[(*game).Id]; !ok {
+			report.RemovedGames = append(report.RemovedGames, newGameReport(game, nil))
+		}
+	}
+
+	sortGameReports(report.NewGames)
+	sortGameReports(report.RemovedGames)
+	sortGameReports(report.UpdatedGames)
+	return report
+}
@@ -0,0 +1,121 @@
+package report
+
+import (
+	"bytes"
+	"gogcli/manifest"
+	"strings"
+	"testing"
+)
+
+func getReportFixtures() (*manifest.Manifest, *manifest.Manifest) {
+	from := &manifest.Manifest{
+		Games: []manifest.ManifestGame{
+			manifest.ManifestGame{
+				Id:    1,
+				Title: "Updated Game",
+				Installers: []manifest.ManifestGameInstaller{
+					manifest.ManifestGameInstaller{Title: "Updated Game", Os: "windows", Languages: []string{"english"}, Name: "setup_1.0.exe", Version: "1.0", VerifiedSize: 100, Checksum: "a"},
+					manifest.ManifestGameInstaller{Title: "Updated Game", Os: "linux", Languages: []string{"english"}, Name: "game.sh", Version: "1.0", VerifiedSize: 100, Checksum: "b"},
+				},
+				Extras: []manifest.ManifestGameExtra{
+					manifest.ManifestGameExtra{Title: "Manual", Name: "manual.pdf", VerifiedSize: 10, Checksum: "c"},
+					manifest.ManifestGameExtra{Title: "Artbook", Name: "artbook.pdf", VerifiedSize: 10, Checksum: "d"},
+				},
+				VerifiedSize: 220,
+			},
+			manifest.ManifestGame{Id: 2, Title: "Removed Game", VerifiedSize: 50},
+			manifest.ManifestGame{Id: 3, Title: "Unchanged Game", VerifiedSize: 50},
+		},
+		VerifiedSize: 320,
+	}
+
+	to := &manifest.Manifest{
+		Games: []manifest.ManifestGame{
+			manifest.ManifestGame{
+				Id:    1,
+				Title: "Updated Game",
+				Installers: []manifest.ManifestGameInstaller{
+					manifest.ManifestGameInstaller{Title: "Updated Game", Os: "windows", Languages: []string{"english"}, Name: "setup_1.1.exe", Version: "1.1", VerifiedSize: 120, Checksum: "e"},
+					manifest.ManifestGameInstaller{Title: "Updated Game", Os: "linux", Languages: []string{"english"}, Name: "game.sh", Version: "1.1", VerifiedSize: 110, Checksum: "f"},
+				},
+				Extras: []manifest.ManifestGameExtra{
+					manifest.ManifestGameExtra{Title: "Manual", Name: "manual.pdf", VerifiedSize: 12, Checksum: "g"},
+					manifest.ManifestGameExtra{Title: "Soundtrack", Name: "soundtrack.zip", VerifiedSize: 30, Checksum: "h"},
+				},
+				VerifiedSize: 272,
+			},
+			manifest.ManifestGame{Id: 3, Title: "Unchanged Game", VerifiedSize: 50},
+			manifest.ManifestGame{Id: 4, Title: "New Game", VerifiedSize: 40},
+		},
+		VerifiedSize: 362,
+	}
+
+	return from, to
+}
+
+func TestNewReport(t *testing.T) {
+	from, to := getReportFixtures()
+	r := NewReport(from, to)
+
+	if len(r.NewGames) != 1 || r.NewGames[0].Id != 4 {
+		t.Errorf("Expected the new game to be reported: %v", r.NewGames)
+	}
+	if len(r.RemovedGames) != 1 || r.RemovedGames[0].Id != 2 {
+		t.Errorf("Expected the removed game to be reported: %v", r.RemovedGames)
+	}
+	if len(r.UpdatedGames) != 1 {
+		t.Fatalf("Expected only the updated game to be reported and got %d games", len(r.UpdatedGames))
+	}
+	if r.SizeDelta != 42 {
+		t.Errorf("Expected a size delta of 42 and got %d", r.SizeDelta)
+	}
+
+	game := r.UpdatedGames[0]
+	if game.SizeDelta != 52 {
+		t.Errorf("Expected a game size delta of 52 and got %d", game.SizeDelta)
+	}
+
+	if len(game.VersionChanges) != 2 {
+		t.Fatalf("Expected 2 version changes and got %v", game.VersionChanges)
+	}
+	for _, change := range game.VersionChanges {
+		if change.FromVersion != "1.0" || change.ToVersion != "1.1" {
+			t.Errorf("Version change was not reported properly: %v", change)
+		}
+		if change.Os == "windows" && (change.FromName != "setup_1.0.exe" || change.ToName != "setup_1.1.exe" || change.SizeDelta != 20) {
+			t.Errorf("Renamed installer was not reported properly: %v", change)
+		}
+	}
+
+	if len(game.NewFiles) != 1 || game.NewFiles[0].Name != "soundtrack.zip" {
+		t.Errorf("Expected the soundtrack to be the only new file: %v", game.NewFiles)
+	}
+	if len(game.RemovedFiles) != 1 || game.RemovedFiles[0].Name != "artbook.pdf" {
+		t.Errorf("Expected the artbook to be the only removed file: %v", game.RemovedFiles)
+	}
+
+	if len(game.ChecksumChanges) != 2 {
+		t.Errorf("Expected 2 checksum changes and got %v", game.ChecksumChanges)
+	}
+}
+
+func TestRender(t *testing.T) {
+	from, to := getReportFixtures()
+	r := NewReport(from, to)
+
+	for _, format := range []string{FormatMarkdown, FormatHtml, FormatJson} {
+		var buf bytes.Buffer
+		err := Render(&r, format, &buf)
+		if err != nil {
+			t.Fatalf("Rendering in format %s failed: %s", format, err.Error())
+		}
+		if !strings.Contains(buf.String(), "soundtrack.zip") || !strings.Contains(buf.String(), "Removed Game") {
+			t.Errorf("Report in format %s is missing changes", format)
+		}
+	}
+
+	var buf bytes.Buffer
+	if Render(&r, "pdf", &buf) == nil {
+		t.Errorf("Rendering in an unsupported format should fail")
+	}
+}
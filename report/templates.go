@@ -0,0 +1,134 @@
+package report
+
+const markdownTemplate = `# Manifest Changes
+
+{{.FromGames}} games ({{size .FromSize}}) → {{.ToGames}} games ({{size .ToSize}}), {{delta .SizeDelta}}
+{{- if not .HasChanges}}
+
+No changes.
+{{- end}}
+{{- if .NewGames}}
+
+## New Games
+{{range .NewGames}}
+- **{{.Title}}** ({{.Id}}), {{size .ToSize}}
+{{- end}}
+{{- end}}
+{{- if .RemovedGames}}
+
+## Removed Games
+{{range .RemovedGames}}
+- **{{.Title}}** ({{.Id}}), {{size .FromSize}}
+{{- end}}
+{{- end}}
+{{- if .UpdatedGames}}
+
+## Updated Games
+{{- range .UpdatedGames}}
+
+### {{.Title}} ({{.Id}})
+
+Size: {{size .FromSize}} → {{size .ToSize}} ({{delta .SizeDelta}})
+{{- if .VersionChanges}}
+
+Version changes:
+{{range .VersionChanges}}
+- {{.Title}} [{{.Os}}{{if .Languages}}, {{join .Languages ", "}}{{end}}]: {{version .FromVersion .FromDate}} → {{version .ToVersion .ToDate}} ({{delta .SizeDelta}})
+{{- end}}
+{{- end}}
+{{- if .NewFiles}}
+
+New files:
+{{range .NewFiles}}
+- {{.Kind}}: {{.Title}} ` + "`{{.Name}}`" + `, {{size .Size}}
+{{- end}}
+{{- end}}
+{{- if .RemovedFiles}}
+
+Removed files:
+{{range .RemovedFiles}}
+- {{.Kind}}: {{.Title}} ` + "`{{.Name}}`" + `, {{size .Size}}
+{{- end}}
+{{- end}}
+{{- if .ChecksumChanges}}
+
+Checksum changes:
+{{range .ChecksumChanges}}
+- {{.Kind}}: {{.Title}} ` + "`{{.Name}}`" + `, {{.FromChecksum}} → {{.ToChecksum}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Manifest Changes</title>
+<style>
+body { font-family: sans-serif; margin: 1em 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { text-align: left; padding: 0.3em 0.6em; border-bottom: 1px solid #ccc; }
+td.checksum, td.name { font-family: monospace; font-size: 0.85em; }
+</style>
+</head>
+<body>
+<h1>Manifest Changes</h1>
+<p>{{.FromGames}} games ({{size .FromSize}}) → {{.ToGames}} games ({{size .ToSize}}), {{delta .SizeDelta}}</p>
+{{- if not .HasChanges}}
+<p>No changes.</p>
+{{- end}}
+{{- if .NewGames}}
+<h2>New Games</h2>
+<ul>
+{{- range .NewGames}}
+<li><strong>{{.Title}}</strong> ({{.Id}}), {{size .ToSize}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .RemovedGames}}
+<h2>Removed Games</h2>
+<ul>
+{{- range .RemovedGames}}
+<li><strong>{{.Title}}</strong> ({{.Id}}), {{size .FromSize}}</li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .UpdatedGames}}
+<h2>Updated Games</h2>
+{{- range .UpdatedGames}}
+<h3>{{.Title}} ({{.Id}})</h3>
+<p>Size: {{size .FromSize}} → {{size .ToSize}} ({{delta .SizeDelta}})</p>
+{{- if .VersionChanges}}
+<table>
+<tr><th>Installer</th><th>Os</th><th>Languages</th><th>Before</th><th>After</th><th>Size</th></tr>
+{{- range .VersionChanges}}
+<tr><td>{{.Title}}</td><td>{{.Os}}</td><td>{{join .Languages ", "}}</td><td>{{version .FromVersion .FromDate}}</td><td>{{version .ToVersion .ToDate}}</td><td>{{delta .SizeDelta}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if or .NewFiles .RemovedFiles}}
+<table>
+<tr><th>Change</th><th>Kind</th><th>Title</th><th>Name</th><th>Size</th></tr>
+{{- range .NewFiles}}
+<tr><td>New</td><td>{{.Kind}}</td><td>{{.Title}}</td><td class="name">{{.Name}}</td><td>{{size .Size}}</td></tr>
+{{- end}}
+{{- range .RemovedFiles}}
+<tr><td>Removed</td><td>{{.Kind}}</td><td>{{.Title}}</td><td class="name">{{.Name}}</td><td>{{size .Size}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .ChecksumChanges}}
+<table>
+<tr><th>Kind</th><th>Title</th><th>Name</th><th>Before</th><th>After</th></tr>
+{{- range .ChecksumChanges}}
+<tr><td>{{.Kind}}</td><td>{{.Title}}</td><td class="name">{{.Name}}</td><td class="checksum">{{.FromChecksum}}</td><td class="checksum">{{.ToChecksum}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- end}}
+{{- end}}
+</body>
+</html>
+`
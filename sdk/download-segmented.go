@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"gogcli/metrics"
+	"gogcli/segmented"
+	"io"
+)
+
+//Size of the segments when the file metadata doesn't list chunks, same as gog's chunks
+const DEFAULT_SEGMENT_SIZE = int64(10 * 1024 * 1024)
+
+//Returns the chunks listed in the xml metadata of the file, if any
+func (s *Sdk) retrieveDownloadSegments(metadataUrl string, fn string) []segmented.Segment {
+	segments := []segmented.Segment{}
+	fileInfo := XmlFile{Chunks: make([]XmlFileChunk, 0)}
+
+	reply, err := s.getUrlBody(metadataUrl, fn, false, (*s).maxRetries)
+	if err != nil {
+		(*s).logger.Debug(fmt.Sprintf("%s -> Could not retrieve file metadata: %s", fn, err.Error()))
+		return segments
+	}
+
+	err = xml.Unmarshal(reply.Body, &fileInfo)
+	if err != nil {
+		(*s).logger.Debug(fmt.Sprintf("%s -> Could not parse file metadata: %s", fn, err.Error()))
+		return segments
+	}
+
+	for _, chunk := range fileInfo.Chunks {
+		segments = append(segments, segmented.Segment{From: chunk.From, To: chunk.To, Checksum: chunk.Checksum})
+	}
+
+	if !segmented.IsContiguous(segments, fileInfo.Size) {
+		(*s).logger.Debug(fmt.Sprintf("%s -> File metadata chunks do not cover the file, ignoring them", fn))
+		return []segmented.Segment{}
+	}
+
+	return segments
+}
+
+//Checks that the cdn answers range requests with partial content
+func (s *Sdk) supportsRangeRequests(downloadUrl string, fn string) bool {
+	reply, err := s.getUrlBodyReaderWithHeaders(downloadUrl, fn, map[string]string{"Range": "bytes=0-0"}, (*s).maxRetries)
+	if reply.BodyHandle != nil {
+		reply.BodyHandle.Close()
+	}
+	return err == nil && reply.StatusCode == 206
+}
+
+func (s *Sdk) getDownloadSegment(downloadUrl string, fn string, segment segmented.Segment) (io.ReadCloser, error) {
+	reply, err := s.getUrlBodyReaderWithHeaders(downloadUrl, fn, map[string]string{"Range": segment.GetRangeHeader()}, (*s).maxRetries)
+	if err != nil {
+		if reply.BodyHandle != nil {
+			reply.BodyHandle.Close()
+		}
+		return nil, err
+	}
+
+	if reply.StatusCode != 206 {
+		reply.BodyHandle.Close()
+		msg := fmt.Sprintf("%s -> Expected status code of 206 for range %s and got %d", fn, segment.GetRangeHeader(), reply.StatusCode)
+		return nil, errors.New(msg)
+	}
+
+	return reply.BodyHandle, nil
+}
+
+//Downloads the file over several connections, reusing the chunk boundaries of the file metadata when possible.
+//Falls back to a single connection for files below the minimum size or when the cdn doesn't support range requests.
+func (s *Sdk) getSegmentedDownloadHandle(u string, fn string) (io.ReadCloser, int64, string, error) {
+	redirect, err := s.getUrlRedirect(u, fn, (*s).maxRetries)
+	if err != nil {
+		return nil, int64(0), "", getDownloadError(err, fn, redirect.StatusCode)
+	}
+	downloadUrl := redirect.RedirectUrl
+
+	(*s).logger.Debug(fmt.Sprintf("Final Url: %s", downloadUrl))
+
+	filename, err := getFilenameFromUrlPath(downloadUrl, fn)
+	if err != nil {
+		return nil, int64(0), "", err
+	}
+
+	segments := []segmented.Segment{}
+	metadataUrl, err := convertDownloadUrlToMetadataUrl(downloadUrl)
+	if err == nil {
+		segments = s.retrieveDownloadSegments(metadataUrl, fn)
+	}
+
+	size := int64(-1)
+	if len(segments) > 0 {
+		size = segments[len(segments)-1].To + 1
+	} else {
+		lengthReply, lengthErr := s.getUrlBodyLength(downloadUrl, fn, (*s).maxRetries)
+		if lengthErr == nil {
+			size = lengthReply.BodyLength
+			segments = segmented.Split(size, DEFAULT_SEGMENT_SIZE)
+		}
+	}
+
+	if size < (*s).segmentedMinSize || len(segments) < 2 || (!s.supportsRangeRequests(downloadUrl, fn)) {
+		reply, err := s.getUrlBodyReader(downloadUrl, fn, (*s).maxRetries)
+		if err != nil {
+			if reply.BodyHandle != nil {
+				reply.BodyHandle.Close()
+			}
+			return nil, int64(0), "", getDownloadError(err, fn, reply.StatusCode)
+		}
+		return reply.BodyHandle, reply.BodyLength, filename, nil
+	}
+
+	(*s).logger.Debug(fmt.Sprintf("%s -> Downloading %s in %d segments over %d connections", fn, filename, len(segments), (*s).downloadConnections))
+
+	reader := segmented.NewReader(
+		segments,
+		func(segment segmented.Segment) (io.ReadCloser, error) {
+			return s.getDownloadSegment(downloadUrl, fn, segment)
+		},
+		(*s).downloadConnections,
+		int((*s).maxRetries),
+		func(segment segmented.Segment, retriesLeft int, err error) {
+			(*s).logger.Warning(fmt.Sprintf("%s -> Segment %s failed with error %s. Will retry.", fn, segment.GetRangeHeader(), err.Error()))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fn))
+			s.pauseAfterError()
+		},
+	)
+	return reader, size, filename, nil
+}
@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestGetSegmentedDownloadHandleNotFound(t *testing.T) {
+	serveTestRequests(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "www.gog.com" {
+			http.Redirect(w, r, "https://cdn.gog.com/setup_game.exe", http.StatusFound)
+			return
+		}
+		http.NotFound(w, r)
+	})
+	s := newTestSdk("session")
+	s.SetSegmentedDownloads(4, 0)
+
+	_, _, _, err := s.GetDownloadHandle("/downloads/game/en1installer0")
+	if !IsDownloadNotFoundError(err) {
+		t.Errorf("Expected a file missing from the cdn to give a download not found error and got %v", err)
+	}
+}
+
+func TestGetSegmentedDownloadHandleSingleConnection(t *testing.T) {
+	content := "small installer"
+	serveTestRequests(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "www.gog.com" {
+			http.Redirect(w, r, "https://cdn.gog.com/setup_game.exe", http.StatusFound)
+			return
+		}
+		if r.URL.Path != "/setup_game.exe" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(content))
+	})
+	s := newTestSdk("session")
+	s.SetSegmentedDownloads(4, 1024)
+
+	handle, _, name, err := s.GetDownloadHandle("/downloads/game/en1installer0")
+	if err != nil {
+		t.Fatalf("Getting the download handle failed: %s", err.Error())
+	}
+	defer handle.Close()
+	body, _ := ioutil.ReadAll(handle)
+	if name != "setup_game.exe" || string(body) != content {
+		t.Errorf("Expected files below the minimum size to be downloaded over a single connection and got %s with %q", name, string(body))
+	}
+}
@@ -17,7 +17,7 @@ type XmlFile struct {
 	Name     string   `xml:"name,attr"`
 	Checksum string   `xml:"md5,attr"`
 	Size     int64    `xml:"total_size,attr"`
-	Chunks   []XmlFileChunk
+	Chunks   []XmlFileChunk `xml:"chunk"`
 }
 
 type XmlFileChunk struct {
@@ -57,7 +57,7 @@ func (s *Sdk) retrieveDownloadMetadata(metadataUrl string, fn string, retriesLef
 			Checksum: "",
 			Size: int64(-1),
 			Found: reply.StatusCode != 403 && reply.StatusCode != 404,
-			BadMetadata: reply.StatusCode != 403 && reply.StatusCode != 404,
+			BadMetadata: reply.StatusCode != 403 && reply.StatusCode != 404 && (!IsSessionExpiredError(err)),
 		}, err
 	}
 
@@ -126,6 +126,9 @@ func (s *Sdk) getDownloadFileInfo(downloadPath string) (string, string, int64, e
 	//Redirection
 	reply, err := s.getUrlRedirect(u, fn, (*s).maxRetries)
 	if err != nil {
+		if IsSessionExpiredError(err) {
+			return "", "", int64(-1), err, false, false
+		}
 		return "", "", int64(-1), err, reply.StatusCode == 403 || reply.StatusCode == 404, reply.StatusCode != 403 && reply.StatusCode != 404
 	}
 	filenameLoc := reply.RedirectUrl
@@ -151,7 +154,7 @@ func (s *Sdk) getDownloadFileInfo(downloadPath string) (string, string, int64, e
 
 		lengthReply, lengthErr := s.getUrlBodyLength(filenameLoc, fn, (*s).maxRetries)
 		if lengthErr != nil {
-			return "", "", int64(-1), lengthErr, false, true
+			return "", "", int64(-1), lengthErr, false, !IsSessionExpiredError(lengthErr)
 		}
 
 		return filename, "", lengthReply.BodyLength, nil, false, false
@@ -247,9 +250,16 @@ func (s *Sdk) GetDownloadHandle(downloadPath string) (io.ReadCloser, int64, stri
 	fn := fmt.Sprintf("GetDownloadHandle(downloadPath=%s)", downloadPath)
 	u := fmt.Sprintf("https://www.gog.com%s", downloadPath)
 
+	if (*s).downloadConnections > 1 {
+		return s.getSegmentedDownloadHandle(u, fn)
+	}
+
 	reply, err := s.getUrlBodyReader(u, fn, (*s).maxRetries)
 	if err != nil {
-		return nil, int64(0), "", err
+		if reply.BodyHandle != nil {
+			reply.BodyHandle.Close()
+		}
+		return nil, int64(0), "", getDownloadError(err, fn, reply.StatusCode)
 	}
 	
 	(*s).logger.Debug(fmt.Sprintf("Final Url: %s", reply.FinalUrl))
@@ -258,4 +268,31 @@ func (s *Sdk) GetDownloadHandle(downloadPath string) (io.ReadCloser, int64, stri
 	filename := path.Base(downloadUrl.Path)
 
 	return reply.BodyHandle, reply.BodyLength, filename, nil
-}
\ No newline at end of file
+}
+
+//Gets a handle on the file content starting at the given offset along with the length left to download
+func (s *Sdk) GetDownloadHandleFrom(downloadPath string, offset int64) (io.ReadCloser, int64, string, error) {
+	fn := fmt.Sprintf("GetDownloadHandleFrom(downloadPath=%s, offset=%d)", downloadPath, offset)
+	u := fmt.Sprintf("https://www.gog.com%s", downloadPath)
+
+	reply, err := s.getUrlBodyReaderWithHeaders(u, fn, map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)}, (*s).maxRetries)
+	if err != nil {
+		if reply.BodyHandle != nil {
+			reply.BodyHandle.Close()
+		}
+		return nil, int64(0), "", getDownloadError(err, fn, reply.StatusCode)
+	}
+
+	if reply.StatusCode != 206 {
+		reply.BodyHandle.Close()
+		msg := fmt.Sprintf("%s -> Expected status code of 206 and got %d", fn, reply.StatusCode)
+		return nil, int64(0), "", errors.New(msg)
+	}
+
+	(*s).logger.Debug(fmt.Sprintf("Final Url: %s", reply.FinalUrl))
+
+	downloadUrl, _ := url.Parse(reply.FinalUrl)
+	filename := path.Base(downloadUrl.Path)
+
+	return reply.BodyHandle, reply.BodyLength, filename, nil
+}
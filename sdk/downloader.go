@@ -1,6 +1,9 @@
 package sdk
 
 import (
+	"errors"
+	"fmt"
+	"gogcli/logging"
 	"gogcli/manifest"
 	"io"
 )
@@ -11,5 +14,84 @@ type Downloader struct {
 }
 
 func (d Downloader) Download(file manifest.FileInfo) (io.ReadCloser, int64, string, error) {
+	if file.Kind == "depot" {
+		return d.SdkPtrPtr.GetDepotHandle(file.Url)
+	}
 	return d.SdkPtrPtr.GetDownloadHandle(file.Url)
 }
+
+//Depots are assembled from their chunks so the content before the offset is read and discarded
+func (d Downloader) DownloadFrom(file manifest.FileInfo, offset int64) (io.ReadCloser, int64, string, error) {
+	if file.Kind != "depot" {
+		return d.SdkPtrPtr.GetDownloadHandleFrom(file.Url, offset)
+	}
+
+	handle, size, name, err := d.SdkPtrPtr.GetDepotHandle(file.Url)
+	if err != nil {
+		return nil, int64(0), "", err
+	}
+
+	_, err = io.CopyN(io.Discard, handle, offset)
+	if err != nil {
+		handle.Close()
+		msg := fmt.Sprintf("DownloadFrom(file={Url=%s, ...}, offset=%d) -> Error occured while skipping to the offset: %s", file.Url, offset, err.Error())
+		return nil, int64(0), "", errors.New(msg)
+	}
+
+	return handle, size - offset, name, nil
+}
+
+//Implementation of the Downloader interface for libraries spanning several accounts.
+//Files are downloaded with the accounts that own them, falling back to the next owner when an account cannot access a file.
+type AccountsDownloader struct {
+	Accounts map[string]*Sdk
+	Default  *Sdk
+	Logger   *logging.Logger
+}
+
+type accountDownload func(d Downloader) (io.ReadCloser, int64, string, error)
+
+func (d AccountsDownloader) download(file manifest.FileInfo, fn accountDownload) (io.ReadCloser, int64, string, error) {
+	sdks := []*Sdk{}
+	names := []string{}
+	for _, account := range file.Accounts {
+		if sdkPtr, ok := d.Accounts[account]; ok {
+			sdks = append(sdks, sdkPtr)
+			names = append(names, account)
+		}
+	}
+	if len(sdks) == 0 {
+		return fn(Downloader{SdkPtrPtr: d.Default})
+	}
+
+	var err error
+	for idx, sdkPtr := range sdks {
+		var handle io.ReadCloser
+		var size int64
+		var name string
+		handle, size, name, err = fn(Downloader{SdkPtrPtr: sdkPtr})
+		if err == nil {
+			return handle, size, name, nil
+		}
+
+		if !(IsDownloadNotFoundError(err) || IsSessionExpiredError(err)) {
+			return nil, int64(0), "", err
+		}
+		if idx < len(sdks)-1 {
+			d.Logger.Warning(fmt.Sprintf("Account %s cannot download %s, trying with account %s => %s", names[idx], file.Url, names[idx+1], err.Error()))
+		}
+	}
+	return nil, int64(0), "", err
+}
+
+func (d AccountsDownloader) Download(file manifest.FileInfo) (io.ReadCloser, int64, string, error) {
+	return d.download(file, func(downloader Downloader) (io.ReadCloser, int64, string, error) {
+		return downloader.Download(file)
+	})
+}
+
+func (d AccountsDownloader) DownloadFrom(file manifest.FileInfo, offset int64) (io.ReadCloser, int64, string, error) {
+	return d.download(file, func(downloader Downloader) (io.ReadCloser, int64, string, error) {
+		return downloader.DownloadFrom(file, offset)
+	})
+}
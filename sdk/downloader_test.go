@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"gogcli/logging"
+	"gogcli/manifest"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"testing"
+)
+
+const testDownloadContent = "game installer"
+
+//Serves the installer to the sessions without an answer, the other sessions get the answer that goes with their status code
+func serveTestAccountDownloads(t *testing.T, answers map[string]int) map[string]int {
+	requests := map[string]int{}
+	serveTestRequests(t, func(w http.ResponseWriter, r *http.Request) {
+		session := getTestSession(r)
+		switch r.Host {
+		case "www.gog.com":
+			requests[session]++
+			switch answers[session] {
+			case http.StatusNotFound:
+				http.NotFound(w, r)
+			case http.StatusFound:
+				http.Redirect(w, r, "https://login.gog.com/auth?client_id=1", http.StatusFound)
+			case http.StatusInternalServerError:
+				http.Error(w, "error", http.StatusInternalServerError)
+			default:
+				http.Redirect(w, r, "https://cdn.gog.com/setup_game.exe", http.StatusFound)
+			}
+		case "login.gog.com":
+			w.Write([]byte("<html><body>Log in</body></html>"))
+		case "cdn.gog.com":
+			w.Write([]byte(testDownloadContent))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	return requests
+}
+
+func newTestAccountsDownloader(sessions ...string) AccountsDownloader {
+	accounts := map[string]*Sdk{}
+	for _, session := range sessions {
+		accounts[session] = newTestSdk(session)
+	}
+	return AccountsDownloader{
+		Accounts: accounts,
+		Default:  newTestSdk("default"),
+		Logger:   logging.CreateSource("error").CreateLogger(ioutil.Discard, "", log.Lmsgprefix),
+	}
+}
+
+func checkTestAccountDownload(t *testing.T, d AccountsDownloader, file manifest.FileInfo) {
+	handle, _, name, err := d.Download(file)
+	if err != nil {
+		t.Fatalf("Expected the file to be downloaded with another account and got %s", err.Error())
+	}
+	defer handle.Close()
+	body, _ := ioutil.ReadAll(handle)
+	if name != "setup_game.exe" || string(body) != testDownloadContent {
+		t.Errorf("Expected the file to be downloaded and got %s with %q", name, string(body))
+	}
+}
+
+func TestAccountsDownloaderNotFound(t *testing.T) {
+	requests := serveTestAccountDownloads(t, map[string]int{"first": http.StatusNotFound})
+	d := newTestAccountsDownloader("first", "second")
+
+	checkTestAccountDownload(t, d, manifest.FileInfo{Kind: "installer", Url: "/downloads/game/en1installer0", Accounts: []string{"first", "second"}})
+	if requests["first"] != 1 || requests["second"] != 1 {
+		t.Errorf("Expected each account to be tried once in order and got %v", requests)
+	}
+}
+
+func TestAccountsDownloaderSessionExpired(t *testing.T) {
+	requests := serveTestAccountDownloads(t, map[string]int{"first": http.StatusFound})
+	d := newTestAccountsDownloader("first", "second")
+
+	checkTestAccountDownload(t, d, manifest.FileInfo{Kind: "installer", Url: "/downloads/game/en1installer0", Accounts: []string{"first", "second"}})
+	if requests["first"] != 1 || requests["second"] != 1 {
+		t.Errorf("Expected each account to be tried once in order and got %v", requests)
+	}
+}
+
+func TestAccountsDownloaderOtherErrors(t *testing.T) {
+	requests := serveTestAccountDownloads(t, map[string]int{"first": http.StatusInternalServerError})
+	d := newTestAccountsDownloader("first", "second")
+
+	_, _, _, err := d.Download(manifest.FileInfo{Kind: "installer", Url: "/downloads/game/en1installer0", Accounts: []string{"first", "second"}})
+	if err == nil || IsDownloadNotFoundError(err) || IsSessionExpiredError(err) {
+		t.Errorf("Expected the error of the first account to be returned and got %v", err)
+	}
+	if requests["second"] != 0 {
+		t.Errorf("Expected errors other than inaccessible files not to be retried with other accounts")
+	}
+}
+
+func TestAccountsDownloaderAllAccountsFail(t *testing.T) {
+	serveTestAccountDownloads(t, map[string]int{"first": http.StatusFound, "second": http.StatusNotFound})
+	d := newTestAccountsDownloader("first", "second")
+
+	_, _, _, err := d.Download(manifest.FileInfo{Kind: "installer", Url: "/downloads/game/en1installer0", Accounts: []string{"first", "second"}})
+	if !IsDownloadNotFoundError(err) {
+		t.Errorf("Expected the error of the last account to be returned and got %v", err)
+	}
+}
+
+func TestAccountsDownloaderDefault(t *testing.T) {
+	requests := serveTestAccountDownloads(t, map[string]int{})
+	d := newTestAccountsDownloader("first")
+
+	checkTestAccountDownload(t, d, manifest.FileInfo{Kind: "installer", Url: "/downloads/game/en1installer0", Accounts: []string{"unknown"}})
+	if requests["default"] != 1 || requests["first"] != 0 {
+		t.Errorf("Expected files of unknown accounts to be downloaded with the default account and got %v", requests)
+	}
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"golang.org/x/net/html"
+	"net/url"
+	"strings"
+)
+
+//Returned when gog answers as if the user was not logged in, retrying the request is pointless in that case
+type SessionExpiredError struct {
+	FnCall string
+	Reason string
+}
+
+func (e *SessionExpiredError) Error() string {
+	return fmt.Sprintf("%s -> session expired: %s. A new cookie is needed.", e.FnCall, e.Reason)
+}
+
+//Lets the actions processor know that the download should not be retried
+func (e *SessionExpiredError) Unrecoverable() bool {
+	return true
+}
+
+func NewSessionExpiredError(fnCall string, reason string) error {
+	return &SessionExpiredError{FnCall: fnCall, Reason: reason}
+}
+
+func IsSessionExpiredError(err error) bool {
+	var sErr *SessionExpiredError
+	return errors.As(err, &sErr)
+}
+
+//Returned when the download url of a file is not accessible to the account, another account owning the game may still access it
+type DownloadNotFoundError struct {
+	FnCall     string
+	StatusCode int
+}
+
+func (e *DownloadNotFoundError) Error() string {
+	return fmt.Sprintf("%s -> download url answered with status code %d", e.FnCall, e.StatusCode)
+}
+
+func IsDownloadNotFoundError(err error) bool {
+	var nErr *DownloadNotFoundError
+	return errors.As(err, &nErr)
+}
+
+//Converts errors of replies with a 403 or 404 status code to a DownloadNotFoundError
+func getDownloadError(err error, fnCall string, statusCode int) error {
+	if statusCode == 403 || statusCode == 404 {
+		return &DownloadNotFoundError{FnCall: fnCall, StatusCode: statusCode}
+	}
+	return err
+}
+
+func isLoginUrl(u *url.URL) bool {
+	if u == nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return host == "login.gog.com" || host == "auth.gog.com" || strings.Contains(u.Fragment, "openlogin") || strings.HasPrefix(u.Path, "/login")
+}
+
+func isLoginLocation(location string) bool {
+	u, err := url.Parse(location)
+	if err != nil {
+		return false
+	}
+	return isLoginUrl(u)
+}
+
+//Html parsing is very lenient so the body also has to start with a tag
+func isHtml(body []byte) bool {
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("<")) {
+		return false
+	}
+	_, err := html.Parse(bytes.NewReader(body))
+	return err == nil
+}
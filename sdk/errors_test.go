@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestIsHtml(t *testing.T) {
+	expectations := map[string]bool{
+		"<!DOCTYPE html><html><body>Log in</body></html>": true,
+		"  \n<html><head></head></html>":                  true,
+		"<div>Partial page</div>":                         true,
+		"{\"error\": \"not found\"}":                      false,
+		"Service unavailable":                             false,
+		"":                                                false,
+	}
+	for body, expected := range expectations {
+		if isHtml([]byte(body)) != expected {
+			t.Errorf("Expected html detection of %q to be %t", body, expected)
+		}
+	}
+}
+
+func TestIsLoginUrl(t *testing.T) {
+	expectations := map[string]bool{
+		"https://login.gog.com/auth?client_id=1":          true,
+		"https://AUTH.gog.com/token":                      true,
+		"https://www.gog.com/#openlogin":                  true,
+		"https://www.gog.com/login?redirect=/account":     true,
+		"https://www.gog.com/account/getFilteredProducts": false,
+		"https://cdn.gog.com/login/setup_game.exe":        true,
+		"https://cdn.gog.com/setup_game.exe":              false,
+	}
+	for location, expected := range expectations {
+		u, _ := url.Parse(location)
+		if isLoginUrl(u) != expected {
+			t.Errorf("Expected login detection of %s to be %t", location, expected)
+		}
+		if isLoginLocation(location) != expected {
+			t.Errorf("Expected login detection of location %s to be %t", location, expected)
+		}
+	}
+
+	if isLoginUrl(nil) || isLoginLocation("%zz") {
+		t.Errorf("Expected missing and unparsable urls not to be login urls")
+	}
+}
+
+func TestSessionExpiredError(t *testing.T) {
+	err := NewSessionExpiredError("GetOwnedGames(page=1)", "expected json and got html from gog api call")
+	if !IsSessionExpiredError(err) || !IsSessionExpiredError(fmt.Errorf("wrapped: %w", err)) {
+		t.Errorf("Expected the session expired error to be recognized, including when wrapped")
+	}
+	if IsSessionExpiredError(errors.New("session expired")) {
+		t.Errorf("Expected other errors not to be session expired errors")
+	}
+
+	var sErr *SessionExpiredError
+	errors.As(err, &sErr)
+	if !sErr.Unrecoverable() {
+		t.Errorf("Expected an expired session not to be retried")
+	}
+	expected := "GetOwnedGames(page=1) -> session expired: expected json and got html from gog api call. A new cookie is needed."
+	if err.Error() != expected {
+		t.Errorf("Expected the message %q and got %q", expected, err.Error())
+	}
+}
+
+func TestGetDownloadError(t *testing.T) {
+	err := errors.New("did not expect status code")
+	for _, statusCode := range []int{403, 404} {
+		if !IsDownloadNotFoundError(getDownloadError(err, "fn", statusCode)) {
+			t.Errorf("Expected the status code %d to give a download not found error", statusCode)
+		}
+	}
+	for _, statusCode := range []int{-1, 401, 500} {
+		if getDownloadError(err, "fn", statusCode) != err {
+			t.Errorf("Expected the error of the status code %d to be left as it is", statusCode)
+		}
+	}
+}
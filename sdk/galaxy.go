@@ -0,0 +1,269 @@
+package sdk
+
+import (
+	"gogcli/galaxy"
+	"gogcli/manifest"
+
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//Returns the builds of the product for the os, from the most recent to the oldest. Products without builds yield an empty list.
+func (s *Sdk) GetGalaxyBuilds(productId int64, os string) (galaxy.Builds, error) {
+	var builds galaxy.Builds
+	fn := fmt.Sprintf("GetGalaxyBuilds(productId=%d, os=%s)", productId, os)
+	u := fmt.Sprintf("https://content-system.gog.com/products/%d/os/%s/builds?generation=2", productId, galaxy.ConvertOs(os))
+
+	reply, err := s.getUrlBody(u, fn, true, (*s).maxRetries)
+	if err != nil {
+		if reply.StatusCode == 404 {
+			return builds, nil
+		}
+		return builds, err
+	}
+
+	err = json.Unmarshal(reply.Body, &builds)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Response deserialization error: %s", fn, err.Error())
+		return builds, errors.New(msg)
+	}
+
+	return builds, nil
+}
+
+//Meta files are compressed so the generic body retrieval, which logs bodies, is not used
+func (s *Sdk) getGalaxyMeta(u string, fn string) ([]byte, error) {
+	reply, err := s.getUrlBodyReader(u, fn, (*s).maxRetries)
+	if reply.BodyHandle != nil {
+		defer reply.BodyHandle.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(reply.BodyHandle)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> body retrieval error: %s", fn, err.Error())
+		return nil, errors.New(msg)
+	}
+
+	decoded, err := galaxy.DecodeMeta(body)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> %s", fn, err.Error())
+		return nil, errors.New(msg)
+	}
+	return decoded, nil
+}
+
+func (s *Sdk) GetGalaxyBuildManifest(link string) (galaxy.BuildManifest, error) {
+	var buildManifest galaxy.BuildManifest
+	fn := fmt.Sprintf("GetGalaxyBuildManifest(link=%s)", link)
+
+	body, err := s.getGalaxyMeta(link, fn)
+	if err != nil {
+		return buildManifest, err
+	}
+
+	err = json.Unmarshal(body, &buildManifest)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Response deserialization error: %s", fn, err.Error())
+		return buildManifest, errors.New(msg)
+	}
+
+	return buildManifest, nil
+}
+
+//Returns the parsed depot manifest along with its decompressed content, which is stored as is in chunk archives
+func (s *Sdk) GetGalaxyDepotManifest(hash string) (galaxy.DepotManifest, []byte, error) {
+	var depotManifest galaxy.DepotManifest
+	fn := fmt.Sprintf("GetGalaxyDepotManifest(hash=%s)", hash)
+
+	body, err := s.getGalaxyMeta(galaxy.GetMetaUrl(hash), fn)
+	if err != nil {
+		return depotManifest, nil, err
+	}
+
+	err = json.Unmarshal(body, &depotManifest)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Response deserialization error: %s", fn, err.Error())
+		return depotManifest, nil, errors.New(msg)
+	}
+
+	return depotManifest, body, nil
+}
+
+//The content system expects the access token of the session rather than the session cookies
+func (s *Sdk) getGalaxyAccessToken() (string, error) {
+	fn := "getGalaxyAccessToken()"
+
+	reply, err := s.getUrlBody(
+		"https://menu.gog.com/v1/account/basic",
+		fn,
+		true,
+		(*s).maxRetries,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	var account accountBasic
+	err = json.Unmarshal(reply.Body, &account)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Response deserialization error: %s", fn, err.Error())
+		return "", errors.New(msg)
+	}
+
+	if (!account.IsLoggedIn) || account.AccessToken == "" {
+		return "", NewSessionExpiredError(fn, "no access token could be obtained as the user is not logged in anymore")
+	}
+
+	return account.AccessToken, nil
+}
+
+func (s *Sdk) GetGalaxySecureLinks(productId string) (galaxy.SecureLinks, error) {
+	var links galaxy.SecureLinks
+	fn := fmt.Sprintf("GetGalaxySecureLinks(productId=%s)", productId)
+	u := fmt.Sprintf("https://content-system.gog.com/products/%s/secure_link?generation=2&_version=2&path=/", productId)
+
+	token, err := s.getGalaxyAccessToken()
+	if err != nil {
+		return links, err
+	}
+
+	reply, err := s.getUrlBodyWithHeaders(u, fn, true, map[string]string{"Authorization": "Bearer " + token}, (*s).maxRetries)
+	if err != nil {
+		return links, err
+	}
+
+	err = json.Unmarshal(reply.Body, &links)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Response deserialization error: %s", fn, err.Error())
+		return links, errors.New(msg)
+	}
+
+	return links, nil
+}
+
+//Retrieves the depots of the latest build of the game for each os, keeping those matching the languages.
+//The size of the depot archives is computed from their manifests so that no chunk needs to be downloaded.
+func (s *Sdk) GetGameDepots(gameId int64, oses []string, languages []string, format string) ([]manifest.ManifestGameDepot, error) {
+	depots := []manifest.ManifestGameDepot{}
+
+	if len(oses) == 0 {
+		oses = []string{"windows", "mac", "linux"}
+	}
+
+	for _, os := range oses {
+		builds, err := s.GetGalaxyBuilds(gameId, os)
+		if err != nil {
+			return depots, err
+		}
+
+		build, ok := builds.GetLatestBuild()
+		if !ok {
+			continue
+		}
+
+		buildManifest, err := s.GetGalaxyBuildManifest(build.Link)
+		if err != nil {
+			return depots, err
+		}
+
+		for _, buildDepot := range buildManifest.GetProductDepots(strconv.FormatInt(gameId, 10)) {
+			depot := manifest.ManifestGameDepot{
+				Languages:     galaxy.ConvertLanguages(buildDepot.Languages),
+				Os:            os,
+				Url:           galaxy.GetDepotUrl(gameId, buildDepot.Manifest, format),
+				Title:         fmt.Sprintf("%s depot (%s)", os, strings.Join(buildDepot.Languages, ", ")),
+				Name:          galaxy.GetDepotArchiveName(os, buildDepot.Manifest, format),
+				ProductId:     gameId,
+				BuildId:       build.BuildId,
+				Manifest:      buildDepot.Manifest,
+				Format:        format,
+				Version:       build.VersionName,
+				Date:          build.DatePublished,
+				EstimatedSize: manifest.GetBytesToEstimate(buildDepot.Size),
+			}
+
+			if len(languages) > 0 && (!depot.HasOneOfLanguages(languages)) {
+				continue
+			}
+
+			depotManifest, raw, err := s.GetGalaxyDepotManifest(buildDepot.Manifest)
+			if err != nil {
+				return depots, err
+			}
+
+			depot.VerifiedSize, err = galaxy.GetArchiveSize(&depotManifest, raw, format)
+			if err != nil {
+				return depots, err
+			}
+
+			depots = append(depots, depot)
+		}
+	}
+
+	return depots, nil
+}
+
+//Streams the depot as a tar archive assembled from its chunks as they are downloaded
+func (s *Sdk) GetDepotHandle(depotUrl string) (io.ReadCloser, int64, string, error) {
+	fn := fmt.Sprintf("GetDepotHandle(depotUrl=%s)", depotUrl)
+
+	productId, hash, format, err := galaxy.ParseDepotUrl(depotUrl)
+	if err != nil {
+		return nil, int64(0), "", err
+	}
+
+	depotManifest, raw, err := s.GetGalaxyDepotManifest(hash)
+	if err != nil {
+		return nil, int64(0), "", err
+	}
+
+	size, err := galaxy.GetArchiveSize(&depotManifest, raw, format)
+	if err != nil {
+		return nil, int64(0), "", err
+	}
+
+	links, err := s.GetGalaxySecureLinks(productId)
+	if err != nil {
+		return nil, int64(0), "", err
+	}
+
+	getChunk := func(chunk galaxy.DepotChunk) (io.ReadCloser, error) {
+		//Large depots can take longer to download than the secure links last
+		if links.IsExpiredAt(time.Now().Add(time.Minute)) {
+			links, err = s.GetGalaxySecureLinks(productId)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		chunkUrl, err := links.GetChunkUrl(chunk.CompressedMd5)
+		if err != nil {
+			return nil, err
+		}
+
+		reply, err := s.getUrlBodyReader(chunkUrl, fn, (*s).maxRetries)
+		if err != nil {
+			if reply.BodyHandle != nil {
+				reply.BodyHandle.Close()
+			}
+			return nil, err
+		}
+		return reply.BodyHandle, nil
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(galaxy.WriteArchive(writer, &depotManifest, raw, format, getChunk))
+	}()
+
+	return reader, size, fmt.Sprintf("%s.tar", hash), nil
+}
@@ -41,6 +41,7 @@ type friend struct {
 }
 
 type User struct {
+	IsLoggedIn        bool
 	Country           string
 	SelectedCurrency  currency
 	PreferredLanguage language
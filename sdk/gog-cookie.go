@@ -6,9 +6,15 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 )
 
+//Cookie writes are read-modify-write operations that must not interleave
+var cookieWriteMutex sync.Mutex
+
 type FirefoxCookie struct {
 	RequestCookies map[string]string `json:"Request Cookies"`
 }
@@ -115,3 +121,143 @@ func ReadCookie(path string, kind string) ([]*http.Cookie, error) {
 
 	return []*http.Cookie{}, nil
 }
+
+func writeDefaultCookie(cookies []*http.Cookie) string {
+	lines := []string{}
+	for _, cookie := range cookies {
+		if cookie.Name == "sessions_gog_com" || cookie.Name == "gog-al" {
+			lines = append(lines, fmt.Sprintf("%s=%s", cookie.Name, cookie.Value))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func writeStringCookie(cookies []*http.Cookie) string {
+	pairs := make([]string, len(cookies))
+	for idx, cookie := range cookies {
+		pairs[idx] = fmt.Sprintf("%s=%s", cookie.Name, cookie.Value)
+	}
+	return strings.Join(pairs, "; ")
+}
+
+//Lines of the previous file are kept so that cookie attributes (domain, expiry, etc) are preserved
+func writeNetscapeCookie(previous string, cookies []*http.Cookie) string {
+	values := map[string]string{}
+	for _, cookie := range cookies {
+		values[cookie.Name] = cookie.Value
+	}
+
+	written := map[string]bool{}
+	lines := []string{}
+	for _, line := range strings.Split(strings.Replace(previous, "\r\n", "\n", -1), "\n") {
+		lineFields := strings.Split(line, "\t")
+		if strings.HasPrefix(line, "#") || len(lineFields) < 7 {
+			if line != "" {
+				lines = append(lines, line)
+			}
+			continue
+		}
+
+		value, ok := values[lineFields[5]]
+		if !ok {
+			continue
+		}
+		lineFields[6] = value
+		written[lineFields[5]] = true
+		lines = append(lines, strings.Join(lineFields, "\t"))
+	}
+
+	for _, cookie := range cookies {
+		if !written[cookie.Name] {
+			lines = append(lines, strings.Join([]string{".gog.com", "TRUE", "/", "TRUE", "0", cookie.Name, cookie.Value}, "\t"))
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+//Other keys of the previous file are kept
+func writeFirefoxCookie(previous string, cookies []*http.Cookie) (string, error) {
+	content := map[string]interface{}{}
+	if previous != "" {
+		err := json.Unmarshal([]byte(previous), &content)
+		if err != nil {
+			return "", errors.New(fmt.Sprintf("Following error occured while parsing Firefox cookie: %s", err.Error()))
+		}
+	}
+
+	requestCookies := map[string]string{}
+	for _, cookie := range cookies {
+		requestCookies[cookie.Name] = cookie.Value
+	}
+	content["Request Cookies"] = requestCookies
+
+	bs, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+//The cookie file is replaced atomically so that an interrupted write cannot corrupt it
+func WriteCookie(path string, kind string, cookies []*http.Cookie) error {
+	var content string
+	var err error
+
+	cookieWriteMutex.Lock()
+	defer cookieWriteMutex.Unlock()
+
+	previous := ""
+	bs, readErr := ioutil.ReadFile(path)
+	if readErr == nil {
+		previous = string(bs)
+	} else if !os.IsNotExist(readErr) {
+		msg := fmt.Sprintf("Error reading file to update cookie: %s", readErr.Error())
+		return errors.New(msg)
+	}
+
+	switch kind {
+	case "default":
+		content = writeDefaultCookie(cookies)
+	case "string":
+		content = writeStringCookie(cookies)
+	case "firefox":
+		content, err = writeFirefoxCookie(previous, cookies)
+	case "netscape":
+		content = writeNetscapeCookie(previous, cookies)
+	default:
+		msg := fmt.Sprintf("Cookie type of %s is not supported", kind)
+		err = errors.New(msg)
+	}
+	if err != nil {
+		return err
+	}
+
+	mode := os.FileMode(0600)
+	if info, statErr := os.Stat(path); statErr == nil {
+		mode = info.Mode()
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.WriteString(content)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), mode)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func getCookiesMap(cookies []*http.Cookie) map[string]string {
+	result := map[string]string{}
+	for _, cookie := range cookies {
+		result[cookie.Name] = cookie.Value
+	}
+	return result
+}
+
+func checkCookieDirectory(t *testing.T, dir string) {
+	files, _ := ioutil.ReadDir(dir)
+	names := []string{}
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+	if len(names) != 1 {
+		t.Errorf("Expected the cookie file to be the only file left and got %v", names)
+	}
+}
+
+func TestWriteCookieRoundTrip(t *testing.T) {
+	cookies := []*http.Cookie{
+		&http.Cookie{Name: "gog-al", Value: "al-value"},
+		&http.Cookie{Name: "sessions_gog_com", Value: "session-value"},
+	}
+
+	for _, kind := range []string{"default", "string", "netscape", "firefox"} {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "cookie")
+
+		err := WriteCookie(path, kind, cookies)
+		if err != nil {
+			t.Errorf("Writing a %s cookie failed: %s", kind, err.Error())
+			continue
+		}
+
+		read, err := ReadCookie(path, kind)
+		if err != nil {
+			t.Errorf("Reading a written %s cookie failed: %s", kind, err.Error())
+			continue
+		}
+
+		values := getCookiesMap(read)
+		if len(values) != 2 || values["gog-al"] != "al-value" || values["sessions_gog_com"] != "session-value" {
+			t.Errorf("Expected the %s cookie to keep its values and got %v", kind, values)
+		}
+
+		checkCookieDirectory(t, dir)
+	}
+}
+
+func TestWriteCookieKeepsPreviousContent(t *testing.T) {
+	dir := t.TempDir()
+
+	netscapePath := filepath.Join(dir, "netscape")
+	previous := "# Netscape HTTP Cookie File\nwww.gog.com\tFALSE\t/\tTRUE\t1999999999\tgog-al\told\n.gog.com\tTRUE\t/\tTRUE\t0\tgone\tvalue\n"
+	ioutil.WriteFile(netscapePath, []byte(previous), 0640)
+
+	err := WriteCookie(netscapePath, "netscape", []*http.Cookie{&http.Cookie{Name: "gog-al", Value: "new"}, &http.Cookie{Name: "added", Value: "value"}})
+	if err != nil {
+		t.Fatalf("Writing the netscape cookie failed: %s", err.Error())
+	}
+	bs, _ := ioutil.ReadFile(netscapePath)
+	expected := "# Netscape HTTP Cookie File\nwww.gog.com\tFALSE\t/\tTRUE\t1999999999\tgog-al\tnew\n.gog.com\tTRUE\t/\tTRUE\t0\tadded\tvalue\n"
+	if string(bs) != expected {
+		t.Errorf("Expected the attributes of the previous netscape cookie to be kept and got %q", string(bs))
+	}
+	if info, _ := os.Stat(netscapePath); info.Mode().Perm() != 0640 {
+		t.Errorf("Expected the permissions of the previous cookie file to be kept and got %v", info.Mode().Perm())
+	}
+
+	firefoxPath := filepath.Join(dir, "firefox")
+	ioutil.WriteFile(firefoxPath, []byte(`{"Request Cookies": {"gog-al": "old"}, "Response Cookies": {"other": "value"}}`), 0600)
+
+	err = WriteCookie(firefoxPath, "firefox", []*http.Cookie{&http.Cookie{Name: "gog-al", Value: "new"}})
+	if err != nil {
+		t.Fatalf("Writing the firefox cookie failed: %s", err.Error())
+	}
+	bs, _ = ioutil.ReadFile(firefoxPath)
+	if !strings.Contains(string(bs), `"Response Cookies"`) {
+		t.Errorf("Expected the other keys of the previous firefox cookie to be kept and got %s", string(bs))
+	}
+	read, _ := ReadCookie(firefoxPath, "firefox")
+	if getCookiesMap(read)["gog-al"] != "new" {
+		t.Errorf("Expected the firefox cookie to be updated")
+	}
+}
+
+func TestWriteCookieErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cookie")
+	ioutil.WriteFile(path, []byte("not json"), 0600)
+
+	err := WriteCookie(path, "firefox", []*http.Cookie{&http.Cookie{Name: "gog-al", Value: "new"}})
+	if err == nil {
+		t.Errorf("Expected an unparsable firefox cookie to be an error")
+	}
+
+	err = WriteCookie(path, "unknown", []*http.Cookie{})
+	if err == nil {
+		t.Errorf("Expected an unknown cookie type to be an error")
+	}
+
+	bs, _ := ioutil.ReadFile(path)
+	if string(bs) != "not json" {
+		t.Errorf("Expected failed writes to leave the cookie file untouched")
+	}
+	checkCookieDirectory(t, dir)
+}
+
+func TestWriteCookieConcurrently(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cookie")
+	values := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	var wg sync.WaitGroup
+	for _, value := range values {
+		wg.Add(1)
+		go func(value string) {
+			defer wg.Done()
+			err := WriteCookie(path, "netscape", []*http.Cookie{&http.Cookie{Name: "gog-al", Value: value}})
+			if err != nil {
+				t.Errorf("Concurrent write failed: %s", err.Error())
+			}
+		}(value)
+	}
+	wg.Wait()
+
+	read, err := ReadCookie(path, "netscape")
+	if err != nil || len(read) != 1 || sort.SearchStrings(values, read[0].Value) == len(values) {
+		t.Errorf("Expected concurrent writes to leave a single valid cookie")
+	}
+	checkCookieDirectory(t, dir)
+}
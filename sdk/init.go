@@ -1,6 +1,10 @@
 package sdk
 
+import "regexp"
+
 func init() {
 	LANGUAGE_MAP = getLanguageMap()
 	DOWNLOAD_METADATA_REGEX = getDownloadMetadataRegex()
+	PATCH_URL_REGEX = regexp.MustCompile(`/[a-z]{2,2}[0-9]+patch[0-9]+$`)
+	LANGUAGE_PACK_URL_REGEX = regexp.MustCompile(`/[a-z]{2,2}[0-9]+langpack[0-9]+$`)
 }
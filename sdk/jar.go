@@ -3,16 +3,82 @@ package sdk
 import (
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
+type CookiesChangeHandler func(cookies []*http.Cookie)
+
+//The jar is shared by all the clients of an sdk instance so that cookies refreshed by one response are used by subsequent requests
 type Jar struct {
-	cookies []*http.Cookie
+	cookies  []*http.Cookie
+	onChange CookiesChangeHandler
+	mutex    sync.Mutex
+	//Held while the change handler runs so that the last handler call always gets the latest cookies
+	changeMutex sync.Mutex
+}
+
+func NewJar(cookies []*http.Cookie) *Jar {
+	return &Jar{cookies: cookies}
 }
 
+func (jar *Jar) SetChangeHandler(onChange CookiesChangeHandler) {
+	jar.mutex.Lock()
+	defer jar.mutex.Unlock()
+	jar.onChange = onChange
+}
+
+func isExpiredCookie(cookie *http.Cookie) bool {
+	return cookie.MaxAge < 0 || ((!cookie.Expires.IsZero()) && cookie.Expires.Before(time.Now()))
+}
+
+//Only cookies set by gog hosts are retained and cookies are matched by name only, as they were when read from the cookie file
 func (jar *Jar) SetCookies(u *url.URL, cookies []*http.Cookie) {
-	jar.cookies = cookies
+	host := u.Hostname()
+	if host != "gog.com" && (!strings.HasSuffix(host, ".gog.com")) {
+		return
+	}
+
+	jar.mutex.Lock()
+	changed := false
+	for _, cookie := range cookies {
+		idx := -1
+		for cIdx, curr := range jar.cookies {
+			if curr.Name == cookie.Name {
+				idx = cIdx
+				break
+			}
+		}
+
+		if isExpiredCookie(cookie) {
+			if idx >= 0 {
+				jar.cookies = append(jar.cookies[:idx], jar.cookies[idx+1:]...)
+				changed = true
+			}
+		} else if idx < 0 {
+			jar.cookies = append(jar.cookies, &http.Cookie{Name: cookie.Name, Value: cookie.Value})
+			changed = true
+		} else if jar.cookies[idx].Value != cookie.Value {
+			jar.cookies[idx] = &http.Cookie{Name: cookie.Name, Value: cookie.Value}
+			changed = true
+		}
+	}
+	onChange := jar.onChange
+	jar.mutex.Unlock()
+
+	if changed && onChange != nil {
+		jar.changeMutex.Lock()
+		defer jar.changeMutex.Unlock()
+		onChange(jar.Cookies(u))
+	}
 }
 
 func (jar *Jar) Cookies(u *url.URL) []*http.Cookie {
-	return jar.cookies
+	jar.mutex.Lock()
+	defer jar.mutex.Unlock()
+
+	cookies := make([]*http.Cookie, len(jar.cookies))
+	copy(cookies, jar.cookies)
+	return cookies
 }
@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestJarSetCookies(t *testing.T) {
+	jar := NewJar([]*http.Cookie{&http.Cookie{Name: "gog-al", Value: "old"}, &http.Cookie{Name: "expiring", Value: "value"}})
+	changes := 0
+	jar.SetChangeHandler(func(cookies []*http.Cookie) {
+		changes++
+	})
+
+	other, _ := url.Parse("https://example.com")
+	jar.SetCookies(other, []*http.Cookie{&http.Cookie{Name: "gog-al", Value: "foreign"}})
+
+	gog, _ := url.Parse("https://embed.gog.com/userData.json")
+	jar.SetCookies(gog, []*http.Cookie{&http.Cookie{Name: "gog-al", Value: "old"}})
+	if changes != 0 {
+		t.Errorf("Expected cookies of other hosts and unchanged cookies to be ignored")
+	}
+
+	jar.SetCookies(gog, []*http.Cookie{
+		&http.Cookie{Name: "gog-al", Value: "new"},
+		&http.Cookie{Name: "expiring", MaxAge: -1},
+		&http.Cookie{Name: "added", Value: "value"},
+	})
+	values := getCookiesMap(jar.Cookies(gog))
+	if changes != 1 || len(values) != 2 || values["gog-al"] != "new" || values["added"] != "value" {
+		t.Errorf("Expected a single change updating, removing and adding cookies and got %d changes with %v", changes, values)
+	}
+}
+
+func TestJarChangeHandlerGetsLatestCookies(t *testing.T) {
+	jar := NewJar([]*http.Cookie{})
+	last := ""
+	jar.SetChangeHandler(func(cookies []*http.Cookie) {
+		last = getCookiesMap(cookies)["counter"]
+	})
+
+	gog, _ := url.Parse("https://www.gog.com")
+	var wg sync.WaitGroup
+	for idx := 0; idx < 20; idx++ {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			jar.SetCookies(gog, []*http.Cookie{&http.Cookie{Name: "counter", Value: strconv.Itoa(idx)}})
+		}(idx)
+	}
+	wg.Wait()
+
+	if last != getCookiesMap(jar.Cookies(gog))["counter"] {
+		t.Errorf("Expected the last change handler call to get the cookies left in the jar")
+	}
+}
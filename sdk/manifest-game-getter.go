@@ -5,6 +5,7 @@ import (
 	
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -47,6 +48,44 @@ func languageToAscii(unicodeRepresentation string) string {
 	return "unknown"
 }
 
+var PATCH_URL_REGEX *regexp.Regexp
+var LANGUAGE_PACK_URL_REGEX *regexp.Regexp
+
+//Download urls end with the language, kind and number of the file, ex: /downloads/some_game/en1patch2
+func getInstallerType(manualUrl string) string {
+	if PATCH_URL_REGEX.MatchString(manualUrl) {
+		return manifest.InstallerTypePatch
+	} else if LANGUAGE_PACK_URL_REGEX.MatchString(manualUrl) {
+		return manifest.InstallerTypeLanguagePack
+	}
+	return manifest.InstallerTypeInstaller
+}
+
+func getManifestGameInstaller(i gameDetailsDownloadsFile) manifest.ManifestGameInstaller {
+	installer := manifest.ManifestGameInstaller{
+		Languages:     []string{languageToAscii(i.Language)},
+		Os:            i.Os,
+		Url:           i.ManualUrl,
+		Title:         i.Name,
+		Type:          getInstallerType(i.ManualUrl),
+		Version:       i.Version,
+		Date:          i.Date,
+		EstimatedSize: i.Size,
+	}
+
+	if installer.IsPatch() {
+		fromVersion, toVersion, ok := manifest.ParseVersionRange(i.Name)
+		if ok {
+			installer.FromVersion = fromVersion
+			installer.ToVersion = toVersion
+		} else {
+			installer.ToVersion = i.Version
+		}
+	}
+
+	return installer
+}
+
 type ManifestGameResult struct {
 	Game  manifest.ManifestGame
 	Error error
@@ -90,9 +129,25 @@ func OwnedGamePagesToManifestGames(done <-chan struct{}, ownedGamesPageCh <-chan
 					}
 
 					game := manifest.ManifestGame{
-						Id:    product.Id,
-						Title: product.Title,
-						Slug:  product.Slug,
+						Id:                   product.Id,
+						Title:                product.Title,
+						Slug:                 product.Slug,
+						Category:             product.Category,
+						Rating:               product.Rating,
+						WorksOn:              product.GetWorksOn(),
+						ReleaseYear:          product.GetReleaseYear(),
+						IsHidden:             product.IsHidden,
+						IsInDevelopment:      product.IsInDevelopment,
+						IsGalaxyCompatible:   product.IsGalaxyCompatible,
+						IsBaseProductMissing: product.IsBaseProductMissing,
+					}
+
+					if game.IsBaseProductMissing && filter.SkipOrphanDlcs {
+						continue
+					}
+
+					if !game.PassesOwnedGameFilter(&filter) {
+						continue
 					}
 
 					if len(titles) > 0 && (!game.HasTitleTerms(titles)) {
@@ -139,6 +194,7 @@ func (s *Sdk) AddGameDetailsToGames(done <-chan struct{}, inGameCh <-chan Manife
 					if err != nil {
 						gameRes.Error = err
 						outGameCh <- gameRes
+						continue
 					}
 					game := gameRes.Game
 
@@ -151,6 +207,10 @@ func (s *Sdk) AddGameDetailsToGames(done <-chan struct{}, inGameCh <-chan Manife
 						continue
 					}
 
+					if len(filter.SkipTags) > 0 && game.HasOneOfTags(filter.SkipTags) {
+						continue
+					}
+
 					game.CdKey = gd.CdKey
 
 					for _, i := range gd.Downloads {
@@ -158,18 +218,7 @@ func (s *Sdk) AddGameDetailsToGames(done <-chan struct{}, inGameCh <-chan Manife
 							continue
 						}
 
-						game.Installers = append(
-							game.Installers,
-							manifest.ManifestGameInstaller{
-								Languages:     []string{languageToAscii(i.Language)},
-								Os:            i.Os,
-								Url:           i.ManualUrl,
-								Title:         i.Name,
-								Version:       i.Version,
-								Date:          i.Date,
-								EstimatedSize: i.Size,
-							},
-						)
+						game.Installers = append(game.Installers, getManifestGameInstaller(i))
 					}
 
 					for _, e := range gd.Extras {
@@ -195,18 +244,9 @@ func (s *Sdk) AddGameDetailsToGames(done <-chan struct{}, inGameCh <-chan Manife
 								continue
 							}
 							
-							game.Installers = append(
-								game.Installers,
-								manifest.ManifestGameInstaller{
-									Languages:     []string{languageToAscii(i.Language)},
-									Os:            i.Os,
-									Url:           i.ManualUrl,
-									Title:         i.Name,
-									Version:       i.Version,
-									Date:          i.Date,
-									EstimatedSize: i.Size,
-								},
-							)
+							installer := getManifestGameInstaller(i)
+							installer.Dlc = d.Title
+							game.Installers = append(game.Installers, installer)
 						}
 
 						for _, e := range d.Extras {
@@ -221,12 +261,17 @@ func (s *Sdk) AddGameDetailsToGames(done <-chan struct{}, inGameCh <-chan Manife
 									Title:         e.Name,
 									Type:          e.Type,
 									Info:          e.Info,
+									Dlc:           d.Title,
 									EstimatedSize: e.Size,
 								},
 							)
 						}
 					}
 
+					if !game.HasInstallerDatedWithin(filter.InstallersSince, filter.InstallersUntil) {
+						continue
+					}
+
 					outGameCh <- ManifestGameResult{
 						Game: game,
 						Error: nil,
@@ -285,6 +330,73 @@ func TapManifestGameIds(done <-chan struct{}, inGameCh <-chan ManifestGameResult
 	return outGameCh, outGameIdsCh
 }
 
+//Depots are only retrieved for the games the filter or its policy rules keep them for, as it requires several requests per game and os
+func (s *Sdk) AddDepotsToGames(done <-chan struct{}, inGameCh <-chan ManifestGameResult, concurrency int, pause int, filter manifest.ManifestFilter) <-chan ManifestGameResult {
+	if !filter.KeepsDepots() {
+		return inGameCh
+	}
+
+	var wg sync.WaitGroup
+	outGameCh := make(chan ManifestGameResult)
+
+	format := filter.DepotFormat
+	if format == "" {
+		format = manifest.DepotFormatFiles
+	}
+
+	for idx := 0; idx < concurrency; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for true {
+				select {
+				case gameRes, ok := <-inGameCh:
+					if !ok {
+						return
+					}
+
+					if gameRes.Error != nil {
+						outGameCh <- gameRes
+						continue
+					}
+
+					gameFilter, _ := filter.GetGameFilter(&gameRes.Game)
+					if !(*gameFilter).Depots {
+						outGameCh <- gameRes
+						continue
+					}
+
+					depots, err := s.GetGameDepots(gameRes.Game.Id, (*gameFilter).Oses, (*gameFilter).Languages, format)
+					if err != nil {
+						gameRes.Error = err
+						outGameCh <- gameRes
+						continue
+					}
+
+					game := gameRes.Game
+					game.Depots = depots
+					outGameCh <- ManifestGameResult{
+						Game: game,
+						Error: nil,
+					}
+				case <-done:
+					return
+				}
+
+				time.Sleep(time.Duration(pause) * time.Millisecond)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(outGameCh)
+	}()
+
+	return outGameCh
+}
+
 func (s *Sdk) AddFileInfoToGames(done <-chan struct{}, inGameCh <-chan ManifestGameResult, concurrency int, pause int, tolerateDangles bool, tolerateBadMetadata bool, filter manifest.ManifestFilter) <-chan GameManyErrorsResult {
 	var wg sync.WaitGroup
 	outGameCh := make(chan GameManyErrorsResult)
@@ -307,13 +419,14 @@ func (s *Sdk) AddFileInfoToGames(done <-chan struct{}, inGameCh <-chan ManifestG
 							Warnings: []error{},
 							Errors: []error{gameRes.Error},
 						}
+						continue
 					}
 
 					warnings := []error{}
 					errs := []error{}
 
 					game := gameRes.Game
-					game.TrimFilesFromFilter(filter)
+					game.TrimFilesFromPolicy(&filter)
 					if game.IsEmpty() {
 						break
 					}
@@ -325,7 +438,9 @@ func (s *Sdk) AddFileInfoToGames(done <-chan struct{}, inGameCh <-chan ManifestG
 
 						info := s.GetFileInfo(installer.Url, tolerateBadMetadata)
 						if info.Error != nil {
-							if info.BadMetadata && tolerateBadMetadata {
+							if IsSessionExpiredError(info.Error) {
+								errs = append(errs, info.Error)
+							} else if info.BadMetadata && tolerateBadMetadata {
 								(*s).logger.Warning(fmt.Sprintf("Bad metadata for %s: File metadata was still fetched using much longer workaround method.", info.Url))
 								err := errors.New(fmt.Sprintf("Bad metadata workaround: %s", info.Error.Error()))
 								warnings = append(warnings, err)
@@ -355,7 +470,9 @@ func (s *Sdk) AddFileInfoToGames(done <-chan struct{}, inGameCh <-chan ManifestG
 
 						info := s.GetFileInfo(extra.Url, tolerateBadMetadata)
 						if info.Error != nil {
-							if info.BadMetadata && tolerateBadMetadata {
+							if IsSessionExpiredError(info.Error) {
+								errs = append(errs, info.Error)
+							} else if info.BadMetadata && tolerateBadMetadata {
 								(*s).logger.Warning(fmt.Sprintf("Bad metadata for %s: File metadata was still fetched using much longer workaround method.", info.Url))
 								err := errors.New(fmt.Sprintf("Bad metadata workaround: %s", info.Error.Error()))
 								warnings = append(warnings, err)
@@ -378,6 +495,10 @@ func (s *Sdk) AddFileInfoToGames(done <-chan struct{}, inGameCh <-chan ManifestG
 						game.Extras[idx] = extra
 					}
 
+					if len(errs) == 0 && (!game.PassesSizeFilter(&filter)) {
+						break
+					}
+
 					outGameCh <- GameManyErrorsResult{
 						Game: game,
 						Warnings: warnings,
@@ -421,6 +542,7 @@ func (s *Sdk) GenerateManifestGameGetter(concurrency int, pause int, tolerateDan
 			),
 		)
 
+		gamesCh = s.AddDepotsToGames(done, gamesCh, concurrency, pause, filter)
 		gamesFinalCh := s.AddFileInfoToGames(done, gamesCh, concurrency, pause, tolerateDangles, tolerateBadMetadata, filter)
 
 		go func() {
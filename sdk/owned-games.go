@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 )
 
 type tag struct {
@@ -63,6 +64,32 @@ type OwnedGamesPage struct {
 	Tags            []tag
 }
 
+func (p product) GetWorksOn() []string {
+	oses := []string{}
+	if p.WorksOn.Windows {
+		oses = append(oses, "windows")
+	}
+	if p.WorksOn.Mac {
+		oses = append(oses, "mac")
+	}
+	if p.WorksOn.Linux {
+		oses = append(oses, "linux")
+	}
+	return oses
+}
+
+//The release date starts with the year, when gog knows it
+func (p product) GetReleaseYear() int {
+	if len(p.ReleaseDate.Date) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(p.ReleaseDate.Date[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
 func (p product) StringifyOses() string {
 	worksOn := "["
 	if p.WorksOn.Windows {
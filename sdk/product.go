@@ -39,10 +39,10 @@ type ProductDownloadExtra struct {
 }
 
 type ProductDownloads struct {
-	Installers []ProductDownloadInstaller
-	Patches    []ProductDownloadInstaller
-	//Language_packs ?
-	Bonus_content []ProductDownloadExtra
+	Installers     []ProductDownloadInstaller
+	Patches        []ProductDownloadInstaller
+	Language_packs []ProductDownloadInstaller
+	Bonus_content  []ProductDownloadExtra
 }
 
 type ProductOsCompatibility struct {
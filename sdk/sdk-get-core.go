@@ -7,12 +7,27 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"golang.org/x/net/html"
 	"io"
+	"gogcli/metrics"
 	"io/ioutil"
+	"net/http"
 	"strconv"
+	"strings"
 )
 
+//Endpoints are labelled with the name of the sdk function making the call
+func getMetricsEndpoint(fnCall string) string {
+	return strings.TrimSpace(strings.SplitN(fnCall, "(", 2)[0])
+}
+
+func recordRequestMetrics(fnCall string, r *http.Response, err error) {
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(r.StatusCode)
+	}
+	metrics.SdkRequests.Inc(getMetricsEndpoint(fnCall), code)
+}
+
 type BodyReaderReply struct {
 	BodyHandle io.ReadCloser
 	BodyLength int64
@@ -22,16 +37,37 @@ type BodyReaderReply struct {
 }
 
 func (s *Sdk) getUrlBodyReader(url string, fnCall string, retriesLeft int64) (BodyReaderReply, error) {
+	return s.getUrlBodyReaderWithHeaders(url, fnCall, nil, retriesLeft)
+}
+
+func (s *Sdk) getUrlBodyReaderWithHeaders(url string, fnCall string, headers map[string]string, retriesLeft int64) (BodyReaderReply, error) {
 	c := s.getClient(true)
 
 	(*s).logger.Debug(fmt.Sprintf("%s -> GET %s", fnCall, url))
 
-	r, err := c.Get(url)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> retrieval request creation error: %s", fnCall, err.Error())
+		return BodyReaderReply{
+			BodyHandle: nil,
+			BodyLength: int64(-1),
+			FinalUrl: "",
+			StatusCode: -1,
+			RetriesLeft: retriesLeft,
+		}, errors.New(msg)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	r, err := c.Do(req)
+	recordRequestMetrics(fnCall, r, err)
 	if err != nil {
 		if retriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> failed with retrieval request error %s. Will retry.", fnCall, err.Error()))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
-			return s.getUrlBodyReader(url, fnCall, retriesLeft - 1)
+			return s.getUrlBodyReaderWithHeaders(url, fnCall, headers, retriesLeft - 1)
 		}
 
 		msg := fmt.Sprintf("%s -> retrieval request error: %s", fnCall, err.Error())
@@ -44,12 +80,25 @@ func (s *Sdk) getUrlBodyReader(url string, fnCall string, retriesLeft int64) (Bo
 		}, errors.New(msg)
 	}
 
+	if r.StatusCode == 401 || isLoginUrl(r.Request.URL) {
+		r.Body.Close()
+		reason := fmt.Sprintf("request was answered with status code %d at %s", r.StatusCode, r.Request.URL.String())
+		return BodyReaderReply{
+			BodyHandle: nil,
+			BodyLength: int64(-1),
+			FinalUrl: r.Request.URL.String(),
+			StatusCode: r.StatusCode,
+			RetriesLeft: retriesLeft,
+		}, NewSessionExpiredError(fnCall, reason)
+	}
+
 	if r.StatusCode < 200 || r.StatusCode >= 300 {
 		if r.StatusCode >= 500 && retriesLeft > 0 {
 			r.Body.Close()
 			(*s).logger.Warning(fmt.Sprintf("%s -> failed with code %d. Will retry.", fnCall, r.StatusCode))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
-			return s.getUrlBodyReader(url, fnCall, retriesLeft - 1)
+			return s.getUrlBodyReaderWithHeaders(url, fnCall, headers, retriesLeft - 1)
 		}
 		msg := fmt.Sprintf("%s -> body download handle retrieval error: did not expect status code of %d", fnCall, r.StatusCode)
 		return BodyReaderReply{
@@ -119,6 +168,7 @@ func (s *Sdk) getUrlBodyChecksum(url string, fnCall string, retriesLeft int64) (
 		}
 		if reply.RetriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> checksum computation failed with error: %s. Will retry.", fnCall, copyErr.Error()))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
 			return s.getUrlBodyChecksum(url, fnCall, retriesLeft - 1)
 		}
@@ -151,9 +201,11 @@ type BodyLengthReply struct {
 func (s *Sdk) getUrlBodyLength(url string, fnCall string, retriesLeft int64) (BodyLengthReply, error) {
 	c := s.getClient(true)
 	r, err := c.Head(url)
+	recordRequestMetrics(fnCall, r, err)
 	if err != nil {
 		if retriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> content length retrieval error: %s. Will retry.", fnCall, err.Error()))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
 			return s.getUrlBodyLength(url, fnCall, retriesLeft - 1)
 		}
@@ -167,9 +219,20 @@ func (s *Sdk) getUrlBodyLength(url string, fnCall string, retriesLeft int64) (Bo
 	}
 	defer r.Body.Close()
 
+	if r.StatusCode == 401 || isLoginUrl(r.Request.URL) {
+		reason := fmt.Sprintf("request was answered with status code %d at %s", r.StatusCode, r.Request.URL.String())
+		return BodyLengthReply{
+			BodyLength: int64(-1),
+			FinalUrl: r.Request.URL.String(),
+			StatusCode: r.StatusCode,
+			RetriesLeft: retriesLeft,
+		}, NewSessionExpiredError(fnCall, reason)
+	}
+
 	if r.StatusCode < 200 || r.StatusCode >= 300 {
 		if r.StatusCode >= 500 && retriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> content length retrieval failed with code %d. Will retry.", fnCall, r.StatusCode))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
 			return s.getUrlBodyLength(url, fnCall, retriesLeft - 1)
 		}
@@ -220,9 +283,13 @@ type BodyReply struct {
 }
 
 func (s *Sdk) getUrlBody(url string, fnCall string, jsonBody bool, retriesLeft int64) (BodyReply, error) {
+	return s.getUrlBodyWithHeaders(url, fnCall, jsonBody, nil, retriesLeft)
+}
+
+func (s *Sdk) getUrlBodyWithHeaders(url string, fnCall string, jsonBody bool, headers map[string]string, retriesLeft int64) (BodyReply, error) {
 	(*s).logger.Debug(fmt.Sprintf("%s -> GET %s", fnCall, url))
 
-	reply, err := s.getUrlBodyReader(url, fnCall, retriesLeft)
+	reply, err := s.getUrlBodyReaderWithHeaders(url, fnCall, headers, retriesLeft)
 	if reply.BodyHandle != nil {
 		defer reply.BodyHandle.Close()
 	}
@@ -239,8 +306,9 @@ func (s *Sdk) getUrlBody(url string, fnCall string, jsonBody bool, retriesLeft i
 	if bErr != nil {
 		if reply.RetriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> body retrieval error: %s. Will retry.", fnCall, bErr.Error()))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
-			return s.getUrlBody(url, fnCall, jsonBody, reply.RetriesLeft - 1)
+			return s.getUrlBodyWithHeaders(url, fnCall, jsonBody, headers, reply.RetriesLeft - 1)
 		}
 		msg := fmt.Sprintf("%s -> body retrieval error: %s", fnCall, bErr.Error())
 		return BodyReply{
@@ -255,12 +323,11 @@ func (s *Sdk) getUrlBody(url string, fnCall string, jsonBody bool, retriesLeft i
 		var out bytes.Buffer
 		jErr := json.Indent(&out, b, "", "  ")
 		if jErr != nil {
-			var msg string
-			_, err := html.Parse(bytes.NewReader(b))
-			if err == nil {
-				msg = fmt.Sprintf("%s -> expected json and got html from gog api call, either the gog api contract changed or more likely your cookie is expired", fnCall)
+			var err error
+			if isHtml(b) {
+				err = NewSessionExpiredError(fnCall, "expected json and got html from gog api call")
 			} else {
-				msg = fmt.Sprintf("%s -> json parsing error: %s", fnCall, jErr.Error())
+				err = errors.New(fmt.Sprintf("%s -> json parsing error: %s", fnCall, jErr.Error()))
 			}
 
 			return BodyReply{
@@ -268,7 +335,7 @@ func (s *Sdk) getUrlBody(url string, fnCall string, jsonBody bool, retriesLeft i
 				FinalUrl: reply.FinalUrl,
 				StatusCode: reply.StatusCode,
 				RetriesLeft: reply.RetriesLeft,
-			}, errors.New(msg)
+			}, err
 		}
 		b = out.Bytes()
 	}
@@ -293,9 +360,11 @@ func (s *Sdk) getUrlRedirect(url string, fnCall string, retriesLeft int64) (Redi
 	
 	var location string
 	r, err := c.Get(url)
+	recordRequestMetrics(fnCall, r, err)
 	if err != nil {
 		if retriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> redirect retrieval error: %s. Will retry.", fnCall, err.Error()))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
 			return s.getUrlRedirect(url, fnCall, retriesLeft - 1)
 		}
@@ -308,9 +377,19 @@ func (s *Sdk) getUrlRedirect(url string, fnCall string, retriesLeft int64) (Redi
 	}
 	defer r.Body.Close()
 
+	if r.StatusCode == 401 || (r.StatusCode >= 300 && r.StatusCode < 400 && isLoginLocation(r.Header.Get("Location"))) {
+		reason := fmt.Sprintf("request was answered with status code %d and location %s", r.StatusCode, r.Header.Get("Location"))
+		return RedirectReply{
+			RedirectUrl: "",
+			StatusCode: r.StatusCode,
+			RetriesLeft:  retriesLeft,
+		}, NewSessionExpiredError(fnCall, reason)
+	}
+
 	if r.StatusCode < 300 || r.StatusCode >= 400 {
 		if r.StatusCode >= 500 && retriesLeft > 0 {
 			(*s).logger.Warning(fmt.Sprintf("%s -> redirect retrieval error: expected response status code of 3xx, but got %d. Will retry.", fnCall, r.StatusCode))
+			metrics.SdkRetries.Inc(getMetricsEndpoint(fnCall))
 			s.pauseAfterError()
 			return s.getUrlRedirect(url, fnCall, retriesLeft - 1)
 		}
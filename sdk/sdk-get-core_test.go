@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+//Answers like gog does for sessions that expired, with redirections to the login page or html pages, depending on the path
+func serveTestSessionRequests(t *testing.T) {
+	serveTestRequests(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "login.gog.com" {
+			w.Write([]byte("<!DOCTYPE html><html><body>Log in</body></html>"))
+			return
+		}
+
+		switch r.URL.Path {
+		case "/login_redirect":
+			http.Redirect(w, r, "https://login.gog.com/auth?client_id=1", http.StatusFound)
+		case "/unauthorized":
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		case "/html":
+			w.Write([]byte("<!DOCTYPE html><html><body>Log in</body></html>"))
+		case "/text":
+			w.Write([]byte("Service unavailable"))
+		case "/json":
+			w.Write([]byte("{\"owned\": [1]}"))
+		case "/cdn_redirect":
+			http.Redirect(w, r, "https://cdn.gog.com/setup_game.exe", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func TestGetUrlBodyReaderSessionExpired(t *testing.T) {
+	serveTestSessionRequests(t)
+	s := newTestSdk("session")
+
+	for _, path := range []string{"/login_redirect", "/unauthorized"} {
+		reply, err := s.getUrlBodyReaderWithHeaders("https://www.gog.com"+path, "fn", map[string]string{"Range": "bytes=0-"}, 0)
+		if !IsSessionExpiredError(err) || reply.BodyHandle != nil {
+			t.Errorf("Expected %s to give a session expired error without a body and got %v", path, err)
+		}
+	}
+
+	reply, err := s.getUrlBodyReader("https://www.gog.com/json", "fn", 0)
+	if err != nil {
+		t.Fatalf("Expected the body to be returned and got %s", err.Error())
+	}
+	defer reply.BodyHandle.Close()
+	body, _ := ioutil.ReadAll(reply.BodyHandle)
+	if string(body) != "{\"owned\": [1]}" || reply.StatusCode != 200 {
+		t.Errorf("Expected the body to be returned and got %q with status code %d", string(body), reply.StatusCode)
+	}
+}
+
+func TestGetUrlBodyHtml(t *testing.T) {
+	serveTestSessionRequests(t)
+	s := newTestSdk("session")
+
+	_, err := s.getUrlBody("https://www.gog.com/html", "fn", true, 0)
+	if !IsSessionExpiredError(err) {
+		t.Errorf("Expected an html page answered to an api call to give a session expired error and got %v", err)
+	}
+	_, err = s.getUrlBody("https://www.gog.com/login_redirect", "fn", true, 0)
+	if !IsSessionExpiredError(err) {
+		t.Errorf("Expected a redirection to the login page to give a session expired error and got %v", err)
+	}
+
+	_, err = s.getUrlBody("https://www.gog.com/text", "fn", true, 0)
+	if err == nil || IsSessionExpiredError(err) {
+		t.Errorf("Expected a body that is neither json nor html to give a parsing error and got %v", err)
+	}
+	reply, err := s.getUrlBody("https://www.gog.com/html", "fn", false, 0)
+	if err != nil || len(reply.Body) == 0 {
+		t.Errorf("Expected html pages to be returned when json is not expected and got %v", err)
+	}
+}
+
+func TestGetUrlBodyLengthSessionExpired(t *testing.T) {
+	serveTestSessionRequests(t)
+	s := newTestSdk("session")
+
+	for _, path := range []string{"/login_redirect", "/unauthorized"} {
+		_, err := s.getUrlBodyLength("https://www.gog.com"+path, "fn", 0)
+		if !IsSessionExpiredError(err) {
+			t.Errorf("Expected %s to give a session expired error and got %v", path, err)
+		}
+	}
+
+	reply, err := s.getUrlBodyLength("https://www.gog.com/json", "fn", 0)
+	if err != nil || reply.BodyLength != int64(len("{\"owned\": [1]}")) {
+		t.Errorf("Expected the length of the body to be returned and got %d with %v", reply.BodyLength, err)
+	}
+}
+
+func TestGetUrlRedirectSessionExpired(t *testing.T) {
+	serveTestSessionRequests(t)
+	s := newTestSdk("session")
+
+	for _, path := range []string{"/login_redirect", "/unauthorized"} {
+		_, err := s.getUrlRedirect("https://www.gog.com"+path, "fn", 0)
+		if !IsSessionExpiredError(err) {
+			t.Errorf("Expected %s to give a session expired error and got %v", path, err)
+		}
+	}
+
+	reply, err := s.getUrlRedirect("https://www.gog.com/cdn_redirect", "fn", 0)
+	if err != nil || reply.RedirectUrl != "https://cdn.gog.com/setup_game.exe" {
+		t.Errorf("Expected the location of the redirection to be returned and got %s with %v", reply.RedirectUrl, err)
+	}
+
+	_, err = s.getUrlRedirect("https://www.gog.com/html", "fn", 0)
+	if err == nil || IsSessionExpiredError(err) {
+		t.Errorf("Expected a page that does not redirect to give an error other than an expired session and got %v", err)
+	}
+}
@@ -1,18 +1,25 @@
 package sdk
 
 import (
+	"fmt"
 	"gogcli/logging"
 	"log"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 )
 
 type Sdk struct {
-	cookies    []*http.Cookie
-	maxRetries int64
-	retryPause time.Duration
-	logger     *logging.Logger
+	jar                 *Jar
+	maxRetries          int64
+	retryPause          time.Duration
+	refreshInterval     time.Duration
+	lastRefresh         time.Time
+	refreshMutex        sync.Mutex
+	logger              *logging.Logger
+	downloadConnections int
+	segmentedMinSize    int64
 }
 
 func NewSdk(cookies []*http.Cookie, logSource *logging.Source) *Sdk {
@@ -20,7 +27,7 @@ func NewSdk(cookies []*http.Cookie, logSource *logging.Source) *Sdk {
 	pause, _ := time.ParseDuration("100ms")
 	
 	sdk := Sdk{
-		cookies: cookies, 
+		jar: NewJar(cookies),
 		maxRetries: 5, 
 		retryPause: pause, 
 		logger: logger,
@@ -28,17 +35,40 @@ func NewSdk(cookies []*http.Cookie, logSource *logging.Source) *Sdk {
 	return &sdk
 }
 
+func (s *Sdk) SetRetries(maxRetries int64, retryPause time.Duration) {
+	(*s).maxRetries = maxRetries
+	(*s).retryPause = retryPause
+}
+
+//Files of at least minSize bytes are downloaded in segments over several connections if connections is greater than 1
+func (s *Sdk) SetSegmentedDownloads(connections int, minSize int64) {
+	(*s).downloadConnections = connections
+	(*s).segmentedMinSize = minSize
+}
+
 func (s *Sdk) pauseAfterError() {
 	time.Sleep((*s).retryPause)
 }
 
+//The persister is called with all the cookies whenever responses change their values
+func (s *Sdk) SetCookiePersister(persist func([]*http.Cookie) error) {
+	(*s).jar.SetChangeHandler(func(cookies []*http.Cookie) {
+		err := persist(cookies)
+		if err != nil {
+			(*s).logger.Warning(fmt.Sprintf("SetCookiePersister(...) -> Error occured while persisting refreshed cookies: %s", err.Error()))
+		} else {
+			(*s).logger.Debug("SetCookiePersister(...) -> Persisted refreshed cookies")
+		}
+	})
+}
+
 func (s *Sdk) getClient(followRedirects bool) http.Client {
-	j := Jar{cookies: (*s).cookies}
+	s.refreshSessionIfDue()
 	if followRedirects {
-		return http.Client{Jar: &j}
+		return http.Client{Jar: (*s).jar}
 	} else {
 		return http.Client{
-			Jar: &j,
+			Jar: (*s).jar,
 			CheckRedirect: func(req *http.Request, via []*http.Request) error {
 				return http.ErrUseLastResponse
 			},
@@ -0,0 +1,83 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type accountBasic struct {
+	IsLoggedIn         bool
+	AccessToken        string
+	AccessTokenExpires int64
+}
+
+//Queries the token endpoint of the gog website which rotates the session cookies of a logged in user
+func (s *Sdk) RefreshSession() error {
+	fn := "RefreshSession()"
+
+	reply, err := s.getUrlBody(
+		"https://menu.gog.com/v1/account/basic",
+		fn,
+		true,
+		(*s).maxRetries,
+	)
+	if err != nil {
+		return err
+	}
+
+	var account accountBasic
+	err = json.Unmarshal(reply.Body, &account)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Response deserialization error: %s", fn, err.Error())
+		return errors.New(msg)
+	}
+
+	if !account.IsLoggedIn {
+		return NewSessionExpiredError(fn, "session could not be refreshed as the user is not logged in anymore")
+	}
+
+	(*s).logger.Debug(fmt.Sprintf("%s -> Refreshed session, access token expires in %d seconds", fn, account.AccessTokenExpires))
+	return nil
+}
+
+//A refresh interval of 0 disables periodic session refreshes
+func (s *Sdk) SetSessionRefresh(interval time.Duration) {
+	(*s).refreshMutex.Lock()
+	defer (*s).refreshMutex.Unlock()
+	(*s).refreshInterval = interval
+	(*s).lastRefresh = time.Now()
+}
+
+func (s *Sdk) refreshSessionIfDue() {
+	(*s).refreshMutex.Lock()
+	due := (*s).refreshInterval > 0 && time.Since((*s).lastRefresh) >= (*s).refreshInterval
+	if due {
+		//Updated before the refresh so that the refresh's own request doesn't trigger another one
+		(*s).lastRefresh = time.Now()
+	}
+	(*s).refreshMutex.Unlock()
+
+	if due {
+		err := s.RefreshSession()
+		if err != nil {
+			(*s).logger.Warning(err.Error())
+		}
+	}
+}
+
+//Verifies that the cookie still grants access to the user's account before starting long running operations
+func (s *Sdk) CheckSession() error {
+	u, err := s.GetUser()
+	if err != nil {
+		return err
+	}
+
+	if !u.IsLoggedIn {
+		return NewSessionExpiredError("CheckSession()", "user data reports that the user is not logged in")
+	}
+
+	(*s).logger.Debug(fmt.Sprintf("CheckSession() -> Session is valid for user %s", u.Username))
+	return nil
+}
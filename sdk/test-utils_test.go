@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"gogcli/logging"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+//Sends the requests of every host to the test server, which tells the hosts apart with the Host header
+type testTransport struct {
+	target    *url.URL
+	transport http.RoundTripper
+}
+
+func (tr testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	redirected := req.Clone(req.Context())
+	redirected.URL.Scheme = tr.target.Scheme
+	redirected.URL.Host = tr.target.Host
+	redirected.Host = req.URL.Host
+
+	r, err := tr.transport.RoundTrip(redirected)
+	if err == nil {
+		r.Request = req
+	}
+	return r, err
+}
+
+//Serves the requests of the sdk with the handler for the duration of the test
+func serveTestRequests(t *testing.T, handler http.HandlerFunc) {
+	server := httptest.NewServer(handler)
+	target, _ := url.Parse(server.URL)
+	previous := http.DefaultTransport
+	http.DefaultTransport = testTransport{target: target, transport: previous}
+	t.Cleanup(func() {
+		http.DefaultTransport = previous
+		server.Close()
+	})
+}
+
+//The session cookie lets the handler tell the accounts apart
+func newTestSdk(session string) *Sdk {
+	s := NewSdk([]*http.Cookie{&http.Cookie{Name: "gog-al", Value: session}}, logging.CreateSource("error"))
+	s.SetRetries(0, 0)
+	return s
+}
+
+func getTestSession(r *http.Request) string {
+	cookie, err := r.Cookie("gog-al")
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
@@ -0,0 +1,180 @@
+package segmented
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+type SegmentGetter func(segment Segment) (io.ReadCloser, error)
+
+//Called before a segment whose download failed is retried
+type RetryHandler func(segment Segment, retriesLeft int, err error)
+
+type segmentResult struct {
+	buf *bytes.Buffer
+	err error
+}
+
+//Reader downloading segments concurrently and returning their content in order.
+//At most twice as many segments as there are connections are held in memory at any time.
+type Reader struct {
+	segments   []Segment
+	getSegment SegmentGetter
+	retries    int
+	onRetry    RetryHandler
+	results    []chan segmentResult
+	window     chan struct{}
+	done       chan struct{}
+	closeOnce  sync.Once
+	current    int
+	buf        *bytes.Buffer
+	err        error
+}
+
+func NewReader(segments []Segment, getSegment SegmentGetter, connections int, retries int, onRetry RetryHandler) *Reader {
+	if connections < 1 {
+		connections = 1
+	}
+
+	r := &Reader{
+		segments:   segments,
+		getSegment: getSegment,
+		retries:    retries,
+		onRetry:    onRetry,
+		results:    make([]chan segmentResult, len(segments)),
+		window:     make(chan struct{}, connections*2),
+		done:       make(chan struct{}),
+		current:    -1,
+	}
+	for idx, _ := range r.results {
+		r.results[idx] = make(chan segmentResult, 1)
+	}
+
+	jobs := make(chan int)
+	go r.dispatch(jobs)
+	for i := 0; i < connections; i++ {
+		go r.work(jobs)
+	}
+
+	return r
+}
+
+//Segments are dispatched in order and only once they have a place in the window, so the
+//segment the reader waits on is never starved by later ones
+func (r *Reader) dispatch(jobs chan<- int) {
+	defer close(jobs)
+	for idx, _ := range r.segments {
+		select {
+		case r.window <- struct{}{}:
+		case <-r.done:
+			return
+		}
+
+		select {
+		case jobs <- idx:
+		case <-r.done:
+			return
+		}
+	}
+}
+
+func (r *Reader) work(jobs <-chan int) {
+	for idx := range jobs {
+		buf, err := r.fetch(r.segments[idx], r.retries)
+		r.results[idx] <- segmentResult{buf: buf, err: err}
+	}
+}
+
+func (r *Reader) fetch(segment Segment, retriesLeft int) (*bytes.Buffer, error) {
+	buf, err := r.fetchOnce(segment)
+	if err == nil {
+		return buf, nil
+	}
+
+	select {
+	case <-r.done:
+		return nil, err
+	default:
+	}
+
+	if retriesLeft > 0 {
+		if r.onRetry != nil {
+			r.onRetry(segment, retriesLeft, err)
+		}
+		return r.fetch(segment, retriesLeft-1)
+	}
+	return nil, err
+}
+
+func (r *Reader) fetchOnce(segment Segment) (*bytes.Buffer, error) {
+	fn := fmt.Sprintf("fetch(segment={From=%d, To=%d, ...})", segment.From, segment.To)
+
+	handle, err := r.getSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+	defer handle.Close()
+
+	buf := bytes.NewBuffer(make([]byte, 0, segment.Size()))
+	h := md5.New()
+	_, err = io.Copy(io.MultiWriter(buf, h), handle)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Error occured while downloading the segment: %s", fn, err.Error())
+		return nil, errors.New(msg)
+	}
+
+	if int64(buf.Len()) != segment.Size() {
+		msg := fmt.Sprintf("%s -> Downloaded %d bytes and expected %d", fn, buf.Len(), segment.Size())
+		return nil, errors.New(msg)
+	}
+
+	checksum := hex.EncodeToString(h.Sum(nil))
+	if segment.Checksum != "" && checksum != segment.Checksum {
+		msg := fmt.Sprintf("%s -> Segment checksum of %s does not match expected checksum of %s", fn, checksum, segment.Checksum)
+		return nil, errors.New(msg)
+	}
+
+	return buf, nil
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	for r.buf == nil || r.buf.Len() == 0 {
+		if r.buf != nil {
+			r.buf = nil
+			<-r.window
+		}
+
+		r.current++
+		if r.current >= len(r.segments) {
+			r.err = io.EOF
+			return 0, r.err
+		}
+
+		result := <-r.results[r.current]
+		if result.err != nil {
+			r.err = result.err
+			r.Close()
+			return 0, r.err
+		}
+		r.buf = result.buf
+	}
+
+	return r.buf.Read(p)
+}
+
+//Stops the downloads of segments that were not started yet
+func (r *Reader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+	return nil
+}
@@ -0,0 +1,149 @@
+package segmented
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func getContent(size int) []byte {
+	content := make([]byte, size)
+	for idx, _ := range content {
+		content[idx] = byte(idx % 251)
+	}
+	return content
+}
+
+func getChecksum(content []byte) string {
+	h := md5.Sum(content)
+	return hex.EncodeToString(h[:])
+}
+
+func TestSplit(t *testing.T) {
+	segments := Split(25, 10)
+	expected := []Segment{{From: 0, To: 9}, {From: 10, To: 19}, {From: 20, To: 24}}
+	if len(segments) != len(expected) {
+		t.Fatalf("Expected %d segments and got %d", len(expected), len(segments))
+	}
+	for idx, segment := range segments {
+		if segment != expected[idx] {
+			t.Errorf("Expected segment %v and got %v", expected[idx], segment)
+		}
+	}
+
+	if !IsContiguous(segments, 25) {
+		t.Errorf("Expected split segments to be contiguous")
+	}
+	if IsContiguous(segments, 26) {
+		t.Errorf("Expected segments not to cover a larger file")
+	}
+	if IsContiguous([]Segment{{From: 0, To: 9}, {From: 11, To: 24}}, 25) {
+		t.Errorf("Expected segments with a gap not to be contiguous")
+	}
+	if len(Split(0, 10)) != 0 {
+		t.Errorf("Expected an empty file not to have segments")
+	}
+}
+
+func TestReaderOrderAndRetries(t *testing.T) {
+	content := getContent(1000)
+	segments := Split(int64(len(content)), 64)
+	for idx, segment := range segments {
+		segments[idx].Checksum = getChecksum(content[segment.From : segment.To+1])
+	}
+
+	var mutex sync.Mutex
+	failures := map[int64]int{}
+	retries := int32(0)
+
+	getter := func(segment Segment) (io.ReadCloser, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		//Every third segment fails once, alternating between an error and a corrupted body
+		if (segment.From/64)%3 == 0 && failures[segment.From] == 0 {
+			failures[segment.From]++
+			if (segment.From/64)%2 == 0 {
+				return nil, errors.New("connection reset")
+			}
+			corrupted := append([]byte{}, content[segment.From:segment.To+1]...)
+			corrupted[0]++
+			return io.NopCloser(bytes.NewReader(corrupted)), nil
+		}
+		return io.NopCloser(bytes.NewReader(content[segment.From : segment.To+1])), nil
+	}
+
+	r := NewReader(segments, getter, 4, 1, func(segment Segment, retriesLeft int, err error) {
+		atomic.AddInt32(&retries, 1)
+	})
+	defer r.Close()
+
+	result, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("Expected no error and got %s", err.Error())
+	}
+	if !bytes.Equal(result, content) {
+		t.Errorf("Expected the content to be reassembled in order")
+	}
+	if retries != 6 {
+		t.Errorf("Expected 6 retries and got %d", retries)
+	}
+}
+
+func TestReaderFailure(t *testing.T) {
+	content := getContent(100)
+	segments := Split(int64(len(content)), 10)
+
+	getter := func(segment Segment) (io.ReadCloser, error) {
+		if segment.From == 50 {
+			return io.NopCloser(bytes.NewReader(content[segment.From:segment.To])), nil
+		}
+		return io.NopCloser(bytes.NewReader(content[segment.From : segment.To+1])), nil
+	}
+
+	r := NewReader(segments, getter, 2, 2, nil)
+	defer r.Close()
+
+	result, err := io.ReadAll(r)
+	if err == nil {
+		t.Fatalf("Expected a truncated segment to fail the download")
+	}
+	if !bytes.Equal(result, content[:50]) {
+		t.Errorf("Expected the segments before the failed one to be read, got %d bytes", len(result))
+	}
+}
+
+func TestReaderBoundedWindow(t *testing.T) {
+	content := getContent(1000)
+	segments := Split(int64(len(content)), 10)
+	started := int32(0)
+
+	getter := func(segment Segment) (io.ReadCloser, error) {
+		atomic.AddInt32(&started, 1)
+		return io.NopCloser(bytes.NewReader(content[segment.From : segment.To+1])), nil
+	}
+
+	r := NewReader(segments, getter, 3, 0, nil)
+	defer r.Close()
+
+	p := make([]byte, 5)
+	_, err := r.Read(p)
+	if err != nil {
+		t.Fatalf("Expected no error and got %s", err.Error())
+	}
+	//Gives the workers the chance to run ahead if the window doesn't stop them
+	for i := 0; i < 1000; i++ {
+		if atomic.LoadInt32(&started) > 6 {
+			break
+		}
+		runtime.Gosched()
+	}
+	if atomic.LoadInt32(&started) > 6 {
+		t.Errorf("Expected at most 6 segments to be fetched ahead and got %d", started)
+	}
+}
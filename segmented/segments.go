@@ -0,0 +1,49 @@
+package segmented
+
+import (
+	"fmt"
+)
+
+//Byte range of a file, bounds included like the chunks of gog's xml file metadata
+type Segment struct {
+	From     int64
+	To       int64
+	Checksum string
+}
+
+func (s Segment) Size() int64 {
+	return s.To - s.From + 1
+}
+
+func (s Segment) GetRangeHeader() string {
+	return fmt.Sprintf("bytes=%d-%d", s.From, s.To)
+}
+
+//Splits a file of the given size in segments of at most segmentSize bytes
+func Split(size int64, segmentSize int64) []Segment {
+	segments := []Segment{}
+	if size <= 0 || segmentSize <= 0 {
+		return segments
+	}
+
+	for from := int64(0); from < size; from += segmentSize {
+		to := from + segmentSize - 1
+		if to >= size {
+			to = size - 1
+		}
+		segments = append(segments, Segment{From: from, To: to})
+	}
+	return segments
+}
+
+//Checks that the segments cover a file of the given size from start to end without gaps or overlaps
+func IsContiguous(segments []Segment, size int64) bool {
+	next := int64(0)
+	for _, segment := range segments {
+		if segment.From != next || segment.To < segment.From {
+			return false
+		}
+		next = segment.To + 1
+	}
+	return next == size
+}
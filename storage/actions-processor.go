@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"gogcli/logging"
 	"gogcli/manifest"
+	"gogcli/metrics"
+	"io"
 	"log"
 	"os"
 	"strings"
 )
 
 type DoneAction struct {
-	action manifest.Action
-	end    bool
+	action       manifest.Action
+	fileSize     int64
+	fileChecksum string
+	end          bool
 }
 
 type ActionResult struct {
@@ -38,8 +42,12 @@ type ActionsProcessor struct {
 	manifestUpdateErrsChan chan []error
 	actionsUpdateErrsChan  chan []error
 	doneActionChan         chan DoneAction
+	cancel                 <-chan struct{}
+	filter                 ActionFilter
 }
 
+type ActionFilter func(action manifest.Action) bool
+
 func GetActionsProcessor(
 	concurrency int,
 	retries int,
@@ -62,6 +70,31 @@ func GetActionsProcessor(
 	}
 }
 
+//Returns a copy of the processor that stops starting new actions once cancel is closed
+func (p ActionsProcessor) WithCancel(cancel <-chan struct{}) ActionsProcessor {
+	p.cancel = cancel
+	return p
+}
+
+//Returns a copy of the processor that only performs the actions accepted by the filter, leaving the other actions pending
+func (p ActionsProcessor) WithFilter(filter ActionFilter) ActionsProcessor {
+	p.filter = filter
+	return p
+}
+
+func (p ActionsProcessor) isCancelled() bool {
+	if p.cancel == nil {
+		return false
+	}
+
+	select {
+	case <-p.cancel:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p ActionsProcessor) addFileAction(
 	fileInfo manifest.FileInfo,
 	action manifest.FileAction,
@@ -69,12 +102,15 @@ func (p ActionsProcessor) addFileAction(
 	d Downloader,
 	retriesLeft int,
 ) {
+	label := getMetricsLabel(s)
 	handleErr := func(err error) {
-		if retriesLeft <= 0 {
+		if retriesLeft <= 0 || isUnrecoverableError(err) {
+			metrics.FilesFailed.Inc(label, "add")
 			p.actionErrChan <- err
 			return
 		}
 
+		metrics.FileRetries.Inc(label)
 		p.logger.Warning(fmt.Sprintf("Problem updating/creating file %d/%ss/%s (%d retries left) => %s", fileInfo.Game.Id, fileInfo.Kind, fileInfo.Name, retriesLeft, err.Error()))
 		p.addFileAction(fileInfo, action, s, d, retriesLeft-1)
 	}
@@ -88,13 +124,35 @@ func (p ActionsProcessor) addFileAction(
 		end:      false,
 	}
 
-	handle, fSize, _, err := d.Download(fileInfo)
+	offset, err := getUploadOffset(fileInfo, s, d)
+	if err != nil {
+		r.err = err
+		handleErr(err)
+		return
+	}
+
+	var handle io.ReadCloser
+	var fSize int64
+	if offset > 0 {
+		p.logger.Info(fmt.Sprintf("Resuming upload of file %d/%ss/%s at byte %d", fileInfo.Game.Id, fileInfo.Kind, fileInfo.Name, offset))
+		handle, fSize, _, err = d.(RangeDownloader).DownloadFrom(fileInfo, offset)
+		fSize += offset
+		if err != nil {
+			//The upload is restarted from scratch rather than failing every retry on a source that can't resume
+			p.logger.Warning(fmt.Sprintf("Cannot resume download of file %d/%ss/%s => %s", fileInfo.Game.Id, fileInfo.Kind, fileInfo.Name, err.Error()))
+			offset = 0
+		}
+	}
+	if offset == 0 {
+		handle, fSize, _, err = d.Download(fileInfo)
+	}
 	if err != nil {
 		r.err = err
 		handleErr(err)
 		return
 	}
 	defer handle.Close()
+	handle = metrics.NewCountingReadCloser(handle, metrics.BytesDownloaded)
 
 	if fileInfo.Size > 0 && fileInfo.Size != fSize {
 		msg := fmt.Sprintf("%s -> Download file size of %d does not match expected file size of %d", fn, fSize, fileInfo.Size)
@@ -105,8 +163,14 @@ func (p ActionsProcessor) addFileAction(
 
 	r.fileSize = fSize
 	fileInfo.Size = fSize
-	fChecksum, uploadErr := s.UploadFile(handle, fileInfo)
-	if err != nil {
+	var fChecksum string
+	var uploadErr error
+	if offset > 0 {
+		fChecksum, uploadErr = s.(ResumableUploadStorage).ResumeUploadFile(handle, fileInfo, offset)
+	} else {
+		fChecksum, uploadErr = s.UploadFile(handle, fileInfo)
+	}
+	if uploadErr != nil {
 		r.err = uploadErr
 		handleErr(uploadErr)
 		return
@@ -130,16 +194,29 @@ func (p ActionsProcessor) addFileAction(
 	}
 
 	r.fileChecksum = fChecksum
+	metrics.FilesProcessed.Inc(label, "add")
+	metrics.BytesUploaded.Add(float64(fSize-offset), label)
 	p.actionResultChan <- r
 	p.actionErrChan <- nil
 	p.logger.Info(fmt.Sprintf("Created/Updated file: %d/%ss/%s", fileInfo.Game.Id, fileInfo.Kind, fileInfo.Name))
 }
 
+//Uploads can only resume when the size of the file is known and the download can start at an offset
+func getUploadOffset(fileInfo manifest.FileInfo, s Storage, d Downloader) (int64, error) {
+	rs, isResumable := s.(ResumableUploadStorage)
+	_, isRangeDownloader := d.(RangeDownloader)
+	if (!isResumable) || (!isRangeDownloader) || fileInfo.Size <= 0 {
+		return 0, nil
+	}
+	return rs.GetUploadOffset(fileInfo)
+}
+
 func (p ActionsProcessor) launchActions(m *manifest.Manifest, iterator *manifest.ActionsIterator, s Storage, d Downloader) {
 	errs := make([]error, 0)
 	jobsRunning := 0
 	concurrency := p.concurrency
 	gamesToDo := -1
+	label := getMetricsLabel(s)
 
 	gamesMap := map[int64]manifest.ManifestGame{}
 	for _, game := range (*m).Games {
@@ -153,10 +230,16 @@ func (p ActionsProcessor) launchActions(m *manifest.Manifest, iterator *manifest
 			p.logger.Info(fmt.Sprintf("Games Progress: %d games with unstarted actions", iterGamesToDo))
 		}
 
+		if iterator.ShouldContinue() && len(errs) == 0 && p.isCancelled() {
+			errs = append(errs, errors.New("launchActions(...) -> Actions processing was cancelled"))
+		}
+
 		if iterator.ShouldContinue() && len(errs) == 0 {
 			action, nextErr := iterator.Next()
 			if nextErr != nil {
 				errs = append(errs, nextErr)
+			} else if p.filter != nil && (!p.filter(action)) {
+				p.logger.Debug(fmt.Sprintf("Skipped filtered out action on game %d", action.Game.Id))
 			} else if !action.IsFileAction {
 				if action.GameAction == "add" {
 					err := s.AddGame(action.Game)
@@ -197,8 +280,10 @@ func (p ActionsProcessor) launchActions(m *manifest.Manifest, iterator *manifest
 					fileInfo := manifest.FileInfo{Game: action.Game, Kind: fileAction.Kind, Name: fileAction.Name, Url: fileAction.Url}
 					err := s.RemoveFile(fileInfo)
 					if err != nil {
+						metrics.FilesFailed.Inc(label, "remove")
 						errs = append(errs, err)
 					} else {
+						metrics.FilesProcessed.Inc(label, "remove")
 						p.doneActionChan <- DoneAction{action: action, end: false}
 						p.logger.Info(fmt.Sprintf("Deleted file: %d/%ss/%s", action.Game.Id, fileAction.Kind, fileAction.Name))
 					}
@@ -227,28 +312,43 @@ func (p ActionsProcessor) launchActions(m *manifest.Manifest, iterator *manifest
 	p.actionsErrsChan <- errs
 }
 
+//With journal storages, the manifest is updated along with the actions when the journal is compacted
 func (p ActionsProcessor) keepManifestUpdated(m *manifest.Manifest, s Storage) {
 	errs := make([]error, 0)
+	gs, isGameStorage := s.(GameStorage)
+	_, isJournalStorage := getJournalStorage(s)
 	for true {
 		r := <-p.actionResultChan
 		if r.end {
 			break
 		}
+
+		action := manifest.Action{
+			Game:          r.game,
+			IsFileAction:  true,
+			FileActionPtr: &r.action,
+			GameAction:    "",
+		}
+		done := DoneAction{action: action, fileSize: r.fileSize, fileChecksum: r.fileChecksum, end: false}
+
+		if isJournalStorage {
+			p.doneActionChan <- done
+			continue
+		}
+
 		err := m.FillMissingFileInfo(r.game.Id, r.fileKind, r.fileName, r.fileSize, r.fileChecksum)
 		if err != nil {
 			errs = append(errs, err)
 		} else {
-			err = s.StoreManifest(m)
+			if isGameStorage {
+				err = gs.StoreManifestGame(m, r.game.Id)
+			} else {
+				err = s.StoreManifest(m)
+			}
 			if err != nil {
 				errs = append(errs, err)
 			} else {
-				action := manifest.Action{
-					Game:          r.game,
-					IsFileAction:  true,
-					FileActionPtr: &r.action,
-					GameAction:    "",
-				}
-				p.doneActionChan <- DoneAction{action: action, end: false}
+				p.doneActionChan <- done
 			}
 		}
 	}
@@ -256,15 +356,54 @@ func (p ActionsProcessor) keepManifestUpdated(m *manifest.Manifest, s Storage) {
 	p.manifestUpdateErrsChan <- errs
 }
 
-func (p ActionsProcessor) keepActionsUpdated(g *manifest.GameActions, s Storage) {
+func (p ActionsProcessor) keepActionsUpdated(m *manifest.Manifest, g *manifest.GameActions, s Storage) {
 	errs := make([]error, 0)
+	label := getMetricsLabel(s)
+	gs, isGameStorage := s.(GameStorage)
+	js, isJournalStorage := getJournalStorage(s)
+	journalEntries := 0
 	for true {
 		d := <-p.doneActionChan
 		if d.end {
 			break
 		}
+
+		var err error
+		if isJournalStorage {
+			entry := manifest.NewJournalEntry(d.action, d.fileSize, d.fileChecksum)
+			err = manifest.ReplayJournal(m, nil, []manifest.JournalEntry{entry})
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			err = js.AppendJournal(entry)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			journalEntries++
+		}
+
 		g.ApplyAction(d.action)
-		err := s.StoreActions(g)
+		metrics.ActionsPending.Set(float64(g.ActionsLeft()), label)
+		if isJournalStorage {
+			if journalEntries >= journalCompactionInterval {
+				err = compactJournal(s, js, m, g)
+				journalEntries = 0
+			}
+		} else if isGameStorage {
+			err = gs.StoreGameActions(g, d.action.Game.Id)
+		} else {
+			err = s.StoreActions(g)
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if isJournalStorage && journalEntries > 0 {
+		err := compactJournal(s, js, m, g)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -277,7 +416,8 @@ func (p ActionsProcessor) ProcessGameActions(m *manifest.Manifest, a *manifest.G
 	iterator.Sort(p.gamesSort, m)
 	go p.launchActions(m, iterator, s, d)
 	go p.keepManifestUpdated(m, s)
-	go p.keepActionsUpdated(a.DeepCopy(), s)
+	remaining := a.DeepCopy()
+	go p.keepActionsUpdated(m, remaining, s)
 	actionErrs := <-p.actionsErrsChan
 	p.actionResultChan <- ActionResult{end: true}
 	manifestUpdateErrs := <-p.manifestUpdateErrsChan
@@ -294,7 +434,8 @@ func (p ActionsProcessor) ProcessGameActions(m *manifest.Manifest, a *manifest.G
 		errs[idx+len(actionErrs)+len(manifestUpdateErrs)] = err
 	}
 
-	if len(errs) == 0 && (!iterator.HasMore()) {
+	//Filtered out actions are still pending once the iterator is exhausted
+	if len(errs) == 0 && (!iterator.HasMore()) && remaining.ActionsLeft() == 0 {
 		err := s.RemoveActions()
 		if err != nil {
 			return []error{err}
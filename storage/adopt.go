@@ -0,0 +1,264 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+//Sub-directories of a game's directory in which other gog downloaders put its files
+var adoptLayouts = map[string][]string{
+	"gogrepo":        {"", "extras"},
+	"lgogdownloader": {"", "extras", "patches", "languagepacks", "dlc/*", "dlc/*/extras", "dlc/*/patches", "dlc/*/languagepacks"},
+}
+
+type AdoptedFile struct {
+	GameId int64
+	Kind   string
+	Name   string
+	Path   string
+}
+
+type AdoptReport struct {
+	Adopted     []AdoptedFile
+	Mismatches  []LocalFileMismatch
+	AdoptedSize int64
+	Errors      []string `json:",omitempty"`
+}
+
+func IsAdoptLayout(layout string) bool {
+	_, ok := adoptLayouts[layout]
+	return ok
+}
+
+func getAdoptionCandidate(dir string, layout string, slug string, name string) (string, bool) {
+	for _, subDir := range adoptLayouts[layout] {
+		dirs := []string{filepath.Join(dir, slug, subDir)}
+		if strings.Contains(subDir, "*") {
+			matches, err := filepath.Glob(filepath.Join(dir, slug, subDir))
+			if err != nil {
+				continue
+			}
+			dirs = matches
+		}
+
+		for _, candidateDir := range dirs {
+			candidate := filepath.Join(candidateDir, name)
+			info, err := os.Stat(candidate)
+			if err == nil && info.Mode().IsRegular() {
+				return candidate, true
+			}
+		}
+	}
+	return "", false
+}
+
+//Copies the file through a temporary file so that an interrupted copy never leaves a partial file at the destination
+func copyLocalFile(from string, to string) error {
+	src, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	tmpPath := to + ".tmp"
+	dest, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dest, src)
+	if err == nil {
+		err = dest.Sync()
+	}
+	closeErr := dest.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, to)
+}
+
+//Files on another file system than the storage can neither be moved nor linked and are copied instead,
+//the original being removed afterwards unless it is to be left intact
+func adoptLocalFile(candidate string, target string, link bool) error {
+	var err error
+	if link {
+		err = os.Link(candidate, target)
+	} else {
+		err = os.Rename(candidate, target)
+	}
+	if !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	err = copyLocalFile(candidate, target)
+	if err != nil || link {
+		return err
+	}
+	return os.Remove(candidate)
+}
+
+//Moves or links the files that the pending actions of the storage would download from the directory of another
+//gog downloader into the storage, after checking them against the manifest, and marks their actions as done
+func AdoptFiles(s Storage, dir string, layout string, link bool) (*AdoptReport, error) {
+	if !IsAdoptLayout(layout) {
+		return nil, errors.New(fmt.Sprintf("AdoptFiles(..., layout=%s, ...) -> Unknown layout", layout))
+	}
+
+	//Only the file system storages can take files in place, other storages have to upload them instead
+	f, ok := GetStorageFileSystem(s)
+	if !ok {
+		return nil, errors.New("AdoptFiles(...) -> Only fs and sqlite storages can adopt files. Use the import command to upload local files in other storages")
+	}
+
+	err := RecoverJournal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	hasActions, err := s.HasActions()
+	if err != nil {
+		return nil, err
+	}
+	if !hasActions {
+		return nil, errors.New("AdoptFiles(...) -> Storage does not have pending actions")
+	}
+
+	m, err := s.LoadManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.LoadActions()
+	if err != nil {
+		return nil, err
+	}
+
+	report := AdoptReport{
+		Adopted:    []AdoptedFile{},
+		Mismatches: []LocalFileMismatch{},
+	}
+
+	for _, gameAction := range *(actions.DeepCopy()) {
+		gameInfo := manifest.GameInfo{Id: gameAction.Id, Slug: gameAction.Slug, Title: gameAction.Title}
+		if gameAction.Action == "remove" {
+			continue
+		}
+
+		fileActions := []manifest.FileAction{}
+		for _, fileAction := range gameAction.InstallerActions {
+			fileActions = append(fileActions, fileAction)
+		}
+		for _, fileAction := range gameAction.ExtraActions {
+			fileActions = append(fileActions, fileAction)
+		}
+
+		gameAdded := gameAction.Action != "add"
+		for idx, _ := range fileActions {
+			fileAction := fileActions[idx]
+			if fileAction.Action != "add" {
+				continue
+			}
+
+			fileInfo, err := m.GetFileActionFileInfo(gameInfo, fileAction)
+			if err != nil {
+				report.Errors = append(report.Errors, err.Error())
+				continue
+			}
+
+			candidate, found := getAdoptionCandidate(dir, layout, gameAction.Slug, fileInfo.Name)
+			if !found {
+				continue
+			}
+
+			info, err := os.Stat(candidate)
+			if err != nil {
+				report.Errors = append(report.Errors, err.Error())
+				continue
+			}
+			if fileInfo.Size > 0 && fileInfo.Size != info.Size() {
+				report.Mismatches = append(report.Mismatches, LocalFileMismatch{GameId: gameInfo.Id, Kind: fileInfo.Kind, Name: fileInfo.Name, Path: candidate, Problem: "size", Size: info.Size()})
+				continue
+			}
+
+			if fileInfo.Checksum == "" {
+				report.Mismatches = append(report.Mismatches, LocalFileMismatch{GameId: gameInfo.Id, Kind: fileInfo.Kind, Name: fileInfo.Name, Path: candidate, Problem: "noManifestChecksum"})
+				continue
+			}
+
+			checksum, err := hashLocalFile(candidate)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("AdoptFiles(...) -> Error occured while hashing file %s: %s", candidate, err.Error()))
+				continue
+			}
+			if fileInfo.Checksum != checksum {
+				report.Mismatches = append(report.Mismatches, LocalFileMismatch{GameId: gameInfo.Id, Kind: fileInfo.Kind, Name: fileInfo.Name, Path: candidate, Problem: "checksum", Checksum: checksum})
+				continue
+			}
+
+			if !gameAdded {
+				err = f.AddGame(gameInfo)
+				if err != nil {
+					report.Errors = append(report.Errors, err.Error())
+					break
+				}
+				actions.ApplyAction(manifest.Action{Game: gameInfo, IsFileAction: false, GameAction: "add"})
+				gameAdded = true
+			}
+
+			target, err := f.getFilePath(fileInfo)
+			if err == nil {
+				err = os.MkdirAll(path.Dir(target), 0755)
+			}
+			if err != nil {
+				report.Errors = append(report.Errors, err.Error())
+				continue
+			}
+			err = adoptLocalFile(candidate, target, link)
+			if err != nil {
+				report.Errors = append(report.Errors, fmt.Sprintf("AdoptFiles(...) -> Error occured while adopting file %s: %s", candidate, err.Error()))
+				continue
+			}
+
+			err = m.FillMissingFileInfo(gameInfo.Id, fileInfo.Kind, fileInfo.Name, info.Size(), checksum)
+			if err != nil {
+				report.Errors = append(report.Errors, err.Error())
+			}
+			actions.ApplyAction(manifest.Action{Game: gameInfo, IsFileAction: true, FileActionPtr: &fileAction})
+
+			report.Adopted = append(report.Adopted, AdoptedFile{GameId: gameInfo.Id, Kind: fileInfo.Kind, Name: fileInfo.Name, Path: candidate})
+			report.AdoptedSize += info.Size()
+		}
+	}
+
+	//Nothing was adopted, so the storage is left as it was
+	if len(report.Adopted) == 0 {
+		return &report, nil
+	}
+
+	err = s.StoreManifest(m)
+	if err != nil {
+		return &report, err
+	}
+
+	if actions.ActionsLeft() > 0 {
+		return &report, s.StoreActions(actions)
+	}
+
+	err = s.RemoveActions()
+	if err != nil {
+		return &report, err
+	}
+	return &report, s.RemoveSource()
+}
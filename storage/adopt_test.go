@@ -0,0 +1,289 @@
+package storage
+
+import (
+	"fmt"
+	"gogcli/importers"
+	"errors"
+	"gogcli/manifest"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func getAdoptedNames(report *AdoptReport) map[string]string {
+	names := map[string]string{}
+	for _, adopted := range (*report).Adopted {
+		names[adopted.Name] = adopted.Path
+	}
+	return names
+}
+
+//Gogrepo manifest of the files of the test manifest
+func getTestGogrepoManifest() []byte {
+	file := func(name string, osType string) string {
+		content := testFileContents[name]
+		return fmt.Sprintf("{'desc': u'%s', 'lang': 'en', 'manual_url': '/downloads/%s', 'md5': '%s', 'name': u'%s', 'os_type': '%s', 'size': %d, 'version': None}", name, name, getTestChecksum(content), name, osType, len(content))
+	}
+	return []byte(fmt.Sprintf(
+		"[{'downloads': [%s, %s], 'extras': [%s], 'id': 1, 'long_title': u'First Game', 'serial': '', 'title': 'first_game'}, {'downloads': [%s], 'extras': [], 'id': 2, 'long_title': u'Second Game', 'serial': '', 'title': 'second_game'}]",
+		file("setup_first.exe", "windows"),
+		file("first.sh", "linux"),
+		file("first_manual.pdf", ""),
+		file("setup_second.exe", "windows"),
+	))
+}
+
+func importTestGogrepoManifest(t *testing.T) *manifest.Manifest {
+	m, err := importers.ImportGogrepoManifest(getTestGogrepoManifest())
+	if err != nil {
+		t.Fatalf("Could not import the gogrepo manifest: %s", err.Error())
+	}
+	m.Finalize()
+	return m
+}
+
+func TestAdoptFilesGogrepo(t *testing.T) {
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+	dir := t.TempDir()
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "setup_first.exe"), testFileContents["setup_first.exe"])
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "first.sh"), testFileContents["first.sh"])
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "extras", "first_manual.pdf"), testFileContents["first_manual.pdf"])
+
+	report, err := AdoptFiles(s, dir, "gogrepo", false)
+	if err != nil {
+		t.Fatalf("Adopting the files failed: %s", err.Error())
+	}
+	if len(report.Errors) > 0 || len(report.Mismatches) > 0 {
+		t.Errorf("Expected the files to be adopted without problems and got %v", *report)
+	}
+	names := getAdoptedNames(report)
+	if len(names) != 3 || names["first_manual.pdf"] != filepath.Join(dir, "first_game", "extras", "first_manual.pdf") {
+		t.Errorf("Expected the files of the first game to be adopted and got %v", names)
+	}
+
+	for name, _ := range names {
+		file := manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "installer", Name: name}
+		if name == "first_manual.pdf" {
+			file.Kind = "extra"
+		}
+		if readTestFile(t, s, file) != testFileContents[name] {
+			t.Errorf("Expected %s to be in the storage", name)
+		}
+		if _, err := os.Stat(names[name]); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be moved out of the directory", name)
+		}
+	}
+
+	actions, err := s.LoadActions()
+	if err != nil {
+		t.Fatalf("Could not load the actions: %s", err.Error())
+	}
+	if _, ok := (*actions)[1]; ok {
+		t.Errorf("Expected the actions of the first game to be done")
+	}
+	if _, ok := (*actions)[2]; !ok {
+		t.Errorf("Expected the actions of the second game to be left pending")
+	}
+}
+
+func TestAdoptFilesLinkLgogdownloader(t *testing.T) {
+	s := newTestSqliteStore(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+	dir := t.TempDir()
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "setup_first.exe"), testFileContents["setup_first.exe"])
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "dlc", "expansion", "first.sh"), testFileContents["first.sh"])
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "extras", "first_manual.pdf"), testFileContents["first_manual.pdf"])
+	writeLocalTestFile(t, filepath.Join(dir, "second_game", "setup_second.exe"), testFileContents["setup_second.exe"])
+
+	report, err := AdoptFiles(s, dir, "lgogdownloader", true)
+	if err != nil {
+		t.Fatalf("Adopting the files failed: %s", err.Error())
+	}
+	names := getAdoptedNames(report)
+	if len(names) != 4 {
+		t.Errorf("Expected all the files to be adopted and got %v", names)
+	}
+	for name, path := range names {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("Expected %s to be left in the directory when linked", name)
+		}
+	}
+
+	hasActions, _ := s.HasActions()
+	hasSource, _ := s.HasSource()
+	if hasActions || hasSource {
+		t.Errorf("Expected the actions and source to be removed once every file is adopted")
+	}
+	m, err := s.LoadManifest()
+	if err != nil {
+		t.Fatalf("Could not load the manifest: %s", err.Error())
+	}
+	if len((*m).Games) != 2 {
+		t.Errorf("Expected the manifest to be stored with its games")
+	}
+}
+
+func TestAdoptFilesImportedManifestFilter(t *testing.T) {
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, importTestGogrepoManifest(t))
+	dir := t.TempDir()
+	for _, dirName := range []string{"first_game", "second_game"} {
+		for name, content := range testFileContents {
+			writeLocalTestFile(t, filepath.Join(dir, dirName, name), content)
+		}
+	}
+
+	report, err := AdoptFiles(s, dir, "gogrepo", false)
+	if err != nil || len(report.Adopted) != 4 {
+		t.Fatalf("Expected the 4 files to be adopted and got %v with error %v", report, err)
+	}
+
+	//A later sync imprints the filter stored with the adopted manifest on the manifest it plans
+	m := importTestGogrepoManifest(t)
+	err = ImprintFilter(m, s)
+	if err != nil {
+		t.Fatalf("Could not imprint the filter: %s", err.Error())
+	}
+	if len((*m).Games) != 2 || len((*m).Games[0].Installers)+len((*m).Games[1].Installers) != 3 || len((*m).Games[0].Extras) != 1 {
+		t.Errorf("Expected the filter of the imported manifest to keep all the files and got %v", (*m).Games)
+	}
+	actions, err := PlanManifest(m, s, manifest.ChecksumValidationIfPresent)
+	if err != nil {
+		t.Fatalf("Could not plan the manifest: %s", err.Error())
+	}
+	if len(*actions) != 0 {
+		t.Errorf("Expected the adopted files to be kept and got actions %v", *actions)
+	}
+	for name, content := range testFileContents {
+		file := manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "installer", Name: name}
+		if name == "first_manual.pdf" {
+			file.Kind = "extra"
+		} else if name == "setup_second.exe" {
+			file.Game.Id = 2
+		}
+		if readTestFile(t, s, file) != content {
+			t.Errorf("Expected %s to still be in the storage", name)
+		}
+	}
+}
+
+func TestAdoptFilesMismatches(t *testing.T) {
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+	dir := t.TempDir()
+	corrupted := []byte(testFileContents["setup_first.exe"])
+	corrupted[0] = 'F'
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "setup_first.exe"), string(corrupted))
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "first.sh"), "truncated")
+
+	report, err := AdoptFiles(s, dir, "gogrepo", false)
+	if err != nil {
+		t.Fatalf("Adopting the files failed: %s", err.Error())
+	}
+	if len(report.Adopted) != 0 {
+		t.Errorf("Expected mismatched files not to be adopted and got %v", report.Adopted)
+	}
+	problems := map[string]string{}
+	for _, mismatch := range report.Mismatches {
+		problems[mismatch.Name] = mismatch.Problem
+	}
+	if len(problems) != 2 || problems["setup_first.exe"] != "checksum" || problems["first.sh"] != "size" {
+		t.Errorf("Expected a checksum and a size mismatch and got %v", problems)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "first_game", "setup_first.exe")); err != nil {
+		t.Errorf("Expected mismatched files to be left in the directory")
+	}
+	ids, _ := s.GetGameIds()
+	if len(ids) != 0 {
+		t.Errorf("Expected the storage to be left as it was when nothing is adopted and got games %v", ids)
+	}
+	actions, _ := s.LoadActions()
+	if len(*actions) != 2 {
+		t.Errorf("Expected the actions to be left pending")
+	}
+}
+
+func TestAdoptFilesWithoutManifestChecksum(t *testing.T) {
+	s := newTestFileSystem(t)
+	m := getTestStorageManifest()
+	(*m).Games[0].Extras[0].Checksum = ""
+	applyTestManifest(t, s, m)
+	dir := t.TempDir()
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "setup_first.exe"), testFileContents["setup_first.exe"])
+	writeLocalTestFile(t, filepath.Join(dir, "first_game", "extras", "first_manual.pdf"), testFileContents["first_manual.pdf"])
+
+	report, err := AdoptFiles(s, dir, "gogrepo", false)
+	if err != nil {
+		t.Fatalf("Adopting the files failed: %s", err.Error())
+	}
+	names := getAdoptedNames(report)
+	if len(names) != 1 || names["setup_first.exe"] == "" {
+		t.Errorf("Expected only the file with a manifest checksum to be adopted and got %v", names)
+	}
+	if len(report.Mismatches) != 1 || report.Mismatches[0].Name != "first_manual.pdf" || report.Mismatches[0].Problem != "noManifestChecksum" {
+		t.Errorf("Expected the file without a manifest checksum to be reported and got %v", report.Mismatches)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "first_game", "extras", "first_manual.pdf")); err != nil {
+		t.Errorf("Expected the unverified file to be left in the directory")
+	}
+}
+
+func TestAdoptLocalFileAcrossFileSystems(t *testing.T) {
+	otherDir, err := ioutil.TempDir("/dev/shm", "adopt")
+	if err != nil {
+		t.Skip("No other file system to adopt files from")
+	}
+	defer os.RemoveAll(otherDir)
+	dir := t.TempDir()
+	candidate := filepath.Join(otherDir, "setup_first.exe")
+	writeLocalTestFile(t, candidate, testFileContents["setup_first.exe"])
+	if err := os.Link(candidate, filepath.Join(dir, "probe")); !errors.Is(err, syscall.EXDEV) {
+		t.Skip("The temporary directories are on the same file system")
+	}
+
+	linked := filepath.Join(dir, "linked.exe")
+	err = adoptLocalFile(candidate, linked, true)
+	if err != nil {
+		t.Fatalf("Linking across file systems failed: %s", err.Error())
+	}
+	if _, err := os.Stat(candidate); err != nil {
+		t.Errorf("Expected the linked file to be copied and left in place")
+	}
+
+	moved := filepath.Join(dir, "moved.exe")
+	err = adoptLocalFile(candidate, moved, false)
+	if err != nil {
+		t.Fatalf("Moving across file systems failed: %s", err.Error())
+	}
+	if _, err := os.Stat(candidate); !os.IsNotExist(err) {
+		t.Errorf("Expected the moved file to be removed once copied")
+	}
+
+	for _, target := range []string{linked, moved} {
+		content, err := ioutil.ReadFile(target)
+		if err != nil || string(content) != testFileContents["setup_first.exe"] {
+			t.Errorf("Expected %s to be copied with its content", target)
+		}
+		if _, err := os.Stat(target + ".tmp"); !os.IsNotExist(err) {
+			t.Errorf("Expected no temporary file to be left for %s", target)
+		}
+	}
+}
+
+func TestAdoptFilesPreconditions(t *testing.T) {
+	s := newTestFileSystem(t)
+	_, err := AdoptFiles(s, t.TempDir(), "other", false)
+	if err == nil {
+		t.Errorf("Expected an unknown layout to be rejected")
+	}
+
+	populateTestStorage(t, s, getTestStorageManifest())
+	_, err = AdoptFiles(s, t.TempDir(), "gogrepo", false)
+	if err == nil {
+		t.Errorf("Expected files not to be adopted without pending actions")
+	}
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"gogcli/metrics"
+	"time"
+)
+
+func getMetricsLabel(s Storage) string {
+	summary, err := s.GetPrintableSummary()
+	if err != nil {
+		return "unknown"
+	}
+	return summary
+}
+
+//Updates the manifest and pending actions gauges of the storage
+func CollectMetrics(s Storage) error {
+	label := getMetricsLabel(s)
+
+	hasManifest, err := s.HasManifest()
+	if err != nil {
+		return err
+	}
+
+	if hasManifest {
+		m, err := s.LoadManifest()
+		if err != nil {
+			return err
+		}
+
+		installers := 0
+		extras := 0
+		depots := 0
+		for _, game := range (*m).Games {
+			installers += len(game.Installers)
+			extras += len(game.Extras)
+			depots += len(game.Depots)
+		}
+
+		metrics.ManifestGames.Set(float64(len((*m).Games)), label)
+		metrics.ManifestFiles.Set(float64(installers), label, "installer")
+		metrics.ManifestFiles.Set(float64(extras), label, "extra")
+		metrics.ManifestFiles.Set(float64(depots), label, "depot")
+		metrics.ManifestSize.Set(float64((*m).VerifiedSize), label)
+	}
+
+	hasActions, err := s.HasActions()
+	if err != nil {
+		return err
+	}
+
+	pending := 0
+	if hasActions {
+		a, err := s.LoadActions()
+		if err != nil {
+			return err
+		}
+		pending = a.ActionsLeft()
+	}
+	metrics.ActionsPending.Set(float64(pending), label)
+
+	return nil
+}
+
+func RecordValidation(s Storage) error {
+	validationsFile, err := metrics.GetValidationsFilePath()
+	if err != nil {
+		return err
+	}
+	return metrics.StoreValidation(validationsFile, getMetricsLabel(s), time.Now())
+}
@@ -0,0 +1,460 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io"
+	"sort"
+	"sync"
+)
+
+const (
+	DivergenceOnlyInManifestA  = "onlyInManifestA"
+	DivergenceOnlyInManifestB  = "onlyInManifestB"
+	DivergenceManifestMismatch = "manifestMismatch"
+	DivergenceMissingInA       = "missingInA"
+	DivergenceMissingInB       = "missingInB"
+	DivergenceUntrackedInA     = "untrackedInA"
+	DivergenceUntrackedInB     = "untrackedInB"
+	DivergenceCorruptedInA     = "corruptedInA"
+	DivergenceCorruptedInB     = "corruptedInB"
+)
+
+type CompareDivergence struct {
+	GameId    int64
+	Kind      string
+	Name      string
+	Problem   string
+	SizeA     int64  `json:",omitempty"`
+	SizeB     int64  `json:",omitempty"`
+	ChecksumA string `json:",omitempty"`
+	ChecksumB string `json:",omitempty"`
+}
+
+type CompareReport struct {
+	GamesOnlyInA      []int64
+	GamesOnlyInB      []int64
+	GamesUntrackedInA []int64
+	GamesUntrackedInB []int64
+	Divergences       []CompareDivergence
+	FilesCompared     int
+}
+
+func (r *CompareReport) HasDivergences() bool {
+	return len((*r).GamesOnlyInA) > 0 || len((*r).GamesOnlyInB) > 0 || len((*r).GamesUntrackedInA) > 0 || len((*r).GamesUntrackedInB) > 0 || len((*r).Divergences) > 0
+}
+
+type compareKey struct {
+	GameId int64
+	Kind   string
+	Name   string
+}
+
+type compareSide struct {
+	storage        Storage
+	manifest       *manifest.Manifest
+	selfValidating bool
+	files          map[compareKey]manifest.FileInfo
+	games          map[int64]bool
+	listedGames    map[int64]bool
+	listedFiles    map[compareKey]manifest.FileInfo
+}
+
+type compareJob struct {
+	file manifest.FileInfo
+	side *compareSide
+	tag  string
+}
+
+type compareResult struct {
+	key      compareKey
+	tag      string
+	size     int64
+	checksum string
+	err      error
+}
+
+func loadCompareSide(s Storage, tag string) (*compareSide, error) {
+	has, err := s.HasManifest()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("loadCompareSide(..., %s) -> Error checking manifest existance: %s", tag, err.Error()))
+	}
+	if !has {
+		return nil, errors.New(fmt.Sprintf("loadCompareSide(..., %s) -> Manifest not found", tag))
+	}
+
+	m, err := s.LoadManifest()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("loadCompareSide(..., %s) -> Error occured while loading the manifest: %s", tag, err.Error()))
+	}
+
+	selfValidating, err := s.IsSelfValidating()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("loadCompareSide(..., %s) -> Error occured while querying the storage: %s", tag, err.Error()))
+	}
+
+	side := compareSide{
+		storage:        s,
+		manifest:       m,
+		selfValidating: selfValidating,
+		files:          make(map[compareKey]manifest.FileInfo),
+		games:          make(map[int64]bool),
+		listedGames:    make(map[int64]bool),
+		listedFiles:    make(map[compareKey]manifest.FileInfo),
+	}
+
+	for _, game := range (*m).Games {
+		side.games[game.Id] = true
+	}
+
+	iterator := manifest.NewManifestFileInterator(m)
+	for iterator.HasMore() {
+		file, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		side.files[compareKey{GameId: file.Game.Id, Kind: file.Kind, Name: file.Name}] = file
+	}
+
+	ids, err := s.GetGameIds()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("loadCompareSide(..., %s) -> Error occured while listing the games: %s", tag, err.Error()))
+	}
+	for _, id := range ids {
+		side.listedGames[id] = true
+		files, err := s.GetGameFiles(id)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("loadCompareSide(..., %s) -> Error occured while listing the files of game %d: %s", tag, id, err.Error()))
+		}
+		for _, file := range files {
+			side.listedFiles[compareKey{GameId: id, Kind: file.Kind, Name: file.Name}] = file
+		}
+	}
+
+	return &side, nil
+}
+
+func (s *compareSide) hasGame(id int64) bool {
+	return (*s).games[id]
+}
+
+func getCorruptionDivergence(key compareKey, tag string, size int64, checksum string, expected manifest.FileInfo) CompareDivergence {
+	divergence := CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name}
+	if tag == "a" {
+		divergence.Problem = DivergenceCorruptedInA
+		divergence.SizeA = size
+		divergence.ChecksumA = checksum
+		divergence.SizeB = expected.Size
+		divergence.ChecksumB = expected.Checksum
+	} else {
+		divergence.Problem = DivergenceCorruptedInB
+		divergence.SizeA = expected.Size
+		divergence.ChecksumA = expected.Checksum
+		divergence.SizeB = size
+		divergence.ChecksumB = checksum
+	}
+	return divergence
+}
+
+//Checks the file against the size and checksum the storage lists it with, which is all that is needed for storages
+//that validate their files themselves. Files of other storages need to be hashed if their listing matches.
+func (s *compareSide) checkListedFile(key compareKey, tag string, expected manifest.FileInfo, verifyChecksum bool) (*CompareDivergence, bool) {
+	listed := (*s).listedFiles[key]
+	if listed.Size != expected.Size {
+		divergence := getCorruptionDivergence(key, tag, listed.Size, listed.Checksum, expected)
+		return &divergence, false
+	}
+
+	if (*s).selfValidating {
+		if verifyChecksum && listed.Checksum != expected.Checksum {
+			divergence := getCorruptionDivergence(key, tag, listed.Size, listed.Checksum, expected)
+			return &divergence, false
+		}
+		return nil, false
+	}
+
+	return nil, verifyChecksum
+}
+
+func hashStoredFile(job compareJob) compareResult {
+	key := compareKey{GameId: job.file.Game.Id, Kind: job.file.Kind, Name: job.file.Name}
+	handle, size, err := (*job.side).storage.DownloadFile(job.file)
+	if err != nil {
+		msg := fmt.Sprintf("hashStoredFile(FileInfo{Game: {id: %d, ...}, Kind: %s, Name: %s, ...}) -> Error occured while getting the file's download handle in storage %s: %s", key.GameId, key.Kind, key.Name, job.tag, err.Error())
+		return compareResult{key: key, tag: job.tag, err: errors.New(msg)}
+	}
+	defer handle.Close()
+
+	h := md5.New()
+	_, err = io.Copy(h, handle)
+	if err != nil {
+		msg := fmt.Sprintf("hashStoredFile(FileInfo{Game: {id: %d, ...}, Kind: %s, Name: %s, ...}) -> Error occured while reading the file in storage %s: %s", key.GameId, key.Kind, key.Name, job.tag, err.Error())
+		return compareResult{key: key, tag: job.tag, err: errors.New(msg)}
+	}
+	return compareResult{key: key, tag: job.tag, size: size, checksum: hex.EncodeToString(h.Sum(nil))}
+}
+
+func hashStoredFiles(jobs []compareJob, concurrency int) []compareResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	jobsCh := make(chan compareJob)
+	resultsCh := make(chan compareResult)
+	for idx := 0; idx < concurrency; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobsCh {
+				resultsCh <- hashStoredFile(job)
+			}
+		}()
+	}
+
+	go func() {
+		for _, job := range jobs {
+			jobsCh <- job
+		}
+		close(jobsCh)
+		wg.Wait()
+		close(resultsCh)
+	}()
+
+	results := []compareResult{}
+	for result := range resultsCh {
+		results = append(results, result)
+	}
+	return results
+}
+
+func sortedGameIds(ids map[int64]bool) []int64 {
+	sorted := []int64{}
+	for id, _ := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+//Removes the given files from the manifest so that planning against it adds them back
+func removeManifestFiles(m *manifest.Manifest, keys map[compareKey]bool) {
+	for gIdx, _ := range (*m).Games {
+		game := &(*m).Games[gIdx]
+
+		installers := []manifest.ManifestGameInstaller{}
+		for _, installer := range (*game).Installers {
+			if !keys[compareKey{GameId: (*game).Id, Kind: "installer", Name: installer.Name}] {
+				installers = append(installers, installer)
+			}
+		}
+		(*game).Installers = installers
+
+		extras := []manifest.ManifestGameExtra{}
+		for _, extra := range (*game).Extras {
+			if !keys[compareKey{GameId: (*game).Id, Kind: "extra", Name: extra.Name}] {
+				extras = append(extras, extra)
+			}
+		}
+		(*game).Extras = extras
+
+		depots := []manifest.ManifestGameDepot{}
+		for _, depot := range (*game).Depots {
+			if !keys[compareKey{GameId: (*game).Id, Kind: "depot", Name: depot.Name}] {
+				depots = append(depots, depot)
+			}
+		}
+		(*game).Depots = depots
+	}
+}
+
+//Compares the manifests and stored files of both storages.
+//Files are checked against the size and checksum their storage lists them with when it is self validating and hashed otherwise.
+//The returned actions bring storage b in line with storage a.
+func Compare(a Storage, b Storage, concurrency int, verifyChecksum bool) (*CompareReport, *manifest.GameActions, []error) {
+	sideA, err := loadCompareSide(a, "a")
+	if err != nil {
+		return nil, nil, []error{err}
+	}
+	sideB, err := loadCompareSide(b, "b")
+	if err != nil {
+		return nil, nil, []error{err}
+	}
+
+	report := CompareReport{
+		GamesOnlyInA:      []int64{},
+		GamesOnlyInB:      []int64{},
+		GamesUntrackedInA: []int64{},
+		GamesUntrackedInB: []int64{},
+		Divergences:       []CompareDivergence{},
+	}
+
+	onlyInA := map[int64]bool{}
+	for _, game := range (*sideA).manifest.Games {
+		if !sideB.hasGame(game.Id) {
+			onlyInA[game.Id] = true
+		}
+	}
+	report.GamesOnlyInA = sortedGameIds(onlyInA)
+	onlyInB := map[int64]bool{}
+	for _, game := range (*sideB).manifest.Games {
+		if !sideA.hasGame(game.Id) {
+			onlyInB[game.Id] = true
+		}
+	}
+	report.GamesOnlyInB = sortedGameIds(onlyInB)
+	untrackedInA := map[int64]bool{}
+	for id, _ := range (*sideA).listedGames {
+		if !sideA.hasGame(id) {
+			untrackedInA[id] = true
+		}
+	}
+	report.GamesUntrackedInA = sortedGameIds(untrackedInA)
+	untrackedInB := map[int64]bool{}
+	for id, _ := range (*sideB).listedGames {
+		if !sideB.hasGame(id) {
+			untrackedInB[id] = true
+		}
+	}
+	report.GamesUntrackedInB = sortedGameIds(untrackedInB)
+
+	//Files of b that will have to be uploaded again from a
+	redo := map[compareKey]bool{}
+	jobs := []compareJob{}
+	for key, fileA := range (*sideA).files {
+		fileB, inB := (*sideB).files[key]
+		if !inB {
+			if !onlyInA[key.GameId] {
+				report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceOnlyInManifestA, SizeA: fileA.Size, ChecksumA: fileA.Checksum})
+			}
+			continue
+		}
+
+		if fileA.Size != fileB.Size || fileA.Checksum != fileB.Checksum {
+			report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceManifestMismatch, SizeA: fileA.Size, SizeB: fileB.Size, ChecksumA: fileA.Checksum, ChecksumB: fileB.Checksum})
+			continue
+		}
+
+		_, listedInA := (*sideA).listedFiles[key]
+		_, listedInB := (*sideB).listedFiles[key]
+		if !listedInA {
+			report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceMissingInA})
+		}
+		if !listedInB {
+			report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceMissingInB})
+			redo[key] = true
+		}
+		if !(listedInA && listedInB) {
+			continue
+		}
+
+		report.FilesCompared++
+		divergence, hash := sideA.checkListedFile(key, "a", fileA, verifyChecksum)
+		if divergence != nil {
+			report.Divergences = append(report.Divergences, *divergence)
+		} else if hash {
+			jobs = append(jobs, compareJob{file: fileA, side: sideA, tag: "a"})
+		}
+		divergence, hash = sideB.checkListedFile(key, "b", fileB, verifyChecksum)
+		if divergence != nil {
+			report.Divergences = append(report.Divergences, *divergence)
+			redo[key] = true
+		} else if hash {
+			jobs = append(jobs, compareJob{file: fileB, side: sideB, tag: "b"})
+		}
+	}
+
+	for key, fileB := range (*sideB).files {
+		if _, inA := (*sideA).files[key]; !inA && (!onlyInB[key.GameId]) {
+			report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceOnlyInManifestB, SizeB: fileB.Size, ChecksumB: fileB.Checksum})
+		}
+	}
+
+	for key, _ := range (*sideA).listedFiles {
+		if _, ok := (*sideA).files[key]; !ok && (!untrackedInA[key.GameId]) {
+			report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceUntrackedInA})
+		}
+	}
+	for key, _ := range (*sideB).listedFiles {
+		if _, ok := (*sideB).files[key]; !ok && (!untrackedInB[key.GameId]) {
+			report.Divergences = append(report.Divergences, CompareDivergence{GameId: key.GameId, Kind: key.Kind, Name: key.Name, Problem: DivergenceUntrackedInB})
+		}
+	}
+
+	errs := []error{}
+	for _, result := range hashStoredFiles(jobs, concurrency) {
+		if result.err != nil {
+			errs = append(errs, result.err)
+			continue
+		}
+
+		expected := (*sideA).files[result.key]
+		if result.size == expected.Size && result.checksum == expected.Checksum {
+			continue
+		}
+
+		if result.tag == "b" {
+			redo[result.key] = true
+		}
+		report.Divergences = append(report.Divergences, getCorruptionDivergence(result.key, result.tag, result.size, result.checksum, expected))
+	}
+
+	sort.SliceStable(report.Divergences, func(i, j int) bool {
+		first, second := report.Divergences[i], report.Divergences[j]
+		if first.GameId != second.GameId {
+			return first.GameId < second.GameId
+		}
+		if first.Kind != second.Kind {
+			return first.Kind < second.Kind
+		}
+		if first.Name != second.Name {
+			return first.Name < second.Name
+		}
+		return first.Problem < second.Problem
+	})
+
+	removeManifestFiles((*sideB).manifest, redo)
+	actions := (*sideB).manifest.Plan((*sideA).manifest, manifest.ChecksumValidation, false)
+
+	return &report, actions, errs
+}
+
+//Stores the manifest of storage a along with the actions bringing storage b in line with it so that
+//they can be executed with storage a as their source
+func ApplyComparison(a Storage, b Storage, actions *manifest.GameActions) error {
+	err := RecoverJournal(b)
+	if err != nil {
+		return err
+	}
+
+	hasSource, err := b.HasSource()
+	if err != nil {
+		return err
+	}
+	if hasSource {
+		return errors.New("ApplyComparison(...) -> Unfinished actions are pending in storage b. Aborting.")
+	}
+
+	m, err := a.LoadManifest()
+	if err != nil {
+		return err
+	}
+
+	err = b.StoreManifest(m)
+	if err != nil {
+		return err
+	}
+
+	if len(*actions) == 0 {
+		return nil
+	}
+
+	err = b.StoreSource(a.GenerateSource())
+	if err != nil {
+		return err
+	}
+	return b.StoreActions(actions)
+}
@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"gogcli/manifest"
+	"reflect"
+	"testing"
+)
+
+//File system storage listing its files with the checksum of the manifest, as storages validating their files do
+type selfValidatingTestStorage struct {
+	FileSystem
+	checksums map[string]string
+}
+
+func (s selfValidatingTestStorage) IsSelfValidating() (bool, error) {
+	return true, nil
+}
+
+func (s selfValidatingTestStorage) GetGameFiles(gameId int64) ([]manifest.FileInfo, error) {
+	files, err := s.FileSystem.GetGameFiles(gameId)
+	for idx, _ := range files {
+		files[idx].Checksum = s.checksums[files[idx].Name]
+	}
+	return files, err
+}
+
+func getCompareProblems(report *CompareReport) map[string]string {
+	problems := map[string]string{}
+	for _, divergence := range (*report).Divergences {
+		problems[divergence.Name] = divergence.Problem
+	}
+	return problems
+}
+
+func TestCompareIdenticalStorages(t *testing.T) {
+	a := newTestFileSystem(t)
+	b := newTestSqliteStore(t)
+	populateTestStorage(t, a, getTestStorageManifest())
+	populateTestStorage(t, b, getTestStorageManifest())
+
+	report, actions, errs := Compare(a, b, 2, true)
+	if len(errs) > 0 {
+		t.Fatalf("Comparison failed: %v", errs)
+	}
+	if report.HasDivergences() || report.FilesCompared != 4 {
+		t.Errorf("Expected identical storages not to diverge and got %v", *report)
+	}
+	if len(*actions) != 0 {
+		t.Errorf("Expected no action to bring identical storages in line")
+	}
+}
+
+func TestCompareDivergences(t *testing.T) {
+	a := newTestFileSystem(t)
+	b := newTestFileSystem(t)
+	populateTestStorage(t, a, getTestStorageManifest())
+
+	mB := getTestStorageManifest()
+	files := getTestFiles(t, mB)
+	//setup_first.exe is missing, first.sh is corrupted and first_manual.pdf has another checksum in the manifest
+	for _, file := range files {
+		if file.Name == "setup_first.exe" {
+			continue
+		} else if file.Name == "first.sh" {
+			content := []byte(testFileContents[file.Name])
+			content[0] = 'F'
+			uploadTestFile(t, b, file, string(content))
+		} else {
+			uploadTestFile(t, b, file, testFileContents[file.Name])
+		}
+	}
+	uploadTestFile(t, b, manifest.FileInfo{Game: files[0].Game, Kind: "extra", Name: "untracked.pdf"}, "untracked")
+	(*mB).Games[0].Extras[0].Checksum = "other"
+	(*mB).Games = append((*mB).Games, manifest.ManifestGame{Id: 3, Title: "Third Game", Extras: []manifest.ManifestGameExtra{manifest.ManifestGameExtra{Name: "third.pdf"}}})
+	b.StoreManifest(mB)
+
+	report, actions, errs := Compare(a, b, 2, true)
+	if len(errs) > 0 {
+		t.Fatalf("Comparison failed: %v", errs)
+	}
+
+	expected := map[string]string{
+		"setup_first.exe":  DivergenceMissingInB,
+		"first.sh":         DivergenceCorruptedInB,
+		"first_manual.pdf": DivergenceManifestMismatch,
+		"untracked.pdf":    DivergenceUntrackedInB,
+	}
+	if !reflect.DeepEqual(getCompareProblems(report), expected) {
+		t.Errorf("Expected divergences %v and got %v", expected, getCompareProblems(report))
+	}
+	if !reflect.DeepEqual(report.GamesOnlyInB, []int64{3}) || len(report.GamesOnlyInA) != 0 {
+		t.Errorf("Expected the third game to only be in the manifest of b")
+	}
+
+	action, ok := (*actions)[1]
+	if !ok {
+		t.Fatalf("Expected actions on the first game of b")
+	}
+	for _, name := range []string{"setup_first.exe", "first.sh"} {
+		if _, ok := action.InstallerActions[name]; !ok {
+			t.Errorf("Expected %s to be uploaded again to b", name)
+		}
+	}
+	if (*actions)[3].Action != "remove" {
+		t.Errorf("Expected the game only in b to be removed")
+	}
+}
+
+func TestCompareWithoutChecksums(t *testing.T) {
+	a := newTestFileSystem(t)
+	b := newTestFileSystem(t)
+	populateTestStorage(t, a, getTestStorageManifest())
+
+	m := getTestStorageManifest()
+	for _, file := range getTestFiles(t, m) {
+		content := []byte(testFileContents[file.Name])
+		if file.Name == "setup_second.exe" {
+			content[0] = 'S'
+		} else if file.Name == "first.sh" {
+			content = content[1:]
+		}
+		uploadTestFile(t, b, file, string(content))
+	}
+	b.StoreManifest(m)
+
+	report, _, errs := Compare(a, b, 2, false)
+	if len(errs) > 0 {
+		t.Fatalf("Comparison failed: %v", errs)
+	}
+
+	expected := map[string]string{"first.sh": DivergenceCorruptedInB}
+	if !reflect.DeepEqual(getCompareProblems(report), expected) {
+		t.Errorf("Expected only the file of another size to be detected without checksums and got %v", getCompareProblems(report))
+	}
+}
+
+func TestCompareSelfValidatingStorage(t *testing.T) {
+	a := newTestFileSystem(t)
+	populateTestStorage(t, a, getTestStorageManifest())
+
+	m := getTestStorageManifest()
+	checksums := map[string]string{}
+	for _, file := range getTestFiles(t, m) {
+		checksums[file.Name] = file.Checksum
+	}
+	checksums["setup_second.exe"] = "corrupted"
+	b := selfValidatingTestStorage{newTestFileSystem(t), checksums}
+	populateTestStorage(t, b, m)
+
+	report, actions, errs := Compare(a, b, 2, true)
+	if len(errs) > 0 {
+		t.Fatalf("Comparison failed: %v", errs)
+	}
+
+	expected := map[string]string{"setup_second.exe": DivergenceCorruptedInB}
+	if !reflect.DeepEqual(getCompareProblems(report), expected) {
+		t.Errorf("Expected the checksum listed by the self validating storage to be verified and got %v", getCompareProblems(report))
+	}
+	if _, ok := (*actions)[2].InstallerActions["setup_second.exe"]; !ok {
+		t.Errorf("Expected the corrupted file to be uploaded again")
+	}
+}
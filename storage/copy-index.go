@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Copies the manifest, metadata, actions and source of a storage to another one, leaving the game files untouched.
+//This is how an fs storage and a sqlite store sharing the same directory are converted into one another.
+func CopyIndex(source Storage, destination Storage) error {
+	exists, err := source.Exists()
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("CopyIndex(...) -> Source storage does not exist")
+	}
+
+	err = EnsureInitialization(destination)
+	if err != nil {
+		return err
+	}
+
+	hasManifest, err := source.HasManifest()
+	if err != nil {
+		return err
+	}
+
+	if !hasManifest {
+		return errors.New("CopyIndex(...) -> Source storage does not have a manifest")
+	}
+
+	m, err := source.LoadManifest()
+	if err != nil {
+		return err
+	}
+
+	err = destination.StoreManifest(m)
+	if err != nil {
+		return err
+	}
+
+	hasMetadata, err := source.HasMetadata()
+	if err != nil {
+		return err
+	}
+
+	if hasMetadata {
+		meta, err := source.LoadMetadata()
+		if err != nil {
+			return err
+		}
+
+		err = destination.StoreMetadata(meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	hasActions, err := source.HasActions()
+	if err != nil {
+		return err
+	}
+
+	if hasActions {
+		actions, err := source.LoadActions()
+		if err != nil {
+			return err
+		}
+
+		err = destination.StoreActions(actions)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = destination.RemoveActions()
+		if err != nil {
+			return err
+		}
+	}
+
+	hasSource, err := source.HasSource()
+	if err != nil {
+		return err
+	}
+
+	if hasSource {
+		src, err := source.LoadSource()
+		if err != nil {
+			return err
+		}
+
+		err = destination.StoreSource(src)
+		if err != nil {
+			msg := fmt.Sprintf("CopyIndex(...) -> Error copying the source: %s", err.Error())
+			return errors.New(msg)
+		}
+	} else {
+		err = destination.RemoveSource()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -34,6 +34,11 @@ func Copy(source Storage, destination Storage, sourceDownloader Downloader, a Ac
 		return []error{err}
 	}
 
+	err = RecoverJournal(destination)
+	if err != nil {
+		return []error{err}
+	}
+
 	hasSource, hasSourceErr = destination.HasSource()
 	if hasSourceErr != nil {
 		return []error{hasSourceErr}
@@ -14,3 +14,11 @@ func (d FileSystemDownloader) Download(file manifest.FileInfo) (io.ReadCloser, i
 	handle, size, err := d.Fs.DownloadFile(file)
 	return handle, size, file.Name, err
 }
+
+func (d FileSystemDownloader) DownloadFrom(file manifest.FileInfo, offset int64) (io.ReadCloser, int64, string, error) {
+	handle, size, err := d.Fs.DownloadFile(file)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return seekDownloadHandle(handle, size, file, offset)
+}
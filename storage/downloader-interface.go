@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"errors"
+	"fmt"
 	"gogcli/manifest"
 	"io"
 )
@@ -9,6 +11,41 @@ type Downloader interface {
 	Download(file manifest.FileInfo) (io.ReadCloser, int64, string, error)
 }
 
+//Downloaders that can start a download at an offset implement this interface so that interrupted uploads can be resumed.
+//The returned size is the length left to download from the offset.
+type RangeDownloader interface {
+	DownloadFrom(file manifest.FileInfo, offset int64) (io.ReadCloser, int64, string, error)
+}
+
+//Moves the download handle of a file to the offset for storages whose handles can seek
+func seekDownloadHandle(handle io.ReadCloser, size int64, file manifest.FileInfo, offset int64) (io.ReadCloser, int64, string, error) {
+	seeker, ok := handle.(io.Seeker)
+	if !ok {
+		handle.Close()
+		msg := fmt.Sprintf("seekDownloadHandle(gameId=%d, kind=%s, name=%s, offset=%d) -> Download handle cannot seek", file.Game.Id, file.Kind, file.Name, offset)
+		return nil, 0, "", errors.New(msg)
+	}
+
+	_, err := seeker.Seek(offset, io.SeekStart)
+	if err != nil {
+		handle.Close()
+		msg := fmt.Sprintf("seekDownloadHandle(gameId=%d, kind=%s, name=%s, offset=%d) -> Error occured while seeking: %s", file.Game.Id, file.Kind, file.Name, offset, err.Error())
+		return nil, 0, "", errors.New(msg)
+	}
+
+	return handle, size - offset, file.Name, nil
+}
+
+//Download errors that retrying cannot fix, like an expired session, implement this interface
+type UnrecoverableError interface {
+	Unrecoverable() bool
+}
+
+func isUnrecoverableError(err error) bool {
+	var uErr UnrecoverableError
+	return errors.As(err, &uErr) && uErr.Unrecoverable()
+}
+
 type Source struct {
 	Type         string
 	S3Params     S3Configs
@@ -14,3 +14,11 @@ func (d S3StoreDownloader) Download(file manifest.FileInfo) (io.ReadCloser, int6
 	handle, size, err := d.S3.DownloadFile(file)
 	return handle, size, file.Name, err
 }
+
+func (d S3StoreDownloader) DownloadFrom(file manifest.FileInfo, offset int64) (io.ReadCloser, int64, string, error) {
+	handle, size, err := d.S3.DownloadFile(file)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return seekDownloadHandle(handle, size, file, offset)
+}
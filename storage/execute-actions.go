@@ -21,6 +21,11 @@ func ExecuteActions(s Storage, d Downloader, a ActionsProcessor) []error {
 		return []error{errors.New(msg)}
 	}
 
+	err = RecoverJournal(s)
+	if err != nil {
+		return []error{err}
+	}
+
 	hasManifest, hasManifestErr := s.HasManifest()
 	if hasManifestErr != nil {
 		return []error{hasManifestErr}
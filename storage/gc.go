@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+var temporaryFileSuffixes = []string{".part", ".partial", ".tmp", ".temp", ".crdownload", ".download"}
+
+type OrphanFile struct {
+	GameId         int64
+	Kind           string
+	Name           string
+	Size           int64
+	UnexpectedName bool `json:",omitempty"`
+}
+
+type GcReport struct {
+	OrphanGames []int64
+	OrphanFiles []OrphanFile
+	OrphanSize  int64
+	Errors      []string `json:",omitempty"`
+}
+
+type GcUndoList struct {
+	TrashPath string
+	Games     []int64
+	Files     []OrphanFile
+}
+
+//Names that gog would never give a file, like hidden files and the leftovers of interrupted downloads
+func isUnexpectedFileName(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return true
+	}
+
+	lowerName := strings.ToLower(name)
+	for _, suffix := range temporaryFileSuffixes {
+		if strings.HasSuffix(lowerName, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func getManifestFileNames(m *manifest.Manifest) map[int64]map[string]bool {
+	names := make(map[int64]map[string]bool)
+	addName := func(gameId int64, kind string, name string) {
+		if _, ok := names[gameId]; !ok {
+			names[gameId] = make(map[string]bool)
+		}
+		names[gameId][kind+"/"+name] = true
+	}
+
+	for _, game := range (*m).Games {
+		if _, ok := names[game.Id]; !ok {
+			names[game.Id] = make(map[string]bool)
+		}
+		for _, installer := range game.Installers {
+			addName(game.Id, "installer", installer.Name)
+		}
+		for _, extra := range game.Extras {
+			addName(game.Id, "extra", extra.Name)
+		}
+		for _, depot := range game.Depots {
+			addName(game.Id, "depot", depot.Name)
+		}
+	}
+
+	for gameId, protected := range (*m).ProtectedFiles {
+		for _, name := range protected.Installers {
+			addName(gameId, "installer", name)
+		}
+		for _, name := range protected.Extras {
+			addName(gameId, "extra", name)
+		}
+		for _, name := range protected.Depots {
+			addName(gameId, "depot", name)
+		}
+	}
+
+	return names
+}
+
+//Lists the games and files of the storage that neither its manifest nor its protected files account for
+func FindOrphans(s Storage) (*GcReport, error) {
+	//The journal of an interrupted execution completes the stored manifest
+	err := RecoverJournal(s)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("FindOrphans(...) -> Error occured while recovering the journal: %s", err.Error()))
+	}
+
+	has, err := s.HasManifest()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("FindOrphans(...) -> Error checking manifest existance: %s", err.Error()))
+	}
+	if !has {
+		return nil, errors.New("FindOrphans(...) -> Manifest not found")
+	}
+
+	m, err := s.LoadManifest()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("FindOrphans(...) -> Error occured while loading the manifest: %s", err.Error()))
+	}
+	names := getManifestFileNames(m)
+
+	ids, err := s.GetGameIds()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("FindOrphans(...) -> Error occured while listing the games: %s", err.Error()))
+	}
+
+	report := GcReport{
+		OrphanGames: []int64{},
+		OrphanFiles: []OrphanFile{},
+	}
+	for _, id := range ids {
+		gameNames, known := names[id]
+		files, err := s.GetGameFiles(id)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("FindOrphans(...) -> Error occured while listing the files of game %d: %s", id, err.Error()))
+			continue
+		}
+
+		if !known {
+			report.OrphanGames = append(report.OrphanGames, id)
+		}
+
+		for _, file := range files {
+			if known && gameNames[file.Kind+"/"+file.Name] {
+				continue
+			}
+
+			report.OrphanFiles = append(report.OrphanFiles, OrphanFile{
+				GameId:         id,
+				Kind:           file.Kind,
+				Name:           file.Name,
+				Size:           file.Size,
+				UnexpectedName: isUnexpectedFileName(file.Name),
+			})
+			report.OrphanSize += file.Size
+		}
+	}
+
+	return &report, nil
+}
+
+func moveFile(from Storage, to Storage, file manifest.FileInfo) error {
+	handle, size, err := from.DownloadFile(file)
+	if err != nil {
+		return err
+	}
+	file.Size = size
+
+	_, err = to.UploadFile(handle, file)
+	if err != nil {
+		return err
+	}
+
+	return from.RemoveFile(file)
+}
+
+//Moves the orphans of the report in a file system storage at the trash path, from which they can be restored
+//with the returned undo list until the trash is emptied
+func CollectGarbage(s Storage, report *GcReport, trashPath string, logSource *logging.Source) (GcUndoList, []error) {
+	undo := GcUndoList{
+		TrashPath: trashPath,
+		Games:     []int64{},
+		Files:     []OrphanFile{},
+	}
+
+	err := RecoverJournal(s)
+	if err != nil {
+		return undo, []error{err}
+	}
+
+	hasActions, err := s.HasActions()
+	if err != nil {
+		return undo, []error{err}
+	}
+	if hasActions {
+		return undo, []error{errors.New("CollectGarbage(...) -> Unfinished actions are pending in the storage, which would remove some of the orphans anyways. Aborting.")}
+	}
+
+	trash := GetFileSystem(trashPath, logSource, "trash")
+	err = EnsureInitialization(trash)
+	if err != nil {
+		return undo, []error{err}
+	}
+
+	errs := []error{}
+	trashedGames := make(map[int64]bool)
+	for _, orphan := range (*report).OrphanFiles {
+		file := manifest.FileInfo{
+			Game: manifest.GameInfo{Id: orphan.GameId},
+			Kind: orphan.Kind,
+			Name: orphan.Name,
+		}
+
+		if !trashedGames[orphan.GameId] {
+			err = trash.AddGame(file.Game)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			trashedGames[orphan.GameId] = true
+		}
+
+		err = moveFile(s, trash, file)
+		if err != nil {
+			msg := fmt.Sprintf("CollectGarbage(...) -> Error occured while moving file %s of kind %s of game %d to the trash: %s", orphan.Name, orphan.Kind, orphan.GameId, err.Error())
+			errs = append(errs, errors.New(msg))
+			continue
+		}
+		undo.Files = append(undo.Files, orphan)
+	}
+
+	if len(errs) > 0 {
+		return undo, errs
+	}
+
+	for _, gameId := range (*report).OrphanGames {
+		err = s.RemoveGame(manifest.GameInfo{Id: gameId})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		undo.Games = append(undo.Games, gameId)
+	}
+
+	return undo, errs
+}
+
+//Moves the files of the undo list back from the trash into the storage
+func UndoGarbageCollection(s Storage, undo *GcUndoList, logSource *logging.Source) []error {
+	trash := GetFileSystem((*undo).TrashPath, logSource, "trash")
+
+	errs := []error{}
+	for _, gameId := range (*undo).Games {
+		err := s.AddGame(manifest.GameInfo{Id: gameId})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	remaining := []OrphanFile{}
+	for _, orphan := range (*undo).Files {
+		file := manifest.FileInfo{
+			Game: manifest.GameInfo{Id: orphan.GameId},
+			Kind: orphan.Kind,
+			Name: orphan.Name,
+		}
+
+		err := moveFile(trash, s, file)
+		if err != nil {
+			msg := fmt.Sprintf("UndoGarbageCollection(...) -> Error occured while restoring file %s of kind %s of game %d from the trash: %s", orphan.Name, orphan.Kind, orphan.GameId, err.Error())
+			errs = append(errs, errors.New(msg))
+			remaining = append(remaining, orphan)
+		}
+	}
+	(*undo).Files = remaining
+	(*undo).Games = []int64{}
+
+	return errs
+}
+
+func LoadGcUndoList(path string) (*GcUndoList, error) {
+	var undo GcUndoList
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(bs, &undo)
+	if err != nil {
+		return nil, err
+	}
+	return &undo, nil
+}
+
+func StoreGcUndoList(undo *GcUndoList, path string) error {
+	if len((*undo).Files) == 0 && len((*undo).Games) == 0 {
+		err := os.Remove(path)
+		if err != nil && (!os.IsNotExist(err)) {
+			return err
+		}
+		return nil
+	}
+
+	bs, err := json.MarshalIndent(undo, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bs, 0644)
+}
@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"gogcli/logging"
+	"gogcli/manifest"
+	"path/filepath"
+	"testing"
+)
+
+//Populates the storage with the test manifest, a leftover download in the first game and a game absent from the manifest
+func populateTestGcStorage(t *testing.T, s Storage) {
+	populateTestStorage(t, s, getTestStorageManifest())
+	uploadTestFile(t, s, manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "installer", Name: "setup_first.exe.part"}, "partial")
+	uploadTestFile(t, s, manifest.FileInfo{Game: manifest.GameInfo{Id: 3}, Kind: "extra", Name: "third.pdf"}, "third game manual")
+}
+
+func getOrphanNames(report *GcReport) map[string]bool {
+	names := map[string]bool{}
+	for _, orphan := range (*report).OrphanFiles {
+		names[orphan.Name] = orphan.UnexpectedName
+	}
+	return names
+}
+
+func TestIsUnexpectedFileName(t *testing.T) {
+	expectations := map[string]bool{
+		"setup_game.exe":          false,
+		"manual.pdf":              false,
+		".hidden":                 true,
+		"setup_game.exe.PART":     true,
+		"setup_game.exe.download": true,
+		"game.tmp":                true,
+	}
+	for name, expected := range expectations {
+		if isUnexpectedFileName(name) != expected {
+			t.Errorf("Expected unexpected name of %s to be %t", name, expected)
+		}
+	}
+}
+
+func TestFindOrphans(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestGcStorage(t, s)
+
+	m := getTestStorageManifest()
+	(*m).ProtectedFiles = map[int64]manifest.ProtectedGameFiles{
+		3: manifest.ProtectedGameFiles{Extras: []string{"protected.pdf"}},
+	}
+	s.StoreManifest(m)
+	uploadTestFile(t, s, manifest.FileInfo{Game: manifest.GameInfo{Id: 3}, Kind: "extra", Name: "protected.pdf"}, "protected")
+
+	report, err := FindOrphans(s)
+	if err != nil {
+		t.Fatalf("Finding the orphans failed: %s", err.Error())
+	}
+
+	if len(report.OrphanGames) != 0 {
+		t.Errorf("Expected the game with protected files not to be an orphan and got %v", report.OrphanGames)
+	}
+	names := getOrphanNames(report)
+	if len(names) != 2 || names["setup_first.exe.part"] != true || names["third.pdf"] != false {
+		t.Errorf("Expected the leftover download and the unprotected file to be orphans and got %v", names)
+	}
+	if report.OrphanSize != int64(len("partial")+len("third game manual")) {
+		t.Errorf("Expected the size of the orphans to add up and got %d", report.OrphanSize)
+	}
+}
+
+func TestFindOrphansRecoversJournal(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestGcStorage(t, s)
+
+	//The execution uploaded the installer of the second game, but was interrupted before storing its checksum in the manifest
+	m := getTestStorageManifest()
+	(*m).Games[1].Installers[0].Checksum = ""
+	s.StoreManifest(m)
+	installer := manifest.FileInfo{Game: manifest.GameInfo{Id: 2}, Kind: "installer", Name: "setup_second.exe"}
+	entry := appendTestJournalEntry(t, s, installer)
+
+	report, err := FindOrphans(s)
+	if err != nil {
+		t.Fatalf("Finding the orphans failed: %s", err.Error())
+	}
+	if len(report.OrphanGames) != 1 || report.OrphanGames[0] != 3 {
+		t.Errorf("Expected the third game to be an orphan and got %v", report.OrphanGames)
+	}
+
+	entries, _ := s.LoadJournal()
+	if len(entries) != 0 {
+		t.Errorf("Expected the journal to be cleared once recovered")
+	}
+	stored, _ := s.LoadManifest()
+	if (*stored).Games[1].Installers[0].Checksum != entry.FileChecksum {
+		t.Errorf("Expected the journal to be replayed on the manifest before looking for orphans")
+	}
+}
+
+func TestCollectGarbageAndUndo(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestGcStorage(t, s)
+	trashPath := filepath.Join(t.TempDir(), "trash")
+
+	report, err := FindOrphans(s)
+	if err != nil {
+		t.Fatalf("Finding the orphans failed: %s", err.Error())
+	}
+
+	undo, errs := CollectGarbage(s, report, trashPath, logging.CreateSource("error"))
+	if len(errs) > 0 {
+		t.Fatalf("Collecting the garbage failed: %v", errs)
+	}
+	if len(undo.Files) != 2 || len(undo.Games) != 1 {
+		t.Errorf("Expected the undo list to hold the trashed files and games and got %v", undo)
+	}
+
+	ids, _ := s.GetGameIds()
+	if len(ids) != 2 {
+		t.Errorf("Expected the orphan game to be removed and got games %v", ids)
+	}
+	report, _ = FindOrphans(s)
+	if len(report.OrphanFiles) != 0 {
+		t.Errorf("Expected no orphan left after the garbage collection and got %v", report.OrphanFiles)
+	}
+
+	undoPath := filepath.Join(t.TempDir(), "undo.json")
+	err = StoreGcUndoList(&undo, undoPath)
+	if err != nil {
+		t.Fatalf("Could not store the undo list: %s", err.Error())
+	}
+	loadedUndo, err := LoadGcUndoList(undoPath)
+	if err != nil {
+		t.Fatalf("Could not load the undo list: %s", err.Error())
+	}
+
+	errs = UndoGarbageCollection(s, loadedUndo, logging.CreateSource("error"))
+	if len(errs) > 0 {
+		t.Fatalf("Undoing the garbage collection failed: %v", errs)
+	}
+	if len(loadedUndo.Files) != 0 || len(loadedUndo.Games) != 0 {
+		t.Errorf("Expected the undo list to be emptied and got %v", *loadedUndo)
+	}
+	thirdManual := manifest.FileInfo{Game: manifest.GameInfo{Id: 3}, Kind: "extra", Name: "third.pdf"}
+	if readTestFile(t, s, thirdManual) != "third game manual" {
+		t.Errorf("Expected the orphan file to be restored")
+	}
+	report, _ = FindOrphans(s)
+	if len(report.OrphanFiles) != 2 || len(report.OrphanGames) != 1 {
+		t.Errorf("Expected the orphans to be back after the undo and got %v", *report)
+	}
+}
+
+func TestCollectGarbageWithPendingActions(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestGcStorage(t, s)
+
+	report, err := FindOrphans(s)
+	if err != nil {
+		t.Fatalf("Finding the orphans failed: %s", err.Error())
+	}
+
+	actions := manifest.GameActions{
+		2: manifest.GameAction{Id: 2, Action: "remove"},
+	}
+	s.StoreActions(&actions)
+
+	undo, errs := CollectGarbage(s, report, filepath.Join(t.TempDir(), "trash"), logging.CreateSource("error"))
+	if len(errs) != 1 {
+		t.Errorf("Expected the garbage collection to abort with pending actions")
+	}
+	if len(undo.Files) != 0 || len(undo.Games) != 0 {
+		t.Errorf("Expected nothing to be trashed with pending actions and got %v", undo)
+	}
+}
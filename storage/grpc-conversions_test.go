@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"gogcli/manifest"
+	"reflect"
+	"testing"
+)
+
+func getTestGrpcManifestGame() manifest.ManifestGame {
+	return manifest.ManifestGame{
+		Id:                   1,
+		Slug:                 "first_game",
+		Title:                "First Game",
+		CdKey:                "key",
+		Tags:                 []string{"rpg"},
+		IsBaseProductMissing: true,
+		Installers: []manifest.ManifestGameInstaller{
+			manifest.ManifestGameInstaller{
+				Languages:     []string{"english"},
+				Os:            "windows",
+				Url:           "/downloads/first_game/setup",
+				Title:         "First Game",
+				Name:          "setup_first.exe",
+				Version:       "1.0",
+				Date:          "2022-01-01",
+				EstimatedSize: "1 MB",
+				VerifiedSize:  1024,
+				Checksum:      "abc",
+			},
+			manifest.ManifestGameInstaller{
+				Languages:     []string{"english"},
+				Os:            "windows",
+				Url:           "/downloads/first_game/patch",
+				Title:         "First Game Patch",
+				Name:          "patch_first.exe",
+				Type:          manifest.InstallerTypePatch,
+				Version:       "1.1",
+				FromVersion:   "1.0",
+				ToVersion:     "1.1",
+				Date:          "2022-02-01",
+				EstimatedSize: "1 KB",
+				VerifiedSize:  100,
+				Checksum:      "jkl",
+			},
+			manifest.ManifestGameInstaller{
+				Languages:     []string{"english"},
+				Os:            "windows",
+				Url:           "/downloads/first_game/dlc_soundtrack_installer",
+				Title:         "Soundtrack",
+				Name:          "soundtrack_installer.exe",
+				Version:       "1.0",
+				Date:          "2022-01-01",
+				EstimatedSize: "1 KB",
+				VerifiedSize:  30,
+				Checksum:      "pqr",
+				Dlc:           "First Game Soundtrack",
+			},
+		},
+		Extras: []manifest.ManifestGameExtra{
+			manifest.ManifestGameExtra{
+				Url:           "/downloads/first_game/manual",
+				Title:         "Manual",
+				Name:          "first_manual.pdf",
+				Type:          "manuals",
+				Info:          1,
+				EstimatedSize: "1 KB",
+				VerifiedSize:  10,
+				Checksum:      "def",
+			},
+			manifest.ManifestGameExtra{
+				Url:           "/downloads/first_game/dlc_soundtrack",
+				Title:         "Soundtrack",
+				Name:          "soundtrack.zip",
+				Type:          "audio",
+				Info:          1,
+				EstimatedSize: "1 KB",
+				VerifiedSize:  20,
+				Checksum:      "mno",
+				Dlc:           "First Game Soundtrack",
+			},
+		},
+		Depots: []manifest.ManifestGameDepot{
+			manifest.ManifestGameDepot{
+				Languages:     []string{"english"},
+				Os:            "linux",
+				Url:           "/downloads/first_game/depot",
+				Title:         "First Game",
+				Name:          "depot_first",
+				ProductId:     1,
+				BuildId:       "123",
+				Manifest:      "manifest_id",
+				Format:        "tar",
+				Version:       "1.0",
+				Date:          "2022-01-02",
+				EstimatedSize: "2 MB",
+				VerifiedSize:  2048,
+				Checksum:      "ghi",
+			},
+		},
+		Dlcs: []manifest.ManifestGameDlc{
+			manifest.ManifestGameDlc{
+				Title:        "First Game Soundtrack",
+				Installers:   []string{"soundtrack_installer.exe"},
+				Extras:       []string{"soundtrack.zip"},
+				VerifiedSize: 50,
+			},
+		},
+		EstimatedSize: "3 MB",
+		VerifiedSize:  3232,
+	}
+}
+
+func TestConvertManifestGameRoundTrip(t *testing.T) {
+	game := getTestGrpcManifestGame()
+
+	converted := ConvertGrpcManifestGame(ConvertManifestGame(game))
+	if !reflect.DeepEqual(converted, game) {
+		t.Errorf("Expected the game to be carried through the grpc protocol and got %v instead of %v", converted, game)
+	}
+}
+
+func TestConvertGameActionRoundTrip(t *testing.T) {
+	action := manifest.GameAction{
+		Title:            "First Game",
+		Slug:             "first_game",
+		Id:               1,
+		Action:           "update",
+		InstallerActions: map[string]manifest.FileAction{"setup_first.exe": manifest.FileAction{Title: "First Game", Name: "setup_first.exe", Url: "/setup", Kind: "installer", Action: "add"}},
+		ExtraActions:     map[string]manifest.FileAction{"first_manual.pdf": manifest.FileAction{Title: "Manual", Name: "first_manual.pdf", Url: "/manual", Kind: "extra", Action: "remove"}},
+		DepotActions:     map[string]manifest.FileAction{"depot_first": manifest.FileAction{Title: "First Game", Name: "depot_first", Url: "/depot", Kind: "depot", Action: "add"}},
+	}
+
+	converted := ConvertGrpcGameAction(ConvertGameAction(action))
+	if !reflect.DeepEqual(converted, action) {
+		t.Errorf("Expected the game action to be carried through the grpc protocol and got %v instead of %v", converted, action)
+	}
+}
+
+func TestConvertManifestFilterRoundTrip(t *testing.T) {
+	converted := ConvertGrpcManifestFilter(ConvertManifestFilter(manifest.ManifestFilter{
+		Titles:            []string{"First Game"},
+		Oses:              []string{"linux"},
+		Languages:         []string{"english"},
+		Tags:              []string{"rpg"},
+		Installers:        true,
+		Extras:            true,
+		Depots:            true,
+		DepotFormat:       "tar",
+		Patches:           manifest.PatchesLatest,
+		SkipLanguagePacks: true,
+		SkipDlcs:          true,
+		SkipOrphanDlcs:    true,
+		ExtraTypes:        []string{"manuals"},
+		Intersections:     []manifest.ManifestFilter{},
+	}))
+
+	if len(converted.Titles) != 1 || len(converted.Oses) != 1 || converted.Oses[0] != "linux" || len(converted.Languages) != 1 || len(converted.Tags) != 1 || len(converted.ExtraTypes) != 1 {
+		t.Errorf("Expected the lists of the filter to be carried through the grpc protocol and got %v, %v, %v, %v and %v", converted.Titles, converted.Oses, converted.Languages, converted.Tags, converted.ExtraTypes)
+	}
+	if !converted.Installers || !converted.Extras {
+		t.Errorf("Expected the file kinds of the filter to be carried through the grpc protocol")
+	}
+	if !converted.Depots || converted.DepotFormat != "tar" {
+		t.Errorf("Expected the depot settings of the filter to be carried through the grpc protocol and got %t and %s", converted.Depots, converted.DepotFormat)
+	}
+	if converted.Patches != manifest.PatchesLatest || !converted.SkipLanguagePacks {
+		t.Errorf("Expected the patch and language pack settings of the filter to be carried through the grpc protocol and got %s and %t", converted.Patches, converted.SkipLanguagePacks)
+	}
+	if !converted.SkipDlcs || !converted.SkipOrphanDlcs {
+		t.Errorf("Expected the dlc settings of the filter to be carried through the grpc protocol and got %t and %t", converted.SkipDlcs, converted.SkipOrphanDlcs)
+	}
+}
+
+func TestConvertSourceRoundTrip(t *testing.T) {
+	src := Source{
+		Type: "s3",
+		S3Params: S3Configs{
+			Endpoint:       "localhost:9000",
+			Region:         "us-east-1",
+			Bucket:         "games",
+			Tls:            true,
+			AccessKey:      "access",
+			SecretKey:      "secret",
+			PartSize:       16 * 1024 * 1024,
+			Prefix:         "gog/",
+			StorageClasses: map[string]string{"extra": "GLACIER"},
+			Tags:           true,
+			RestoreDays:    7,
+			RestoreTier:    "Bulk",
+		},
+		GrpcParams: GrpcConfigs{},
+	}
+
+	converted := ConvertGrpcSource(ConvertSource(src))
+	if !reflect.DeepEqual(converted, src) {
+		t.Errorf("Expected the source to be carried through the grpc protocol and got %v instead of %v", converted, src)
+	}
+}
@@ -57,6 +57,12 @@ func ConvertGrpcManifestFilter(filter *storagegrpc.ManifestFilter) manifest.Mani
 		Tags: filter.GetTags(),
 		Installers: filter.GetInstallers(),
 		Extras: filter.GetExtras(),
+		Depots: filter.GetDepots(),
+		DepotFormat: filter.GetDepotFormat(),
+		Patches: filter.GetPatches(),
+		SkipLanguagePacks: filter.GetSkipLanguagePacks(),
+		SkipDlcs: filter.GetSkipDlcs(),
+		SkipOrphanDlcs: filter.GetSkipOrphanDlcs(),
 		ExtraTypes: filter.GetExtraTypes(),
 		Intersections: []manifest.ManifestFilter{},
 	}
@@ -88,7 +94,11 @@ func ConvertGrpcManifestGameInstaller(installer *storagegrpc.ManifestGameInstall
 		Url: installer.GetUrl(),
 		Title: installer.GetTitle(),
 		Name: installer.GetName(),
+		Type: installer.GetType(),
 		Version: installer.GetVersion(),
+		FromVersion: installer.GetFromVersion(),
+		ToVersion: installer.GetToVersion(),
+		Dlc: installer.GetDlc(),
 		Date: installer.GetDate(),
 		EstimatedSize: installer.GetEstimatedSize(),
 		VerifiedSize: installer.GetVerifiedSize(),
@@ -106,6 +116,35 @@ func ConvertGrpcManifestGameExtra(extra *storagegrpc.ManifestGameExtra) manifest
 		EstimatedSize: extra.GetEstimatedSize(),
 		VerifiedSize: extra.GetVerifiedSize(),
 		Checksum: extra.GetChecksum(),
+		Dlc: extra.GetDlc(),
+	}
+}
+
+func ConvertGrpcManifestGameDepot(depot *storagegrpc.ManifestGameDepot) manifest.ManifestGameDepot {
+	return manifest.ManifestGameDepot{
+		Languages: depot.GetLanguages(),
+		Os: ConvertGrpcOs(depot.GetTargetOs()),
+		Url: depot.GetUrl(),
+		Title: depot.GetTitle(),
+		Name: depot.GetName(),
+		ProductId: depot.GetProductId(),
+		BuildId: depot.GetBuildId(),
+		Manifest: depot.GetManifest(),
+		Format: depot.GetFormat(),
+		Version: depot.GetVersion(),
+		Date: depot.GetDate(),
+		EstimatedSize: depot.GetEstimatedSize(),
+		VerifiedSize: depot.GetVerifiedSize(),
+		Checksum: depot.GetChecksum(),
+	}
+}
+
+func ConvertGrpcManifestGameDlc(dlc *storagegrpc.ManifestGameDlc) manifest.ManifestGameDlc {
+	return manifest.ManifestGameDlc{
+		Title: dlc.GetTitle(),
+		Installers: dlc.GetInstallers(),
+		Extras: dlc.GetExtras(),
+		VerifiedSize: dlc.GetVerifiedSize(),
 	}
 }
 
@@ -116,6 +155,7 @@ func ConvertGrpcManifestGame(game *storagegrpc.ManifestGame) manifest.ManifestGa
 		Title: game.GetTitle(),
 		CdKey: game.GetCdKey(),
 		Tags: game.GetTags(),
+		IsBaseProductMissing: game.GetIsBaseProductMissing(),
 		Installers: []manifest.ManifestGameInstaller{},
 		Extras: []manifest.ManifestGameExtra{},
 		EstimatedSize: game.GetEstimatedSize(),
@@ -130,6 +170,14 @@ func ConvertGrpcManifestGame(game *storagegrpc.ManifestGame) manifest.ManifestGa
 		conversion.Extras = append(conversion.Extras, ConvertGrpcManifestGameExtra(extra))
 	}
 
+	for _, depot := range game.GetDepots() {
+		conversion.Depots = append(conversion.Depots, ConvertGrpcManifestGameDepot(depot))
+	}
+
+	for _, dlc := range game.GetDlcs() {
+		conversion.Dlcs = append(conversion.Dlcs, ConvertGrpcManifestGameDlc(dlc))
+	}
+
 	return conversion
 }
 
@@ -151,6 +199,7 @@ func ConvertGrpcGameAction(action *storagegrpc.GameAction) manifest.GameAction {
 		Action: action.GetAction(),
 		InstallerActions: map[string]manifest.FileAction{},
 		ExtraActions: map[string]manifest.FileAction{},
+		DepotActions: map[string]manifest.FileAction{},
 	}
 
 	for _, fAction := range action.GetInstallerActions() {
@@ -161,6 +210,10 @@ func ConvertGrpcGameAction(action *storagegrpc.GameAction) manifest.GameAction {
 		conversion.ExtraActions[fAction.GetName()] = ConvertGrpcFileAction(fAction)
 	}
 
+	for _, fAction := range action.GetDepotActions() {
+		conversion.DepotActions[fAction.GetName()] = ConvertGrpcFileAction(fAction)
+	}
+
 	return conversion
 }
 
@@ -178,6 +231,12 @@ func ConvertGrpcS3Configs(conf *storagegrpc.S3Configs) S3Configs {
 		Tls: conf.GetTls(),
 		AccessKey: conf.GetAccessKey(),
 		SecretKey: conf.GetSecretKey(),
+		PartSize: conf.GetPartSize(),
+		Prefix: conf.GetPrefix(),
+		StorageClasses: conf.GetStorageClasses(),
+		Tags: conf.GetTags(),
+		RestoreDays: int(conf.GetRestoreDays()),
+		RestoreTier: conf.GetRestoreTier(),
 	}
 }
 
@@ -21,8 +21,16 @@ func ConvertOs(os string) storagegrpc.Os {
 func ConvertManifestFilter(filter manifest.ManifestFilter) *storagegrpc.ManifestFilter {
 	conversion := storagegrpc.ManifestFilter{
 		Titles: filter.Titles,
+		Languages: filter.Languages,
+		Tags: filter.Tags,
 		Installers: filter.Installers,
 		Extras: filter.Extras,
+		Depots: filter.Depots,
+		DepotFormat: filter.DepotFormat,
+		Patches: filter.Patches,
+		SkipLanguagePacks: filter.SkipLanguagePacks,
+		SkipDlcs: filter.SkipDlcs,
+		SkipOrphanDlcs: filter.SkipOrphanDlcs,
 		ExtraTypes: filter.ExtraTypes,
 		Oses: []storagegrpc.Os{},
 		Intersections: []*storagegrpc.ManifestFilter{},
@@ -56,7 +64,11 @@ func ConvertManifestGameInstaller(installer manifest.ManifestGameInstaller) *sto
 		Url: installer.Url,
 		TargetOs: ConvertOs(installer.Os),
 		Languages: installer.Languages,
+		Type: installer.Type,
 		Version: installer.Version,
+		FromVersion: installer.FromVersion,
+		ToVersion: installer.ToVersion,
+		Dlc: installer.Dlc,
 		Date: installer.Date,
 		EstimatedSize: installer.EstimatedSize,
 		VerifiedSize: installer.VerifiedSize,
@@ -76,6 +88,39 @@ func ConvertManifestGameExtra(extra manifest.ManifestGameExtra) *storagegrpc.Man
 		EstimatedSize: extra.EstimatedSize,
 		VerifiedSize: extra.VerifiedSize,
 		Checksum: extra.Checksum,
+		Dlc: extra.Dlc,
+	}
+
+	return &conversion
+}
+
+func ConvertManifestGameDepot(depot manifest.ManifestGameDepot) *storagegrpc.ManifestGameDepot {
+	conversion := storagegrpc.ManifestGameDepot{
+		Name: depot.Name,
+		Title: depot.Title,
+		Url: depot.Url,
+		TargetOs: ConvertOs(depot.Os),
+		Languages: depot.Languages,
+		ProductId: depot.ProductId,
+		BuildId: depot.BuildId,
+		Manifest: depot.Manifest,
+		Format: depot.Format,
+		Version: depot.Version,
+		Date: depot.Date,
+		EstimatedSize: depot.EstimatedSize,
+		VerifiedSize: depot.VerifiedSize,
+		Checksum: depot.Checksum,
+	}
+
+	return &conversion
+}
+
+func ConvertManifestGameDlc(dlc manifest.ManifestGameDlc) *storagegrpc.ManifestGameDlc {
+	conversion := storagegrpc.ManifestGameDlc{
+		Title: dlc.Title,
+		Installers: dlc.Installers,
+		Extras: dlc.Extras,
+		VerifiedSize: dlc.VerifiedSize,
 	}
 
 	return &conversion
@@ -84,11 +129,15 @@ func ConvertManifestGameExtra(extra manifest.ManifestGameExtra) *storagegrpc.Man
 func ConvertManifestGame(game manifest.ManifestGame) *storagegrpc.ManifestGame {
 	conversion := storagegrpc.ManifestGame{
 		Id: game.Id,
+		Slug: game.Slug,
 		Title: game.Title,
 		CdKey: game.CdKey,
 		Tags: game.Tags,
+		IsBaseProductMissing: game.IsBaseProductMissing,
 		Installers: []*storagegrpc.ManifestGameInstaller{},
 		Extras: []*storagegrpc.ManifestGameExtra{},
+		Depots: []*storagegrpc.ManifestGameDepot{},
+		Dlcs: []*storagegrpc.ManifestGameDlc{},
 		EstimatedSize: game.EstimatedSize,
 		VerifiedSize: game.VerifiedSize,
 	}
@@ -101,6 +150,14 @@ func ConvertManifestGame(game manifest.ManifestGame) *storagegrpc.ManifestGame {
 		conversion.Extras = append(conversion.Extras, ConvertManifestGameExtra(extra))
 	}
 
+	for _, depot := range game.Depots {
+		conversion.Depots = append(conversion.Depots, ConvertManifestGameDepot(depot))
+	}
+
+	for _, dlc := range game.Dlcs {
+		conversion.Dlcs = append(conversion.Dlcs, ConvertManifestGameDlc(dlc))
+	}
+
 	return &conversion
 }
 
@@ -124,6 +181,7 @@ func ConvertGameAction(action manifest.GameAction) *storagegrpc.GameAction {
 		Action: action.Action,
 		InstallerActions: []*storagegrpc.FileAction{},
 		ExtraActions: []*storagegrpc.FileAction{},
+		DepotActions: []*storagegrpc.FileAction{},
 	}
 
 	for _, fileAction := range action.InstallerActions {
@@ -134,6 +192,10 @@ func ConvertGameAction(action manifest.GameAction) *storagegrpc.GameAction {
 		conversion.ExtraActions = append(conversion.ExtraActions, ConvertFileAction(fileAction))
 	}
 
+	for _, fileAction := range action.DepotActions {
+		conversion.DepotActions = append(conversion.DepotActions, ConvertFileAction(fileAction))
+	}
+
 	return &conversion
 }
 
@@ -153,6 +215,12 @@ func ConvertS3Configs(conf S3Configs) *storagegrpc.S3Configs {
 		Tls: conf.Tls,
 		AccessKey: conf.AccessKey,
 		SecretKey: conf.SecretKey,
+		PartSize: conf.PartSize,
+		Prefix: conf.Prefix,
+		StorageClasses: conf.StorageClasses,
+		Tags: conf.Tags,
+		RestoreDays: int64(conf.RestoreDays),
+		RestoreTier: conf.RestoreTier,
 	}
 
 	return &conversion
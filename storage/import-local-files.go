@@ -0,0 +1,299 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type LocalFileMatch struct {
+	GameId int64
+	Kind   string
+	Name   string
+	Path   string
+}
+
+type LocalFileMismatch struct {
+	GameId   int64
+	Kind     string
+	Name     string
+	Path     string
+	Problem  string
+	Size     int64  `json:",omitempty"`
+	Checksum string `json:",omitempty"`
+}
+
+type LocalFilesReport struct {
+	Matches      []LocalFileMatch
+	Mismatches   []LocalFileMismatch
+	FilesScanned int
+	MatchedSize  int64
+	Errors       []string `json:",omitempty"`
+}
+
+type localFileCandidate struct {
+	path string
+	size int64
+	file manifest.FileInfo
+}
+
+func getLocalFileKey(gameId int64, kind string, name string) string {
+	return fmt.Sprintf("%d/%s/%s", gameId, kind, name)
+}
+
+//Returns the files the pending actions of the storage would download, indexed by name
+func getPendingDownloads(s Storage) (map[string][]manifest.FileInfo, error) {
+	err := RecoverJournal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	hasActions, err := s.HasActions()
+	if err != nil {
+		return nil, err
+	}
+	if !hasActions {
+		return nil, errors.New("getPendingDownloads(...) -> Storage does not have pending actions")
+	}
+
+	m, err := s.LoadManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := s.LoadActions()
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make(map[string][]manifest.FileInfo)
+	for _, gameAction := range *actions {
+		gameInfo := manifest.GameInfo{Id: gameAction.Id, Slug: gameAction.Slug, Title: gameAction.Title}
+		fileActions := []manifest.FileAction{}
+		for _, fileAction := range gameAction.InstallerActions {
+			fileActions = append(fileActions, fileAction)
+		}
+		for _, fileAction := range gameAction.ExtraActions {
+			fileActions = append(fileActions, fileAction)
+		}
+		for _, fileAction := range gameAction.DepotActions {
+			fileActions = append(fileActions, fileAction)
+		}
+
+		for _, fileAction := range fileActions {
+			if fileAction.Action != "add" {
+				continue
+			}
+
+			fileInfo, err := m.GetFileActionFileInfo(gameInfo, fileAction)
+			if err != nil {
+				return nil, err
+			}
+			pending[fileInfo.Name] = append(pending[fileInfo.Name], fileInfo)
+		}
+	}
+
+	return pending, nil
+}
+
+func hashLocalFile(path string) (string, error) {
+	handle, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer handle.Close()
+
+	h := md5.New()
+	_, err = io.Copy(h, handle)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+//Collects the files of a directory that could be the files pending downloads
+type localFilesWalker struct {
+	dir        string
+	pending    map[string][]manifest.FileInfo
+	report     *LocalFilesReport
+	candidates []localFileCandidate
+}
+
+//Entries that cannot be read are listed in the errors of the report and skipped, so that the rest of the
+//directory is still searched
+func (w *localFilesWalker) visit(path string, entry fs.DirEntry, err error) error {
+	if err != nil {
+		if path == (*w).dir {
+			return err
+		}
+
+		(*w).report.Errors = append((*w).report.Errors, fmt.Sprintf("FindLocalFiles(...) -> Error occured while reading %s: %s", path, err.Error()))
+		if entry != nil && entry.IsDir() {
+			return fs.SkipDir
+		}
+		return nil
+	}
+	if !entry.Type().IsRegular() {
+		return nil
+	}
+
+	(*w).report.FilesScanned++
+	files, ok := (*w).pending[entry.Name()]
+	if !ok {
+		return nil
+	}
+
+	info, err := entry.Info()
+	if err != nil {
+		(*w).report.Errors = append((*w).report.Errors, fmt.Sprintf("FindLocalFiles(...) -> Error occured while reading %s: %s", path, err.Error()))
+		return nil
+	}
+
+	for _, file := range files {
+		if file.Size > 0 && file.Size != info.Size() {
+			(*w).report.Mismatches = append((*w).report.Mismatches, LocalFileMismatch{GameId: file.Game.Id, Kind: file.Kind, Name: file.Name, Path: path, Problem: "size", Size: info.Size()})
+			continue
+		}
+		if file.Checksum == "" {
+			(*w).report.Mismatches = append((*w).report.Mismatches, LocalFileMismatch{GameId: file.Game.Id, Kind: file.Kind, Name: file.Name, Path: path, Problem: "noManifestChecksum"})
+			continue
+		}
+		(*w).candidates = append((*w).candidates, localFileCandidate{path: path, size: info.Size(), file: file})
+	}
+	return nil
+}
+
+//Walks the directory for files with the name and size of files the pending actions of the storage would download
+//and hashes them to find those that match the checksum of the manifest
+func FindLocalFiles(s Storage, dir string, concurrency int) (*LocalFilesReport, error) {
+	pending, err := getPendingDownloads(s)
+	if err != nil {
+		return nil, err
+	}
+
+	report := LocalFilesReport{
+		Matches:    []LocalFileMatch{},
+		Mismatches: []LocalFileMismatch{},
+	}
+
+	w := localFilesWalker{dir: dir, pending: pending, report: &report, candidates: []localFileCandidate{}}
+	err = filepath.WalkDir(dir, w.visit)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("FindLocalFiles(..., dir=%s, ...) -> Error occured while walking the directory: %s", dir, err.Error()))
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	matched := make(map[string]bool)
+	candidatesCh := make(chan localFileCandidate)
+	for idx := 0; idx < concurrency; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for candidate := range candidatesCh {
+				checksum, err := hashLocalFile(candidate.path)
+
+				mu.Lock()
+				key := getLocalFileKey(candidate.file.Game.Id, candidate.file.Kind, candidate.file.Name)
+				if err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("FindLocalFiles(...) -> Error occured while hashing file %s: %s", candidate.path, err.Error()))
+				} else if checksum != candidate.file.Checksum {
+					report.Mismatches = append(report.Mismatches, LocalFileMismatch{GameId: candidate.file.Game.Id, Kind: candidate.file.Kind, Name: candidate.file.Name, Path: candidate.path, Problem: "checksum", Checksum: checksum})
+				} else if !matched[key] {
+					matched[key] = true
+					report.Matches = append(report.Matches, LocalFileMatch{GameId: candidate.file.Game.Id, Kind: candidate.file.Kind, Name: candidate.file.Name, Path: candidate.path})
+					report.MatchedSize += candidate.size
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, candidate := range w.candidates {
+		candidatesCh <- candidate
+	}
+	close(candidatesCh)
+	wg.Wait()
+
+	return &report, nil
+}
+
+//Implementation of the Downloader interface serving the local files matched to the files of the manifest
+type LocalFilesDownloader struct {
+	Paths map[string]string
+}
+
+func NewLocalFilesDownloader(report *LocalFilesReport) LocalFilesDownloader {
+	paths := make(map[string]string)
+	for _, match := range (*report).Matches {
+		paths[getLocalFileKey(match.GameId, match.Kind, match.Name)] = match.Path
+	}
+	return LocalFilesDownloader{Paths: paths}
+}
+
+func (d LocalFilesDownloader) Download(file manifest.FileInfo) (io.ReadCloser, int64, string, error) {
+	path, ok := d.Paths[getLocalFileKey(file.Game.Id, file.Kind, file.Name)]
+	if !ok {
+		msg := fmt.Sprintf("LocalFilesDownloader.Download(file={Game={Id=%d, ...}, Kind=%s, Name=%s, ...}) -> No local file matches this file", file.Game.Id, file.Kind, file.Name)
+		return nil, 0, "", errors.New(msg)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	handle, err := os.Open(path)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	return handle, info.Size(), filepath.Base(path), nil
+}
+
+//Only performs the pending actions of the storage that upload the matched local files,
+//leaving the other actions to be executed from the storage's source
+func ImportLocalFiles(s Storage, report *LocalFilesReport, a ActionsProcessor) []error {
+	err := RecoverJournal(s)
+	if err != nil {
+		return []error{err}
+	}
+
+	m, err := s.LoadManifest()
+	if err != nil {
+		return []error{err}
+	}
+
+	actions, err := s.LoadActions()
+	if err != nil {
+		return []error{err}
+	}
+
+	d := NewLocalFilesDownloader(report)
+	games := make(map[int64]bool)
+	for _, match := range (*report).Matches {
+		games[match.GameId] = true
+	}
+
+	filter := func(action manifest.Action) bool {
+		if !action.IsFileAction {
+			return action.GameAction == "add" && games[action.Game.Id]
+		}
+
+		fileAction := (*action.FileActionPtr)
+		_, ok := d.Paths[getLocalFileKey(action.Game.Id, fileAction.Kind, fileAction.Name)]
+		return ok && fileAction.Action == "add"
+	}
+
+	return a.WithFilter(filter).ProcessGameActions(m, actions, s, d)
+}
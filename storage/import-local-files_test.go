@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"errors"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func applyTestManifest(t *testing.T, s Storage, m *manifest.Manifest) {
+	err := ApplyManifest(m, s, Source{Type: "fs"}, false)
+	if err != nil {
+		t.Fatalf("Could not apply the manifest: %s", err.Error())
+	}
+}
+
+func writeLocalTestFile(t *testing.T, path string, content string) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err == nil {
+		err = ioutil.WriteFile(path, []byte(content), 0644)
+	}
+	if err != nil {
+		t.Fatalf("Could not write local file %s: %s", path, err.Error())
+	}
+}
+
+//Writes local files in a layout of their own: a match, a corrupted file, a file of another size and an unrelated file
+func writeLocalTestFiles(t *testing.T) string {
+	dir := t.TempDir()
+	writeLocalTestFile(t, filepath.Join(dir, "first", "setup_first.exe"), testFileContents["setup_first.exe"])
+	corrupted := []byte(testFileContents["first.sh"])
+	corrupted[0] = 'F'
+	writeLocalTestFile(t, filepath.Join(dir, "first", "linux", "first.sh"), string(corrupted))
+	writeLocalTestFile(t, filepath.Join(dir, "first_manual.pdf"), "truncated")
+	writeLocalTestFile(t, filepath.Join(dir, "notes.txt"), "unrelated")
+	return dir
+}
+
+func getLocalFileProblems(report *LocalFilesReport) map[string]string {
+	problems := map[string]string{}
+	for _, mismatch := range (*report).Mismatches {
+		problems[mismatch.Name] = mismatch.Problem
+	}
+	return problems
+}
+
+func TestFindLocalFiles(t *testing.T) {
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+	dir := writeLocalTestFiles(t)
+
+	report, err := FindLocalFiles(s, dir, 2)
+	if err != nil {
+		t.Fatalf("Finding the local files failed: %s", err.Error())
+	}
+
+	if report.FilesScanned != 4 || len(report.Errors) != 0 {
+		t.Errorf("Expected 4 files to be scanned without errors and got %d files and errors %v", report.FilesScanned, report.Errors)
+	}
+	if len(report.Matches) != 1 || report.Matches[0].Name != "setup_first.exe" || report.Matches[0].Path != filepath.Join(dir, "first", "setup_first.exe") {
+		t.Errorf("Expected the windows installer of the first game to match and got %v", report.Matches)
+	}
+	if report.MatchedSize != int64(len(testFileContents["setup_first.exe"])) {
+		t.Errorf("Expected the matched size to be the size of the match and got %d", report.MatchedSize)
+	}
+	problems := getLocalFileProblems(report)
+	if len(problems) != 2 || problems["first.sh"] != "checksum" || problems["first_manual.pdf"] != "size" {
+		t.Errorf("Expected a checksum and a size mismatch and got %v", problems)
+	}
+}
+
+func TestFindLocalFilesWithoutPendingActions(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestStorage(t, s, getTestStorageManifest())
+
+	_, err := FindLocalFiles(s, t.TempDir(), 1)
+	if err == nil {
+		t.Errorf("Expected local files not to be searched without pending actions")
+	}
+}
+
+func TestFindLocalFilesMissingDirectory(t *testing.T) {
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+
+	_, err := FindLocalFiles(s, filepath.Join(t.TempDir(), "missing"), 1)
+	if err == nil {
+		t.Errorf("Expected an error when the directory to search cannot be read")
+	}
+}
+
+func TestLocalFilesWalkerSkipsUnreadableEntries(t *testing.T) {
+	dir := writeLocalTestFiles(t)
+	report := LocalFilesReport{}
+	w := localFilesWalker{dir: dir, pending: map[string][]manifest.FileInfo{}, report: &report, candidates: []localFileCandidate{}}
+	readErr := errors.New("permission denied")
+
+	subDir := filepath.Join(dir, "first")
+	info, err := os.Stat(subDir)
+	if err != nil {
+		t.Fatalf("Could not stat %s: %s", subDir, err.Error())
+	}
+	if w.visit(subDir, fs.FileInfoToDirEntry(info), readErr) != fs.SkipDir {
+		t.Errorf("Expected an unreadable directory to be skipped")
+	}
+	if w.visit(filepath.Join(dir, "notes.txt"), nil, readErr) != nil {
+		t.Errorf("Expected an unreadable file not to stop the walk")
+	}
+	if len(report.Errors) != 2 {
+		t.Errorf("Expected the unreadable entries to be reported and got %v", report.Errors)
+	}
+	if w.visit(dir, nil, readErr) == nil {
+		t.Errorf("Expected an unreadable root directory to stop the walk")
+	}
+}
+
+func TestFindLocalFilesUnreadableDirectory(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("Directories without permissions are still readable by root")
+	}
+
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+	dir := writeLocalTestFiles(t)
+	locked := filepath.Join(dir, "first", "linux")
+	os.Chmod(locked, 0)
+	defer os.Chmod(locked, 0755)
+
+	report, err := FindLocalFiles(s, dir, 1)
+	if err != nil {
+		t.Fatalf("Expected the search to go on past an unreadable directory and got: %s", err.Error())
+	}
+	if len(report.Errors) != 1 || len(report.Matches) != 1 {
+		t.Errorf("Expected the unreadable directory to be reported and the match to be found and got %v", *report)
+	}
+}
+
+func TestImportLocalFiles(t *testing.T) {
+	s := newTestFileSystem(t)
+	applyTestManifest(t, s, getTestStorageManifest())
+	dir := writeLocalTestFiles(t)
+
+	report, err := FindLocalFiles(s, dir, 2)
+	if err != nil {
+		t.Fatalf("Finding the local files failed: %s", err.Error())
+	}
+
+	sort := manifest.NewActionIteratorSort([]int64{}, "none", true)
+	proc := GetActionsProcessor(2, 0, -1, sort, logging.CreateSource("error"))
+	errs := ImportLocalFiles(s, report, proc)
+	if len(errs) > 0 {
+		t.Fatalf("Importing the local files failed: %v", errs)
+	}
+
+	imported := manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "installer", Name: "setup_first.exe"}
+	if readTestFile(t, s, imported) != testFileContents["setup_first.exe"] {
+		t.Errorf("Expected the matched local file to be uploaded in the storage")
+	}
+
+	actions, err := s.LoadActions()
+	if err != nil {
+		t.Fatalf("Could not load the actions: %s", err.Error())
+	}
+	if _, ok := (*actions)[1].InstallerActions["setup_first.exe"]; ok {
+		t.Errorf("Expected the action of the imported file to be done")
+	}
+	if _, ok := (*actions)[1].InstallerActions["first.sh"]; !ok {
+		t.Errorf("Expected the actions of the mismatched files to be left pending")
+	}
+	if _, ok := (*actions)[2]; !ok {
+		t.Errorf("Expected the actions of the games without local files to be left pending")
+	}
+}
@@ -0,0 +1,162 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+)
+
+//Number of journal entries after which the manifest and actions are stored and the journal is cleared
+const journalCompactionInterval = 50
+
+//Storages implementing this interface get the completed actions appended to a journal while actions execute
+//instead of having their whole manifest and actions rewritten after each file
+type JournalStorage interface {
+	AppendJournal(entry manifest.JournalEntry) error
+	LoadJournal() ([]manifest.JournalEntry, error)
+	RemoveJournal() error
+}
+
+//Parses a journal with one entry per line.
+//An unparseable last line is the trace of a crash during an append and is ignored.
+func parseJournal(bs []byte) ([]manifest.JournalEntry, error) {
+	entries := []manifest.JournalEntry{}
+	lines := bytes.Split(bytes.TrimRight(bs, "\n"), []byte("\n"))
+	for idx, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry manifest.JournalEntry
+		err := json.Unmarshal(line, &entry)
+		if err != nil {
+			if idx == len(lines)-1 {
+				break
+			}
+			msg := fmt.Sprintf("parseJournal(...) -> Line %d of the journal is corrupted: %s", idx+1, err.Error())
+			return entries, errors.New(msg)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func getJournalStorage(s Storage) (JournalStorage, bool) {
+	//Storages that can already update a single game do not need a journal
+	if _, ok := s.(GameStorage); ok {
+		return nil, false
+	}
+
+	js, ok := s.(JournalStorage)
+	return js, ok
+}
+
+//Stores the manifest and actions the journal entries were applied on and clears the journal.
+//The journal is only removed once both are stored so that a crash in between is recovered from by replaying it.
+func compactJournal(s Storage, js JournalStorage, m *manifest.Manifest, a *manifest.GameActions) error {
+	err := s.StoreManifest(m)
+	if err != nil {
+		return err
+	}
+
+	err = s.StoreActions(a)
+	if err != nil {
+		return err
+	}
+
+	return js.RemoveJournal()
+}
+
+//Applies the entries of a journal left behind by an interrupted execution to the stored manifest and actions
+func RecoverJournal(s Storage) error {
+	js, ok := getJournalStorage(s)
+	if !ok {
+		return nil
+	}
+
+	entries, err := js.LoadJournal()
+	if err != nil {
+		msg := fmt.Sprintf("RecoverJournal(...) -> Error loading the journal: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	hasManifest, err := s.HasManifest()
+	if err != nil {
+		return err
+	}
+
+	if !hasManifest {
+		return js.RemoveJournal()
+	}
+
+	m, err := s.LoadManifest()
+	if err != nil {
+		return err
+	}
+
+	hasActions, err := s.HasActions()
+	if err != nil {
+		return err
+	}
+
+	var a *manifest.GameActions
+	if hasActions {
+		a, err = s.LoadActions()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = manifest.ReplayJournal(m, a, entries)
+	if err != nil {
+		msg := fmt.Sprintf("RecoverJournal(...) -> %s", err.Error())
+		return errors.New(msg)
+	}
+
+	err = s.StoreManifest(m)
+	if err != nil {
+		return err
+	}
+
+	if hasActions {
+		err = s.StoreActions(a)
+		if err != nil {
+			return err
+		}
+	}
+
+	return js.RemoveJournal()
+}
+
+//Loads the manifest with the entries of the journal applied to it, without storing anything, so that it can be
+//read while an execution is still appending to the journal
+func LoadManifestWithJournal(s Storage) (*manifest.Manifest, error) {
+	m, err := s.LoadManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	js, ok := getJournalStorage(s)
+	if !ok {
+		return m, nil
+	}
+
+	entries, err := js.LoadJournal()
+	if err != nil {
+		msg := fmt.Sprintf("LoadManifestWithJournal(...) -> Error loading the journal: %s", err.Error())
+		return nil, errors.New(msg)
+	}
+
+	err = manifest.ReplayJournal(m, nil, entries)
+	if err != nil {
+		msg := fmt.Sprintf("LoadManifestWithJournal(...) -> %s", err.Error())
+		return nil, errors.New(msg)
+	}
+	return m, nil
+}
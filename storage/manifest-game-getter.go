@@ -96,6 +96,8 @@ func getStorageGames(s Storage, done <-chan struct{}, gameIdsCh <-chan manifest.
 				for _, file := range files {
 					if file.Kind == "installer" {
 						game.Installers = append(game.Installers, manifest.ManifestGameInstaller{Name: file.Name})
+					} else if file.Kind == "depot" {
+						game.Depots = append(game.Depots, manifest.ManifestGameDepot{Name: file.Name})
 					} else {
 						game.Extras = append(game.Extras, manifest.ManifestGameExtra{Name: file.Name})
 					}
@@ -192,6 +194,34 @@ func addStorageGamesFilesMetadata(s Storage, concurrency int, done <-chan struct
 						}
 					}
 
+					for idx, depot := range res.Game.Depots {
+						file := manifest.FileInfo{
+							Game: manifest.GameInfo{Id: res.Game.Id},
+							Kind: "depot",
+							Name: depot.Name,
+						}
+						
+						handle, size, err := s.DownloadFile(file)
+						if err != nil {
+							res.Errors = append(res.Errors, err)
+							gamesCh <- res
+							return
+						}
+
+						h := md5.New()
+						io.Copy(h, handle)
+						depot.Checksum = hex.EncodeToString(h.Sum(nil))
+						depot.VerifiedSize = size
+
+						res.Game.Depots[idx] = depot
+
+						select {
+						case <-done:
+							return
+						default:
+						}
+					}
+
 					gamesCh <- res
 				case <-done:
 					return
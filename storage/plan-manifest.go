@@ -8,6 +8,11 @@ func PlanManifest(m *manifest.Manifest, s Storage, checksumValidation string) (*
 	var storedManifest *manifest.Manifest
 	var loadManifestErr error
 
+	err := RecoverJournal(s)
+	if err != nil {
+		return nil, err
+	}
+
 	hasManifest, hasManifestErr := s.HasManifest()
 	if hasManifestErr != nil {
 		return nil, hasManifestErr
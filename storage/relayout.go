@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+//Moves the installers of a game to the directories the layout sorts them in.
+//Installers the manifest doesn't know are left where they are, except in the flat layout.
+func relayoutInstallers(instDir string, fileDirectories string, installers map[string]manifest.ManifestGameInstaller) []error {
+	errs := []error{}
+	paths := []string{}
+	err := filepath.WalkDir(instDir, func(walkPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() {
+			paths = append(paths, walkPath)
+		}
+		return nil
+	})
+	if err != nil {
+		return []error{err}
+	}
+
+	for _, fPath := range paths {
+		name := filepath.Base(fPath)
+		target := path.Join(instDir, name)
+		if fileDirectories == FileDirectoriesOsLanguage {
+			installer, ok := installers[name]
+			if !ok {
+				continue
+			}
+			subDir := getInstallerSubDirectory(manifest.FileInfo{Os: installer.Os, Languages: installer.Languages})
+			target = path.Join(instDir, subDir, name)
+		}
+
+		if target == fPath {
+			continue
+		}
+		if _, err := os.Stat(target); err == nil {
+			errs = append(errs, errors.New(fmt.Sprintf("relayoutInstallers(...) -> Cannot move %s to %s which already exists", fPath, target)))
+			continue
+		}
+
+		err = os.MkdirAll(path.Dir(target), 0755)
+		if err == nil {
+			err = os.Rename(fPath, target)
+		}
+		if err != nil {
+			errs = append(errs, errors.New(fmt.Sprintf("relayoutInstallers(...) -> Error occured while moving %s: %s", fPath, err.Error())))
+		}
+	}
+
+	removeEmptyDirectories(instDir, true)
+	return errs
+}
+
+//Moves the games and files of a file system storage in place so that they match the given layout.
+//The layout is updated as each game is moved, so that the storage stays usable if the command is interrupted.
+func RelayoutFileSystem(f FileSystem, gameDirectories string, fileDirectories string) []error {
+	target, err := NewFileSystemLayout(gameDirectories, fileDirectories)
+	if err != nil {
+		return []error{err}
+	}
+
+	err = RecoverJournal(f)
+	if err != nil {
+		return []error{err}
+	}
+
+	hasActions, err := f.HasActions()
+	if err != nil {
+		return []error{err}
+	}
+	if hasActions {
+		return []error{errors.New("RelayoutFileSystem(...) -> Unfinished actions are pending in the storage. Aborting.")}
+	}
+
+	games := make(map[int64]manifest.ManifestGame)
+	hasManifest, err := f.HasManifest()
+	if err != nil {
+		return []error{err}
+	}
+	if hasManifest {
+		m, err := f.LoadManifest()
+		if err != nil {
+			return []error{err}
+		}
+		for _, game := range (*m).Games {
+			games[game.Id] = game
+		}
+	}
+
+	ids, err := f.GetGameIds()
+	if err != nil {
+		return []error{err}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	err = f.withLayout(func(layout *FileSystemLayout) bool {
+		(*layout).GameDirectories = gameDirectories
+		(*layout).FileDirectories = fileDirectories
+		return true
+	})
+	if err != nil {
+		return []error{err}
+	}
+
+	errs := []error{}
+	for _, id := range ids {
+		game, known := games[id]
+		gameInfo := manifest.GameInfo{Id: id}
+		if known {
+			gameInfo = manifest.GameInfo{Id: id, Slug: game.Slug, Title: game.Title}
+		}
+
+		oldDir, err := f.getGameDir(id)
+		if err != nil {
+			return append(errs, err)
+		}
+
+		name := target.assignGameDirectory(gameInfo)
+		newDir := path.Join(f.Path, name)
+		if newDir != oldDir {
+			if _, err := os.Stat(newDir); err == nil {
+				errs = append(errs, errors.New(fmt.Sprintf("RelayoutFileSystem(...) -> Cannot move game %d to %s which already exists", id, newDir)))
+				name = path.Base(oldDir)
+				newDir = oldDir
+				target.Games[id] = name
+			} else {
+				err = os.Rename(oldDir, newDir)
+				if err != nil {
+					return append(errs, errors.New(fmt.Sprintf("RelayoutFileSystem(...) -> Error occured while moving game %d: %s", id, err.Error())))
+				}
+			}
+		}
+
+		err = f.withLayout(func(layout *FileSystemLayout) bool {
+			if name == strconv.FormatInt(id, 10) {
+				delete((*layout).Games, id)
+			} else {
+				(*layout).Games[id] = name
+			}
+			return true
+		})
+		if err != nil {
+			return append(errs, err)
+		}
+
+		installers := make(map[string]manifest.ManifestGameInstaller)
+		for _, installer := range game.Installers {
+			installers[installer.Name] = installer
+		}
+		errs = append(errs, relayoutInstallers(path.Join(newDir, "installers"), fileDirectories, installers)...)
+	}
+
+	return errs
+}
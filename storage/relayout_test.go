@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"gogcli/logging"
+	"gogcli/manifest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//Expected location of each file of the test storage, relative to the storage's root, in the slug and os-language layout
+var testSortedFilePaths = map[string]string{
+	"setup_first.exe":  "first_game/installers/windows/english/setup_first.exe",
+	"first.sh":         "first_game/installers/linux/english/first.sh",
+	"first_manual.pdf": "first_game/extras/first_manual.pdf",
+	"setup_second.exe": "second_game/installers/windows/english/setup_second.exe",
+	"unknown.exe":      "first_game/installers/unknown.exe",
+	"third.pdf":        "3/extras/third.pdf",
+}
+
+//Expected location of each file of the test storage, relative to the storage's root, in the default layout
+var testDefaultFilePaths = map[string]string{
+	"setup_first.exe":  "1/installers/setup_first.exe",
+	"first.sh":         "1/installers/first.sh",
+	"first_manual.pdf": "1/extras/first_manual.pdf",
+	"setup_second.exe": "2/installers/setup_second.exe",
+	"unknown.exe":      "1/installers/unknown.exe",
+	"third.pdf":        "3/extras/third.pdf",
+}
+
+//Populates the storage with the test manifest, an installer the manifest doesn't know and a game absent from the manifest
+func populateTestRelayoutStorage(t *testing.T, f FileSystem) {
+	populateTestStorage(t, f, getTestStorageManifest())
+	uploadTestFile(t, f, manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "installer", Name: "unknown.exe"}, "unknown installer")
+	uploadTestFile(t, f, manifest.FileInfo{Game: manifest.GameInfo{Id: 3}, Kind: "extra", Name: "third.pdf"}, "third game manual")
+}
+
+func checkTestFilePaths(t *testing.T, f FileSystem, paths map[string]string) {
+	for name, relPath := range paths {
+		if _, err := os.Stat(filepath.Join(f.Path, relPath)); err != nil {
+			t.Errorf("Expected %s to be at %s", name, relPath)
+		}
+	}
+
+	ids, err := f.GetGameIds()
+	if err != nil {
+		t.Fatalf("Could not list the games: %s", err.Error())
+	}
+	if len(ids) != 3 {
+		t.Errorf("Expected the 3 games to be listed and got %v", ids)
+	}
+
+	for _, id := range ids {
+		files, err := f.GetGameFiles(id)
+		if err != nil {
+			t.Errorf("Could not list the files of game %d: %s", id, err.Error())
+			continue
+		}
+		for _, file := range files {
+			content := readTestFile(t, f, file)
+			if expected, ok := testFileContents[file.Name]; ok && content != expected {
+				t.Errorf("Expected %s to keep its content", file.Name)
+			}
+		}
+	}
+}
+
+func TestRelayoutFileSystemRoundTrip(t *testing.T) {
+	f := newTestFileSystem(t)
+	populateTestRelayoutStorage(t, f)
+
+	errs := RelayoutFileSystem(f, GameDirectoriesSlug, FileDirectoriesOsLanguage)
+	if len(errs) > 0 {
+		t.Fatalf("Relayout to the slug and os-language layout failed: %v", errs)
+	}
+	checkTestFilePaths(t, f, testSortedFilePaths)
+	layout, _ := f.GetLayout()
+	if layout.GameDirectories != GameDirectoriesSlug || layout.FileDirectories != FileDirectoriesOsLanguage || len(layout.Games) != 2 {
+		t.Errorf("Expected the layout to be stored with the directories of the games and got %v", layout)
+	}
+
+	//Reopening the storage reads the stored layout
+	reopened := GetFileSystem(f.Path, logging.CreateSource("error"), "")
+	checkTestFilePaths(t, reopened, testSortedFilePaths)
+
+	errs = RelayoutFileSystem(f, GameDirectoriesId, FileDirectoriesFlat)
+	if len(errs) > 0 {
+		t.Fatalf("Relayout back to the default layout failed: %v", errs)
+	}
+	checkTestFilePaths(t, f, testDefaultFilePaths)
+	if _, err := os.Stat(filepath.Join(f.Path, layoutFileName)); !os.IsNotExist(err) {
+		t.Errorf("Expected the layout file to be removed with the default layout")
+	}
+	if _, err := os.Stat(filepath.Join(f.Path, "1", "installers", "windows")); !os.IsNotExist(err) {
+		t.Errorf("Expected the empty sub-directories of the installers to be removed")
+	}
+}
+
+func TestRelayoutFileSystemRerun(t *testing.T) {
+	f := newTestFileSystem(t)
+	populateTestRelayoutStorage(t, f)
+
+	for i := 0; i < 2; i++ {
+		errs := RelayoutFileSystem(f, GameDirectoriesSlug, FileDirectoriesOsLanguage)
+		if len(errs) > 0 {
+			t.Fatalf("Relayout %d failed: %v", i+1, errs)
+		}
+	}
+	checkTestFilePaths(t, f, testSortedFilePaths)
+}
+
+func TestRelayoutFileSystemInterrupted(t *testing.T) {
+	f := newTestFileSystem(t)
+	populateTestRelayoutStorage(t, f)
+
+	//Interrupted while the installers of the first game were being sorted: the first game was moved and the layout
+	//stored, but its installers and the second game are where they were
+	err := os.Rename(filepath.Join(f.Path, "1"), filepath.Join(f.Path, "first_game"))
+	if err != nil {
+		t.Fatalf("Could not move the first game: %s", err.Error())
+	}
+	err = os.MkdirAll(filepath.Join(f.Path, "first_game", "installers", "windows", "english"), 0755)
+	if err == nil {
+		err = os.Rename(filepath.Join(f.Path, "first_game", "installers", "setup_first.exe"), filepath.Join(f.Path, testSortedFilePaths["setup_first.exe"]))
+	}
+	if err != nil {
+		t.Fatalf("Could not sort the first installer: %s", err.Error())
+	}
+	err = f.withLayout(func(layout *FileSystemLayout) bool {
+		(*layout).GameDirectories = GameDirectoriesSlug
+		(*layout).FileDirectories = FileDirectoriesOsLanguage
+		(*layout).Games[1] = "first_game"
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Could not store the layout: %s", err.Error())
+	}
+
+	//The storage stays usable in between
+	halfway := map[string]string{
+		"setup_first.exe":  testSortedFilePaths["setup_first.exe"],
+		"first.sh":         "first_game/installers/first.sh",
+		"first_manual.pdf": testSortedFilePaths["first_manual.pdf"],
+		"setup_second.exe": testDefaultFilePaths["setup_second.exe"],
+		"unknown.exe":      testSortedFilePaths["unknown.exe"],
+		"third.pdf":        testSortedFilePaths["third.pdf"],
+	}
+	checkTestFilePaths(t, f, halfway)
+
+	errs := RelayoutFileSystem(f, GameDirectoriesSlug, FileDirectoriesOsLanguage)
+	if len(errs) > 0 {
+		t.Fatalf("Resuming the relayout failed: %v", errs)
+	}
+	checkTestFilePaths(t, f, testSortedFilePaths)
+}
+
+func TestRelayoutFileSystemTakenDirectory(t *testing.T) {
+	f := newTestFileSystem(t)
+	populateTestRelayoutStorage(t, f)
+	err := os.Mkdir(filepath.Join(f.Path, "second_game"), 0755)
+	if err != nil {
+		t.Fatalf("Could not create the directory: %s", err.Error())
+	}
+
+	errs := RelayoutFileSystem(f, GameDirectoriesSlug, FileDirectoriesFlat)
+	if len(errs) != 1 {
+		t.Errorf("Expected an error for the game whose directory is taken and got %v", errs)
+	}
+
+	paths := map[string]string{
+		"setup_first.exe":  "first_game/installers/setup_first.exe",
+		"setup_second.exe": testDefaultFilePaths["setup_second.exe"],
+		"third.pdf":        testDefaultFilePaths["third.pdf"],
+	}
+	checkTestFilePaths(t, f, paths)
+}
+
+func TestRelayoutFileSystemPreconditions(t *testing.T) {
+	f := newTestFileSystem(t)
+	populateTestRelayoutStorage(t, f)
+
+	errs := RelayoutFileSystem(f, "name", FileDirectoriesFlat)
+	if len(errs) != 1 {
+		t.Errorf("Expected an unknown layout to be rejected")
+	}
+
+	actions := manifest.GameActions{
+		2: manifest.GameAction{Id: 2, Action: "remove"},
+	}
+	f.StoreActions(&actions)
+	errs = RelayoutFileSystem(f, GameDirectoriesSlug, FileDirectoriesFlat)
+	if len(errs) != 1 {
+		t.Errorf("Expected the relayout to abort with pending actions")
+	}
+	checkTestFilePaths(t, f, testDefaultFilePaths)
+}
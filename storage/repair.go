@@ -5,6 +5,22 @@ import (
 )
 
 func Repair(authMan *manifest.Manifest, storeMan *manifest.Manifest, s Storage, src Source, verifyChecksum bool) error {
+	//The manifest and actions are replaced, so whatever the journal had left to apply to them is moot
+	if js, ok := getJournalStorage(s); ok {
+		err := js.RemoveJournal()
+		if err != nil {
+			return err
+		}
+	}
+
+	//The pending uploads belong to the actions that are replaced
+	if rs, ok := s.(ResumableUploadStorage); ok {
+		err := rs.RemoveIncompleteUploads()
+		if err != nil {
+			return err
+		}
+	}
+
 	hasActions, actErr := s.HasActions()
 	if actErr != nil {
 		return actErr
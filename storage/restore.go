@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+type RestoreSelection struct {
+	Games      []string
+	Oses       []string
+	Languages  []string
+	Installers bool
+	Extras     bool
+	Depots     bool
+}
+
+type RestoreReport struct {
+	Restored     []string
+	Skipped      []string
+	RestoredSize int64
+}
+
+//Returns the files of the games of the manifest that the selection matches
+func SelectRestoreFiles(m *manifest.Manifest, selection RestoreSelection) ([]manifest.FileInfo, error) {
+	selected := manifest.NewEmptyManifest(manifest.ManifestFilter{})
+	noSkip := func(u string) bool { return false }
+	for _, term := range selection.Games {
+		found := false
+		for _, game := range (*m).Games {
+			if !game.IsDesignatedBy(term) {
+				continue
+			}
+			found = true
+			game.TrimInstallers(selection.Oses, selection.Languages, selection.Installers, noSkip)
+			game.TrimExtras([]string{}, selection.Extras, noSkip)
+			game.TrimDepots(selection.Oses, selection.Languages, selection.Depots, noSkip)
+			(*selected).Games = append((*selected).Games, game)
+		}
+		if !found {
+			return nil, errors.New(fmt.Sprintf("SelectRestoreFiles(...) -> No game in the manifest has %s as its id, slug or title", term))
+		}
+	}
+
+	files := []manifest.FileInfo{}
+	if len((*selected).Games) == 0 {
+		return files, nil
+	}
+
+	iterator := manifest.NewManifestFileInterator(selected)
+	for iterator.HasMore() {
+		file, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+//Files are restored under the slug of their game, with extras and depots in their own directories
+func GetRestorePath(dest string, file manifest.FileInfo) string {
+	gameDir := file.Game.Slug
+	if gameDir == "" {
+		gameDir = strconv.FormatInt(file.Game.Id, 10)
+	}
+
+	if file.Kind == "extra" {
+		return filepath.Join(dest, gameDir, "extras", file.Name)
+	} else if file.Kind == "depot" {
+		return filepath.Join(dest, gameDir, "depots", file.Name)
+	}
+	return filepath.Join(dest, gameDir, file.Name)
+}
+
+func checkRestoredFile(file manifest.FileInfo, size int64, checksum string) error {
+	if file.Size > 0 && size != file.Size {
+		return errors.New(fmt.Sprintf("checkRestoredFile(...) -> File %s has size %d instead of %d", file.Name, size, file.Size))
+	}
+	if file.Checksum != "" && checksum != file.Checksum {
+		return errors.New(fmt.Sprintf("checkRestoredFile(...) -> File %s has checksum %s instead of %s", file.Name, checksum, file.Checksum))
+	}
+	return nil
+}
+
+//Opens the partial file of an interrupted restore to append to it if the downloader can resume, or starts over otherwise
+func openRestorePart(d Downloader, file manifest.FileInfo, partPath string) (*os.File, hash.Hash, io.ReadCloser, bool, error) {
+	h := md5.New()
+	if info, err := os.Stat(partPath); err == nil && info.Size() > 0 && (file.Size == 0 || info.Size() < file.Size) {
+		if rangeDownloader, ok := d.(RangeDownloader); ok {
+			part, err := os.OpenFile(partPath, os.O_RDWR, 0644)
+			if err != nil {
+				return nil, nil, nil, false, err
+			}
+
+			_, err = io.Copy(h, part)
+			if err == nil {
+				var handle io.ReadCloser
+				handle, _, _, err = rangeDownloader.DownloadFrom(file, info.Size())
+				if err == nil {
+					return part, h, handle, true, nil
+				}
+			}
+			part.Close()
+			h.Reset()
+		}
+	}
+
+	handle, _, _, err := d.Download(file)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	part, err := os.Create(partPath)
+	if err != nil {
+		handle.Close()
+		return nil, nil, nil, false, err
+	}
+	return part, h, handle, false, nil
+}
+
+func restoreFile(d Downloader, file manifest.FileInfo, dest string) (bool, error) {
+	target := GetRestorePath(dest, file)
+	if info, err := os.Stat(target); err == nil {
+		checksum, err := hashLocalFile(target)
+		if err == nil && checkRestoredFile(file, info.Size(), checksum) == nil {
+			return false, nil
+		}
+	}
+
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return false, err
+	}
+
+	partPath := target + ".part"
+	part, h, handle, resumed, err := openRestorePart(d, file, partPath)
+	if err != nil {
+		return false, err
+	}
+	defer handle.Close()
+
+	_, err = io.Copy(io.MultiWriter(part, h), handle)
+	closeErr := part.Close()
+	if err != nil {
+		return false, err
+	}
+	if closeErr != nil {
+		return false, closeErr
+	}
+
+	info, err := os.Stat(partPath)
+	if err != nil {
+		return false, err
+	}
+	err = checkRestoredFile(file, info.Size(), hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		os.Remove(partPath)
+		//The partial file may have been corrupted when the restore was interrupted
+		if resumed {
+			return restoreFile(d, file, dest)
+		}
+		return false, err
+	}
+	return true, os.Rename(partPath, target)
+}
+
+//Downloads the files from the storage into the destination directory, checking their checksum on the way.
+//Files already restored are skipped and partially restored files are resumed if the storage's downloader can.
+func RestoreFiles(d Downloader, files []manifest.FileInfo, dest string, concurrency int) (*RestoreReport, []error) {
+	report := RestoreReport{
+		Restored: []string{},
+		Skipped:  []string{},
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := []error{}
+	filesCh := make(chan manifest.FileInfo)
+	for idx := 0; idx < concurrency; idx++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range filesCh {
+				restored, err := restoreFile(d, file, dest)
+
+				mu.Lock()
+				if err != nil {
+					msg := fmt.Sprintf("RestoreFiles(...) -> Error occured while restoring file %s of game %d: %s", file.Name, file.Game.Id, err.Error())
+					errs = append(errs, errors.New(msg))
+				} else if restored {
+					report.Restored = append(report.Restored, GetRestorePath(dest, file))
+					report.RestoredSize += file.Size
+				} else {
+					report.Skipped = append(report.Skipped, GetRestorePath(dest, file))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, file := range files {
+		filesCh <- file
+	}
+	close(filesCh)
+	wg.Wait()
+
+	return &report, errs
+}
+
+//Writes the files one after the other, in the order of the manifest, for piping into other tools.
+//A file that fails its checksum has already been written when the mismatch is detected, so writing stops there.
+func RestoreFilesToWriter(d Downloader, files []manifest.FileInfo, w io.Writer) error {
+	for _, file := range files {
+		handle, _, _, err := d.Download(file)
+		if err != nil {
+			return err
+		}
+
+		h := md5.New()
+		size, err := io.Copy(io.MultiWriter(w, h), handle)
+		handle.Close()
+		if err != nil {
+			return err
+		}
+
+		err = checkRestoredFile(file, size, hex.EncodeToString(h.Sum(nil)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
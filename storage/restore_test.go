@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"bytes"
+	"gogcli/manifest"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+//Downloader that cannot start a download at an offset
+type wholeFileTestDownloader struct {
+	d Downloader
+}
+
+func (d wholeFileTestDownloader) Download(file manifest.FileInfo) (io.ReadCloser, int64, string, error) {
+	return d.d.Download(file)
+}
+
+func getSelectedNames(files []manifest.FileInfo) []string {
+	names := []string{}
+	for _, file := range files {
+		names = append(names, file.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newTestRestoreStorage(t *testing.T) (*manifest.Manifest, FileSystemDownloader) {
+	f := newTestFileSystem(t)
+	m := getTestStorageManifest()
+	populateTestStorage(t, f, m)
+	return m, FileSystemDownloader{f}
+}
+
+func checkRestoredTestFiles(t *testing.T, dest string, files []manifest.FileInfo) {
+	for _, file := range files {
+		content, err := ioutil.ReadFile(GetRestorePath(dest, file))
+		if err != nil || string(content) != testFileContents[file.Name] {
+			t.Errorf("Expected %s to be restored with its content", file.Name)
+		}
+		if _, err := os.Stat(GetRestorePath(dest, file) + ".part"); !os.IsNotExist(err) {
+			t.Errorf("Expected no partial file to be left for %s", file.Name)
+		}
+	}
+}
+
+func TestSelectRestoreFiles(t *testing.T) {
+	m := getTestStorageManifest()
+
+	files, err := SelectRestoreFiles(m, RestoreSelection{Games: []string{"1", "second_game"}, Installers: true, Extras: true})
+	if err != nil {
+		t.Fatalf("Selecting the files failed: %s", err.Error())
+	}
+	names := getSelectedNames(files)
+	if len(names) != 4 {
+		t.Errorf("Expected all the files of the games designated by id and slug to be selected and got %v", names)
+	}
+
+	files, err = SelectRestoreFiles(m, RestoreSelection{Games: []string{"first game"}, Oses: []string{"linux"}, Installers: true, Extras: false})
+	if err != nil {
+		t.Fatalf("Selecting the files failed: %s", err.Error())
+	}
+	names = getSelectedNames(files)
+	if len(names) != 1 || names[0] != "first.sh" {
+		t.Errorf("Expected only the linux installer of the game designated by title to be selected and got %v", names)
+	}
+	if files[0].Size != int64(len(testFileContents["first.sh"])) || files[0].Checksum == "" {
+		t.Errorf("Expected the selected files to carry the size and checksum of the manifest")
+	}
+
+	files, err = SelectRestoreFiles(m, RestoreSelection{Games: []string{"1"}, Installers: false, Extras: true})
+	names = getSelectedNames(files)
+	if err != nil || len(names) != 1 || names[0] != "first_manual.pdf" {
+		t.Errorf("Expected only the extras to be selected and got %v", names)
+	}
+
+	_, err = SelectRestoreFiles(m, RestoreSelection{Games: []string{"1", "missing_game"}, Installers: true})
+	if err == nil {
+		t.Errorf("Expected an error for a term that designates no game")
+	}
+}
+
+func TestGetRestorePath(t *testing.T) {
+	game := manifest.GameInfo{Id: 1, Slug: "first_game"}
+	expectations := map[string]manifest.FileInfo{
+		filepath.Join("out", "first_game", "setup.exe"):         manifest.FileInfo{Game: game, Kind: "installer", Name: "setup.exe"},
+		filepath.Join("out", "first_game", "extras", "man.pdf"): manifest.FileInfo{Game: game, Kind: "extra", Name: "man.pdf"},
+		filepath.Join("out", "first_game", "depots", "depot"):   manifest.FileInfo{Game: game, Kind: "depot", Name: "depot"},
+		filepath.Join("out", "2", "setup.exe"):                  manifest.FileInfo{Game: manifest.GameInfo{Id: 2}, Kind: "installer", Name: "setup.exe"},
+	}
+	for expected, file := range expectations {
+		if restorePath := GetRestorePath("out", file); restorePath != expected {
+			t.Errorf("Expected %s to be restored at %s and got %s", file.Name, expected, restorePath)
+		}
+	}
+}
+
+func TestRestoreFiles(t *testing.T) {
+	m, d := newTestRestoreStorage(t)
+	files, _ := SelectRestoreFiles(m, RestoreSelection{Games: []string{"1", "2"}, Installers: true, Extras: true})
+	dest := t.TempDir()
+
+	report, errs := RestoreFiles(d, files, dest, 2)
+	if len(errs) > 0 {
+		t.Fatalf("Restoring the files failed: %v", errs)
+	}
+	if len(report.Restored) != 4 || len(report.Skipped) != 0 {
+		t.Errorf("Expected the 4 files to be restored and got %v", *report)
+	}
+	checkRestoredTestFiles(t, dest, files)
+
+	report, errs = RestoreFiles(d, files, dest, 2)
+	if len(errs) > 0 || len(report.Restored) != 0 || len(report.Skipped) != 4 || report.RestoredSize != 0 {
+		t.Errorf("Expected files already restored to be skipped and got %v with errors %v", *report, errs)
+	}
+}
+
+func TestRestoreFilesResumes(t *testing.T) {
+	m, d := newTestRestoreStorage(t)
+	files, _ := SelectRestoreFiles(m, RestoreSelection{Games: []string{"1"}, Oses: []string{"windows"}, Installers: true})
+	file := files[0]
+
+	downloaders := map[string]Downloader{"range": d, "whole": wholeFileTestDownloader{d}}
+	for name, downloader := range downloaders {
+		for _, part := range []string{testFileContents[file.Name][:5], "corru"} {
+			dest := t.TempDir()
+			partPath := GetRestorePath(dest, file) + ".part"
+			writeLocalTestFile(t, partPath, part)
+
+			report, errs := RestoreFiles(downloader, files, dest, 1)
+			if len(errs) > 0 || len(report.Restored) != 1 {
+				t.Errorf("Expected the %s downloader to finish the restore from the partial file %q and got %v with errors %v", name, part, *report, errs)
+			}
+			checkRestoredTestFiles(t, dest, files)
+		}
+	}
+}
+
+func TestRestoreFilesCorrupted(t *testing.T) {
+	m, d := newTestRestoreStorage(t)
+	files, _ := SelectRestoreFiles(m, RestoreSelection{Games: []string{"2"}, Installers: true})
+	corrupted := []byte(testFileContents["setup_second.exe"])
+	corrupted[0] = 'S'
+	uploadTestFile(t, d.Fs, files[0], string(corrupted))
+	dest := t.TempDir()
+
+	report, errs := RestoreFiles(d, files, dest, 1)
+	if len(errs) != 1 || len(report.Restored) != 0 {
+		t.Errorf("Expected the corrupted file to fail its restore and got %v with errors %v", *report, errs)
+	}
+	if _, err := os.Stat(GetRestorePath(dest, files[0])); !os.IsNotExist(err) {
+		t.Errorf("Expected the corrupted file not to be restored")
+	}
+	if _, err := os.Stat(GetRestorePath(dest, files[0]) + ".part"); !os.IsNotExist(err) {
+		t.Errorf("Expected the partial file of the corrupted file to be removed")
+	}
+}
+
+func TestRestoreFilesToWriter(t *testing.T) {
+	m, d := newTestRestoreStorage(t)
+	files, _ := SelectRestoreFiles(m, RestoreSelection{Games: []string{"1", "2"}, Installers: true, Extras: true})
+
+	var out bytes.Buffer
+	err := RestoreFilesToWriter(d, files, &out)
+	if err != nil {
+		t.Fatalf("Writing the files failed: %s", err.Error())
+	}
+	expected := ""
+	for _, file := range files {
+		expected += testFileContents[file.Name]
+	}
+	if out.String() != expected {
+		t.Errorf("Expected the files to be written one after the other in order and got %q", out.String())
+	}
+
+	corrupted := []byte(testFileContents[files[0].Name])
+	corrupted[0] = 'X'
+	uploadTestFile(t, d.Fs, files[0], string(corrupted))
+	out.Reset()
+	err = RestoreFilesToWriter(d, files, &out)
+	if err == nil {
+		t.Errorf("Expected writing a corrupted file to fail")
+	}
+	if out.String() != string(corrupted) {
+		t.Errorf("Expected the writing to stop after the corrupted file and got %q", out.String())
+	}
+}
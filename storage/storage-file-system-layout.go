@@ -0,0 +1,335 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	GameDirectoriesId         = "id"
+	GameDirectoriesSlug       = "slug"
+	GameDirectoriesTitle      = "title"
+	FileDirectoriesFlat       = "flat"
+	FileDirectoriesOsLanguage = "os-language"
+)
+
+const layoutFileName = "layout.json"
+
+//Directories at the root of the storage that are not games
+var reservedDirectoryNames = map[string]bool{"images": true}
+
+//Layout of the directories of a file system storage, kept in its layout.json file.
+//Storages without the file name the directory of games after their id and put files directly in the directory of their kind.
+type FileSystemLayout struct {
+	GameDirectories string
+	FileDirectories string
+	//Directory of each game, for the layouts that don't name it after the game's id
+	Games map[int64]string
+}
+
+//The layout is shared by the copies of a file system storage and loaded on first use
+type fileSystemLayoutState struct {
+	mu     sync.Mutex
+	loaded bool
+	layout FileSystemLayout
+}
+
+func NewFileSystemLayout(gameDirectories string, fileDirectories string) (FileSystemLayout, error) {
+	if gameDirectories != GameDirectoriesId && gameDirectories != GameDirectoriesSlug && gameDirectories != GameDirectoriesTitle {
+		msg := fmt.Sprintf("NewFileSystemLayout(gameDirectories=%s, ...) -> Game directories should be '%s', '%s' or '%s'", gameDirectories, GameDirectoriesId, GameDirectoriesSlug, GameDirectoriesTitle)
+		return FileSystemLayout{}, errors.New(msg)
+	}
+	if fileDirectories != FileDirectoriesFlat && fileDirectories != FileDirectoriesOsLanguage {
+		msg := fmt.Sprintf("NewFileSystemLayout(..., fileDirectories=%s) -> File directories should be '%s' or '%s'", fileDirectories, FileDirectoriesFlat, FileDirectoriesOsLanguage)
+		return FileSystemLayout{}, errors.New(msg)
+	}
+	return FileSystemLayout{
+		GameDirectories: gameDirectories,
+		FileDirectories: fileDirectories,
+		Games:           make(map[int64]string),
+	}, nil
+}
+
+func (l *FileSystemLayout) IsDefault() bool {
+	return (*l).GameDirectories == GameDirectoriesId && (*l).FileDirectories == FileDirectoriesFlat
+}
+
+//Characters that are not allowed in the file names of some file systems are replaced
+func sanitizeDirectoryName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune("/\\:*?\"<>|", r) || r < 32 {
+			return '_'
+		}
+		return r
+	}, name)
+	return strings.TrimRight(strings.TrimSpace(name), ".")
+}
+
+//Returns the name the layout gives the directory of the game, without considering the names other games already have
+func (l *FileSystemLayout) getGameDirectoryName(game manifest.GameInfo) string {
+	id := strconv.FormatInt(game.Id, 10)
+	name := ""
+	if (*l).GameDirectories == GameDirectoriesSlug {
+		name = sanitizeDirectoryName(game.Slug)
+	} else if (*l).GameDirectories == GameDirectoriesTitle && sanitizeDirectoryName(game.Title) != "" {
+		name = fmt.Sprintf("%s (%s)", sanitizeDirectoryName(game.Title), id)
+	}
+
+	if name == "" || name == "." {
+		return id
+	}
+	return name
+}
+
+//Names the directory of a game that doesn't have one yet, making sure no other game has the same name
+func (l *FileSystemLayout) assignGameDirectory(game manifest.GameInfo) string {
+	if dir, ok := (*l).Games[game.Id]; ok {
+		return dir
+	}
+
+	id := strconv.FormatInt(game.Id, 10)
+	name := l.getGameDirectoryName(game)
+	if name == id {
+		return id
+	}
+
+	taken := reservedDirectoryNames[name]
+	for otherId, dir := range (*l).Games {
+		if dir == name && otherId != game.Id {
+			taken = true
+			break
+		}
+	}
+	if taken {
+		name = fmt.Sprintf("%s (%s)", name, id)
+	}
+	(*l).Games[game.Id] = name
+	return name
+}
+
+//Directory of installers, relative to the installers directory of the game. Installers in several languages are kept together.
+func getInstallerSubDirectory(file manifest.FileInfo) string {
+	if file.Os == "" {
+		return ""
+	}
+
+	language := "multilingual"
+	if len(file.Languages) == 1 {
+		language = file.Languages[0]
+	}
+	return path.Join(file.Os, language)
+}
+
+func getKindDirectory(kind string) (string, error) {
+	if kind == "installer" {
+		return "installers", nil
+	} else if kind == "extra" {
+		return "extras", nil
+	} else if kind == "depot" {
+		return "depots", nil
+	}
+	return "", errors.New("Unknown kind of file")
+}
+
+//Returns the file system that holds the files of fs and sqlite storages
+func GetStorageFileSystem(s Storage) (FileSystem, bool) {
+	switch store := s.(type) {
+	case FileSystem:
+		return store, true
+	case SqliteStore:
+		return store.FileSystem, true
+	}
+	return FileSystem{}, false
+}
+
+func (f FileSystem) getLayoutPath() string {
+	return path.Join(f.Path, layoutFileName)
+}
+
+func (f FileSystem) readLayout() (FileSystemLayout, error) {
+	layout, _ := NewFileSystemLayout(GameDirectoriesId, FileDirectoriesFlat)
+	bs, err := ioutil.ReadFile(f.getLayoutPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return layout, nil
+		}
+		return layout, err
+	}
+
+	err = json.Unmarshal(bs, &layout)
+	if err != nil {
+		msg := fmt.Sprintf("readLayout() -> Error occured while parsing the layout file: %s", err.Error())
+		return layout, errors.New(msg)
+	}
+	if layout.Games == nil {
+		layout.Games = make(map[int64]string)
+	}
+	return layout, nil
+}
+
+func (f FileSystem) writeLayout(layout *FileSystemLayout) error {
+	if layout.IsDefault() && len((*layout).Games) == 0 {
+		err := os.Remove(f.getLayoutPath())
+		if err != nil && (!os.IsNotExist(err)) {
+			return err
+		}
+		return nil
+	}
+
+	bs, err := json.MarshalIndent(layout, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(f.getLayoutPath(), bs, 0644)
+}
+
+//Runs the function with the layout of the storage, which it can change if it returns true
+func (f FileSystem) withLayout(fn func(layout *FileSystemLayout) bool) error {
+	if f.layout == nil {
+		layout, err := f.readLayout()
+		if err != nil {
+			return err
+		}
+		if fn(&layout) {
+			return f.writeLayout(&layout)
+		}
+		return nil
+	}
+
+	f.layout.mu.Lock()
+	defer f.layout.mu.Unlock()
+	if !f.layout.loaded {
+		layout, err := f.readLayout()
+		if err != nil {
+			return err
+		}
+		f.layout.layout = layout
+		f.layout.loaded = true
+	}
+
+	if fn(&f.layout.layout) {
+		return f.writeLayout(&f.layout.layout)
+	}
+	return nil
+}
+
+func (f FileSystem) GetLayout() (FileSystemLayout, error) {
+	var copy FileSystemLayout
+	err := f.withLayout(func(layout *FileSystemLayout) bool {
+		copy = FileSystemLayout{
+			GameDirectories: (*layout).GameDirectories,
+			FileDirectories: (*layout).FileDirectories,
+			Games:           make(map[int64]string),
+		}
+		for id, dir := range (*layout).Games {
+			copy.Games[id] = dir
+		}
+		return false
+	})
+	return copy, err
+}
+
+func (f FileSystem) getGameDir(gameId int64) (string, error) {
+	dir := strconv.FormatInt(gameId, 10)
+	err := f.withLayout(func(layout *FileSystemLayout) bool {
+		if mapped, ok := (*layout).Games[gameId]; ok {
+			dir = mapped
+		}
+		return false
+	})
+	return path.Join(f.Path, dir), err
+}
+
+//Returns the path of a stored file, wherever it was sorted, or the path a new file should be stored at
+func (f FileSystem) getFilePath(file manifest.FileInfo) (string, error) {
+	kindDir, err := getKindDirectory(file.Kind)
+	if err != nil {
+		return "", err
+	}
+
+	gameDir, err := f.getGameDir(file.Game.Id)
+	if err != nil {
+		return "", err
+	}
+	dir := path.Join(gameDir, kindDir)
+
+	fPath := path.Join(dir, file.Name)
+	if _, err := os.Stat(fPath); err == nil || file.Kind != "installer" {
+		return fPath, nil
+	}
+
+	found := ""
+	filepath.WalkDir(dir, func(walkPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if entry.Type().IsRegular() && entry.Name() == file.Name {
+			found = walkPath
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	if found != "" {
+		return found, nil
+	}
+
+	fileDirectories := FileDirectoriesFlat
+	err = f.withLayout(func(layout *FileSystemLayout) bool {
+		fileDirectories = (*layout).FileDirectories
+		return false
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if fileDirectories == FileDirectoriesOsLanguage {
+		return path.Join(dir, getInstallerSubDirectory(file), file.Name), nil
+	}
+	return fPath, nil
+}
+
+//Lists the regular files under the directory, including those sorted in sub-directories
+func listKindFiles(dir string) ([]fs.FileInfo, error) {
+	files := []fs.FileInfo{}
+	err := filepath.WalkDir(dir, func(walkPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, info)
+		return nil
+	})
+	return files, err
+}
+
+//Removes the empty directories that were left behind under the directory after files were moved or removed
+func removeEmptyDirectories(dir string, keepRoot bool) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			removeEmptyDirectories(path.Join(dir, entry.Name()), false)
+		}
+	}
+	if !keepRoot {
+		os.Remove(dir)
+	}
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"gogcli/manifest"
+	"testing"
+)
+
+func TestSanitizeDirectoryName(t *testing.T) {
+	expectations := map[string]string{
+		"first_game":            "first_game",
+		"Game: The Sequel":      "Game_ The Sequel",
+		"AC/DC \\ Live?":        "AC_DC _ Live_",
+		"<Quoted> \"Name\" | *": "_Quoted_ _Name_ _ _",
+		"Tab\tand\nnewline":     "Tab_and_newline",
+		"  Padded Title...  ":   "Padded Title",
+		"...":                   "",
+		"Título ünïcødé":        "Título ünïcødé",
+	}
+	for name, expected := range expectations {
+		sanitized := sanitizeDirectoryName(name)
+		if sanitized != expected {
+			t.Errorf("Expected %q to be sanitized to %q and got %q", name, expected, sanitized)
+		}
+	}
+}
+
+func TestGetGameDirectoryName(t *testing.T) {
+	slugLayout, _ := NewFileSystemLayout(GameDirectoriesSlug, FileDirectoriesFlat)
+	titleLayout, _ := NewFileSystemLayout(GameDirectoriesTitle, FileDirectoriesFlat)
+	idLayout, _ := NewFileSystemLayout(GameDirectoriesId, FileDirectoriesFlat)
+	game := manifest.GameInfo{Id: 12, Slug: "some_game", Title: "Some Game: Deluxe"}
+
+	if name := slugLayout.getGameDirectoryName(game); name != "some_game" {
+		t.Errorf("Expected the slug layout to name the directory after the slug and got %s", name)
+	}
+	if name := titleLayout.getGameDirectoryName(game); name != "Some Game_ Deluxe (12)" {
+		t.Errorf("Expected the title layout to name the directory after the title and id and got %s", name)
+	}
+	if name := idLayout.getGameDirectoryName(game); name != "12" {
+		t.Errorf("Expected the id layout to name the directory after the id and got %s", name)
+	}
+	if name := slugLayout.getGameDirectoryName(manifest.GameInfo{Id: 12, Slug: "."}); name != "12" {
+		t.Errorf("Expected games without a usable slug to fall back to their id and got %s", name)
+	}
+	if name := titleLayout.getGameDirectoryName(manifest.GameInfo{Id: 12, Title: "..."}); name != "12" {
+		t.Errorf("Expected games without a usable title to fall back to their id and got %s", name)
+	}
+}
+
+func TestAssignGameDirectoryCollisions(t *testing.T) {
+	layout, _ := NewFileSystemLayout(GameDirectoriesSlug, FileDirectoriesOsLanguage)
+
+	first := layout.assignGameDirectory(manifest.GameInfo{Id: 1, Slug: "same_game"})
+	second := layout.assignGameDirectory(manifest.GameInfo{Id: 2, Slug: "same_game"})
+	if first != "same_game" || second != "same_game (2)" {
+		t.Errorf("Expected the second game with the same slug to get its id appended and got %s and %s", first, second)
+	}
+
+	//Slugs differing by characters that are sanitized away collide as well
+	third := layout.assignGameDirectory(manifest.GameInfo{Id: 3, Slug: "same:game"})
+	fourth := layout.assignGameDirectory(manifest.GameInfo{Id: 4, Slug: "same/game"})
+	if third != "same_game (3)" || fourth != "same_game (4)" {
+		t.Errorf("Expected sanitized slugs to collide with existing directories and got %s and %s", third, fourth)
+	}
+
+	if images := layout.assignGameDirectory(manifest.GameInfo{Id: 5, Slug: "images"}); images != "images (5)" {
+		t.Errorf("Expected a game not to take the directory of the images and got %s", images)
+	}
+	if fallback := layout.assignGameDirectory(manifest.GameInfo{Id: 6}); fallback != "6" {
+		t.Errorf("Expected a game without slug to keep its id and got %s", fallback)
+	}
+	if _, ok := layout.Games[6]; ok {
+		t.Errorf("Expected games named after their id not to be mapped")
+	}
+
+	if again := layout.assignGameDirectory(manifest.GameInfo{Id: 2, Slug: "other_game"}); again != "same_game (2)" {
+		t.Errorf("Expected a game to keep the directory it was assigned and got %s", again)
+	}
+	if len(layout.Games) != 5 {
+		t.Errorf("Expected 5 games to be mapped to a directory and got %v", layout.Games)
+	}
+}
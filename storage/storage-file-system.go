@@ -13,20 +13,23 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
+	"net/url"
 	"os"
 	"path"
+	"path/filepath"
 	"strconv"
 )
 
 type FileSystem struct {
 	Path   string
 	logger *logging.Logger
+	layout *fileSystemLayoutState
 }
 
 func GetFileSystemFromSource(s Source, logSource *logging.Source, tag string) (FileSystem, error) {
 	if s.Type != "fs" {
 		msg := fmt.Sprintf("Cannot load file system from source of type %s", s.Type)
-		return FileSystem{"", nil}, errors.New(msg)
+		return FileSystem{"", nil, nil}, errors.New(msg)
 	}
 	return GetFileSystem(s.FsPath, logSource, tag), nil
 }
@@ -38,7 +41,7 @@ func GetFileSystem(path string, logSource *logging.Source, tag string) FileSyste
 	} else {
 		logPrefix = fmt.Sprintf("[fs-%s] ", tag)
 	}
-	return FileSystem{path, logSource.CreateLogger(os.Stdout, logPrefix, log.Lmsgprefix)}
+	return FileSystem{path, logSource.CreateLogger(os.Stdout, logPrefix, log.Lmsgprefix), &fileSystemLayoutState{}}
 }
 
 func (f FileSystem) GetGameIds() ([]int64, error) {
@@ -47,12 +50,29 @@ func (f FileSystem) GetGameIds() ([]int64, error) {
 	if err != nil {
 		return gameIds, err
 	}
+
+	layout, err := f.GetLayout()
+	if err != nil {
+		return gameIds, err
+	}
+
+	mappedDirs := make(map[string]bool)
+	for gameId, dir := range layout.Games {
+		mappedDirs[dir] = true
+		gameIds = append(gameIds, gameId)
+	}
 	
 	for _, file := range files {
+		if mappedDirs[file.Name()] {
+			continue
+		}
 		gameId, err := strconv.ParseInt(file.Name(), 10, 64)
 		if err != nil {
 			continue
 		}
+		if _, ok := layout.Games[gameId]; ok {
+			continue
+		}
 		gameIds = append(gameIds, gameId)
 	}
 
@@ -64,11 +84,15 @@ func (f FileSystem) GetGameFiles(GameId int64) ([]manifest.FileInfo, error) {
 	gameInfo := manifest.GameInfo{Id: GameId}
 	fileInfos := []manifest.FileInfo{}
 
-	gameDir := path.Join(f.Path, strconv.FormatInt(GameId, 10))
+	gameDir, err := f.getGameDir(GameId)
+	if err != nil {
+		return fileInfos, err
+	}
 	instDir := path.Join(gameDir, "installers")
 	extrDir := path.Join(gameDir, "extras")
+	depotDir := path.Join(gameDir, "depots")
 
-	installers, installersErr := ioutil.ReadDir(instDir)
+	installers, installersErr := listKindFiles(instDir)
 	if installersErr != nil {
 		return fileInfos, installersErr
 	}
@@ -78,11 +102,12 @@ func (f FileSystem) GetGameFiles(GameId int64) ([]manifest.FileInfo, error) {
 			Game: gameInfo, 
 			Name: file.Name(), 
 			Kind: "installer",
+			Size: file.Size(),
 		}
 		fileInfos = append(fileInfos, fileInfo)
 	}
 
-	extras, extrasErr := ioutil.ReadDir(extrDir)
+	extras, extrasErr := listKindFiles(extrDir)
 	if extrasErr != nil {
 		return fileInfos, extrasErr
 	}
@@ -92,6 +117,23 @@ func (f FileSystem) GetGameFiles(GameId int64) ([]manifest.FileInfo, error) {
 			Game: gameInfo, 
 			Name: file.Name(), 
 			Kind: "extra",
+			Size: file.Size(),
+		}
+		fileInfos = append(fileInfos, fileInfo)
+	}
+
+	//Storages created before depots were supported do not have a depots directory
+	depots, depotsErr := listKindFiles(depotDir)
+	if depotsErr != nil && (!os.IsNotExist(depotsErr)) {
+		return fileInfos, depotsErr
+	}
+
+	for _, file := range depots {
+		fileInfo := manifest.FileInfo{
+			Game: gameInfo, 
+			Name: file.Name(), 
+			Kind: "depot",
+			Size: file.Size(),
 		}
 		fileInfos = append(fileInfos, fileInfo)
 	}
@@ -219,7 +261,7 @@ func (f FileSystem) StoreManifest(m *manifest.Manifest) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	err = ioutil.WriteFile(path.Join(f.Path, "manifest.json"), output, 0644)
+	err = writeFileAtomically(path.Join(f.Path, "manifest.json"), output, 0644)
 	if err == nil {
 		f.logger.Debug(fmt.Sprintf("StoreManifest(...) -> Stored manifest with %d games", len((*m).Games)))
 	}
@@ -240,7 +282,7 @@ func (f FileSystem) StoreMetadata(m *metadata.Metadata) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	err = ioutil.WriteFile(path.Join(f.Path, "metadata.json"), output, 0644)
+	err = writeFileAtomically(path.Join(f.Path, "metadata.json"), output, 0644)
 	if err == nil {
 		f.logger.Debug(fmt.Sprintf("StoreMetadata(...) -> Stored metadata with %d games", len((*m).Games)))
 	}
@@ -261,7 +303,7 @@ func (f FileSystem) StoreActions(a *manifest.GameActions) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	err = ioutil.WriteFile(path.Join(f.Path, "actions.json"), output, 0644)
+	err = writeFileAtomically(path.Join(f.Path, "actions.json"), output, 0644)
 	if err == nil {
 		f.logger.Debug(fmt.Sprintf("StoreActions(...) -> Stored actions on %d games", len(*a)))
 	}
@@ -282,7 +324,7 @@ func (f FileSystem) StoreSource(s *Source) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	err = ioutil.WriteFile(path.Join(f.Path, "source.json"), output, 0644)
+	err = writeFileAtomically(path.Join(f.Path, "source.json"), output, 0644)
 	if err == nil {
 		f.logger.Debug(fmt.Sprintf("StoreSource(...) -> Stored source of type %s", s.Type))
 	}
@@ -387,12 +429,80 @@ func (f FileSystem) RemoveSource() error {
 	return err
 }
 
+func (f FileSystem) AppendJournal(entry manifest.JournalEntry) error {
+	output, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	journal, err := os.OpenFile(path.Join(f.Path, "journal.jsonl"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = journal.Write(append(output, '\n'))
+	if err == nil {
+		err = journal.Sync()
+	}
+	closeErr := journal.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		msg := fmt.Sprintf("AppendJournal(gameId=%d) -> Error occured while appending to the journal: %s", entry.Game.Id, err.Error())
+		return errors.New(msg)
+	}
+
+	f.logger.Debug(fmt.Sprintf("AppendJournal(gameId=%d) -> Appended entry to the journal", entry.Game.Id))
+	return nil
+}
+
+func (f FileSystem) LoadJournal() ([]manifest.JournalEntry, error) {
+	bs, err := ioutil.ReadFile(path.Join(f.Path, "journal.jsonl"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []manifest.JournalEntry{}, nil
+		}
+		return []manifest.JournalEntry{}, err
+	}
+
+	entries, err := parseJournal(bs)
+	if err != nil {
+		msg := fmt.Sprintf("LoadJournal() -> %s", err.Error())
+		return entries, errors.New(msg)
+	}
+
+	f.logger.Debug(fmt.Sprintf("LoadJournal() -> Loaded %d journal entries", len(entries)))
+	return entries, nil
+}
+
+func (f FileSystem) RemoveJournal() error {
+	err := os.Remove(path.Join(f.Path, "journal.jsonl"))
+	if err != nil && (!os.IsNotExist(err)) {
+		return err
+	}
+
+	f.logger.Debug("RemoveJournal() -> Removed journal file")
+	return nil
+}
+
 func (f FileSystem) AddGame(game manifest.GameInfo) error {
-	gameDir := path.Join(f.Path, strconv.FormatInt(game.Id, 10))
+	var gameDir string
+	err := f.withLayout(func(layout *FileSystemLayout) bool {
+		_, known := (*layout).Games[game.Id]
+		gameDir = path.Join(f.Path, layout.assignGameDirectory(game))
+		_, assigned := (*layout).Games[game.Id]
+		return assigned && !known
+	})
+	if err != nil {
+		msg := fmt.Sprintf("AddGame(gameId=%d) -> Error occured while updating the layout: %s", game.Id, err.Error())
+		return errors.New(msg)
+	}
 	instDir := path.Join(gameDir, "installers")
 	extrDir := path.Join(gameDir, "extras")
+	depotDir := path.Join(gameDir, "depots")
 
-	_, err := os.Stat(instDir)
+	_, err = os.Stat(instDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			err = os.MkdirAll(instDir, 0755)
@@ -420,14 +530,31 @@ func (f FileSystem) AddGame(game manifest.GameInfo) error {
 		}
 	}
 
+	_, err = os.Stat(depotDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = os.Mkdir(depotDir, 0755)
+			if err != nil {
+				msg := fmt.Sprintf("AddGame(gameId=%d) -> Error occured while creating depots directory exists: %s", game.Id, err.Error())
+				return errors.New(msg)
+			}
+		} else {
+			msg := fmt.Sprintf("AddGame(gameId=%d) -> Error occured while checking if depots directory exists: %s", game.Id, err.Error())
+			return errors.New(msg)
+		}
+	}
+
 	f.logger.Debug(fmt.Sprintf("AddGame(gameId=%d) -> Created game directory", game.Id))
 	return nil
 }
 
 func (f FileSystem) RemoveGame(game manifest.GameInfo) error {
-	gameDir := path.Join(f.Path, strconv.FormatInt(game.Id, 10))
+	gameDir, err := f.getGameDir(game.Id)
+	if err != nil {
+		return err
+	}
 
-	_, err := os.Stat(gameDir)
+	_, err = os.Stat(gameDir)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil
@@ -437,7 +564,15 @@ func (f FileSystem) RemoveGame(game manifest.GameInfo) error {
 	}
 
 	err = os.RemoveAll(gameDir)
+	if err != nil {
+		return err
+	}
 
+	err = f.withLayout(func(layout *FileSystemLayout) bool {
+		_, known := (*layout).Games[game.Id]
+		delete((*layout).Games, game.Id)
+		return known
+	})
 	if err == nil {
 		f.logger.Debug(fmt.Sprintf("RemoveGame(gameId=%d) -> Removed game directory", game.Id))
 	}
@@ -445,13 +580,15 @@ func (f FileSystem) RemoveGame(game manifest.GameInfo) error {
 }
 
 func (f FileSystem) UploadFile(source io.ReadCloser, file manifest.FileInfo) (string, error) {
-	var fPath string
-	if file.Kind == "installer" {
-		fPath = path.Join(f.Path, strconv.FormatInt(file.Game.Id, 10), "installers", file.Name)
-	} else if file.Kind == "extra" {
-		fPath = path.Join(f.Path, strconv.FormatInt(file.Game.Id, 10), "extras", file.Name)
-	} else {
-		return "", errors.New("Unknown kind of file")
+	fPath, err := f.getFilePath(file)
+	if err != nil {
+		return "", err
+	}
+
+	//Installers can be sorted in sub-directories that don't exist yet
+	err = os.MkdirAll(path.Dir(fPath), 0755)
+	if err != nil {
+		return "", err
 	}
 
 	h := md5.New()
@@ -469,7 +606,7 @@ func (f FileSystem) UploadFile(source io.ReadCloser, file manifest.FileInfo) (st
 	if infoErr != nil {
 		return "", infoErr
 	} else if info.Size() != file.Size {
-		msg := fmt.Sprintf("Created file at %d has size %d which doesn't match expected size %s", fPath, info.Size(), file.Size)
+		msg := fmt.Sprintf("Created file at %s has size %d which doesn't match expected size %d", fPath, info.Size(), file.Size)
 		return "", errors.New(msg)
 	}
 
@@ -478,16 +615,12 @@ func (f FileSystem) UploadFile(source io.ReadCloser, file manifest.FileInfo) (st
 }
 
 func (f FileSystem) RemoveFile(file manifest.FileInfo) error {
-	var fPath string
-	if file.Kind == "installer" {
-		fPath = path.Join(f.Path, strconv.FormatInt(file.Game.Id, 10), "installers", file.Name)
-	} else if file.Kind == "extra" {
-		fPath = path.Join(f.Path, strconv.FormatInt(file.Game.Id, 10), "extras", file.Name)
-	} else {
-		return errors.New("Unknown kind of file")
+	fPath, err := f.getFilePath(file)
+	if err != nil {
+		return err
 	}
 
-	err := os.Remove(fPath)
+	err = os.Remove(fPath)
 	if err != nil && (!os.IsNotExist(err)) {
 		return err
 	}
@@ -497,13 +630,9 @@ func (f FileSystem) RemoveFile(file manifest.FileInfo) error {
 }
 
 func (f FileSystem) DownloadFile(file manifest.FileInfo) (io.ReadCloser, int64, error) {
-	var fPath string
-	if file.Kind == "installer" {
-		fPath = path.Join(f.Path, strconv.FormatInt(file.Game.Id, 10), "installers", file.Name)
-	} else if file.Kind == "extra" {
-		fPath = path.Join(f.Path, strconv.FormatInt(file.Game.Id, 10), "extras", file.Name)
-	} else {
-		msg := fmt.Sprintf("DownloadFile(gameId=%d, kind=%s, name=%s) -> Unknown kind of file", file.Game.Id, file.Kind, file.Name)
+	fPath, err := f.getFilePath(file)
+	if err != nil {
+		msg := fmt.Sprintf("DownloadFile(gameId=%d, kind=%s, name=%s) -> %s", file.Game.Id, file.Kind, file.Name, err.Error())
 		return nil, 0, errors.New(msg)
 	}
 
@@ -524,17 +653,85 @@ func (f FileSystem) DownloadFile(file manifest.FileInfo) (io.ReadCloser, int64,
 	return downloadHandle, size, nil
 }
 
-//TODO
+func (f FileSystem) GetFileLink(file manifest.FileInfo) (string, error) {
+	fPath, err := f.getFilePath(file)
+	if err != nil {
+		msg := fmt.Sprintf("GetFileLink(gameId=%d, kind=%s, name=%s) -> %s", file.Game.Id, file.Kind, file.Name, err.Error())
+		return "", errors.New(msg)
+	}
+
+	absPath, err := filepath.Abs(fPath)
+	if err != nil {
+		return "", err
+	}
+
+	link := url.URL{Scheme: "file", Path: filepath.ToSlash(absPath)}
+	return link.String(), nil
+}
+
 func (f FileSystem) UploadImage(source io.ReadCloser, image metadata.GameMetadataImage) (string, error) {
-	return "", nil 
+	imgDir := path.Join(f.Path, "images", image.Tag)
+	fPath := path.Join(imgDir, image.Name)
+
+	err := os.MkdirAll(imgDir, 0755)
+	if err != nil {
+		msg := fmt.Sprintf("UploadImage(source=..., tag=%s, name=%s) -> Error occured while creating image directory: %s", image.Tag, image.Name, err.Error())
+		return "", errors.New(msg)
+	}
+
+	h := md5.New()
+
+	dest, err := os.Create(fPath)
+	if err != nil {
+		return "", err
+	}
+
+	w := io.MultiWriter(dest, h)
+	_, err = io.Copy(w, source)
+	dest.Close()
+	if err != nil {
+		return "", err
+	}
+
+	info, infoErr := os.Stat(fPath)
+	if infoErr != nil {
+		return "", infoErr
+	} else if image.Size > 0 && info.Size() != image.Size {
+		msg := fmt.Sprintf("Created image at %s has size %d which doesn't match expected size %d", fPath, info.Size(), image.Size)
+		return "", errors.New(msg)
+	}
+
+	f.logger.Debug(fmt.Sprintf("UploadImage(source=..., tag=%s, name=%s) -> Uploaded image", image.Tag, image.Name))
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
 
-//TODO
 func (f FileSystem) RemoveImage(image metadata.GameMetadataImage) error {
+	fPath := path.Join(f.Path, "images", image.Tag, image.Name)
+
+	err := os.Remove(fPath)
+	if err != nil && (!os.IsNotExist(err)) {
+		return err
+	}
+
+	f.logger.Debug(fmt.Sprintf("RemoveImage(tag=%s, name=%s) -> Removed image", image.Tag, image.Name))
 	return nil
 }
 
-//TODO
 func (f FileSystem) DownloadImage(image metadata.GameMetadataImage) (io.ReadCloser, int64, error) {
-	return nil, 0, nil
-}
\ No newline at end of file
+	fPath := path.Join(f.Path, "images", image.Tag, image.Name)
+
+	fi, err := os.Stat(fPath)
+	if err != nil {
+		msg := fmt.Sprintf("DownloadImage(tag=%s, name=%s) -> Error occured while retrieving image size: %s", image.Tag, image.Name, err.Error())
+		return nil, 0, errors.New(msg)
+	}
+
+	downloadHandle, openErr := os.Open(fPath)
+	if openErr != nil {
+		msg := fmt.Sprintf("DownloadImage(tag=%s, name=%s) -> Error occured while opening image for download: %s", image.Tag, image.Name, openErr.Error())
+		return nil, 0, errors.New(msg)
+	}
+
+	f.logger.Debug(fmt.Sprintf("DownloadImage(tag=%s, name=%s) -> Fetched image download handle", image.Tag, image.Name))
+	return downloadHandle, fi.Size(), nil
+}
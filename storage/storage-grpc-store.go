@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"gogcli/metadata"
 	"gogcli/manifest"
     "gogcli/storagegrpc"
@@ -605,6 +606,12 @@ func (g GrpcStore) DownloadFile(file manifest.FileInfo) (io.ReadCloser, int64, e
 	return &fileDownloader, expectedSize, nil
 }
 
+//The grpc protocol has no way to expose the files of the store, so no link can point to them
+func (g GrpcStore) GetFileLink(file manifest.FileInfo) (string, error) {
+	msg := fmt.Sprintf("GetFileLink(gameId=%d, kind=%s, name=%s) -> Grpc stores cannot provide links to their files", file.Game.Id, file.Kind, file.Name)
+	return "", errors.New(msg)
+}
+
 //TODO
 func (g GrpcStore) UploadImage(source io.ReadCloser, image metadata.GameMetadataImage) (string, error) {
 	return "", nil 
@@ -34,7 +34,28 @@ type Storage interface {
 	UploadFile(source io.ReadCloser, file manifest.FileInfo) (string, error)
 	RemoveFile(file manifest.FileInfo) error
 	DownloadFile(file manifest.FileInfo) (io.ReadCloser, int64, error)
+	GetFileLink(file manifest.FileInfo) (string, error)
 	UploadImage(source io.ReadCloser, image metadata.GameMetadataImage) (string, error)
 	RemoveImage(image metadata.GameMetadataImage) error
 	DownloadImage(image metadata.GameMetadataImage) (io.ReadCloser, int64, error)
 }
+
+//Storages that can persist the changes of a single game implement this interface to avoid rewriting
+//the whole manifest and actions after each processed file
+type GameStorage interface {
+	StoreManifestGame(m *manifest.Manifest, gameId int64) error
+	StoreGameActions(a *manifest.GameActions, gameId int64) error
+}
+
+//Storages that can look up games by title without loading the whole manifest implement this interface
+type ManifestSearcher interface {
+	SearchManifest(titleTerms []string) (*manifest.Manifest, error)
+}
+
+//Storages that upload large files in parts implement this interface so that an interrupted upload can
+//resume from its last completed part, even after the process restarts
+type ResumableUploadStorage interface {
+	GetUploadOffset(file manifest.FileInfo) (int64, error)
+	ResumeUploadFile(source io.ReadCloser, file manifest.FileInfo, offset int64) (string, error)
+	RemoveIncompleteUploads() error
+}
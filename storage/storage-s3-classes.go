@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+//Objects in these storage classes must be restored before they can be downloaded
+var s3ArchiveStorageClasses = []string{"GLACIER", "DEEP_ARCHIVE"}
+
+//Returned when downloading an archived object whose restore is not completed yet. Retrying right away won't help.
+type RestorePendingError struct {
+	Key string
+}
+
+func (e *RestorePendingError) Error() string {
+	return fmt.Sprintf("Object %s is archived and being restored, try again once the restore is completed", e.Key)
+}
+
+func (e *RestorePendingError) Unrecoverable() bool {
+	return true
+}
+
+func (s S3Store) getKey(key string) string {
+	prefix := strings.Trim((*s.configs).Prefix, "/")
+	if prefix == "" {
+		return key
+	}
+	return prefix + "/" + key
+}
+
+func (s S3Store) getStorageClass(kind string) string {
+	return (*s.configs).StorageClasses[kind]
+}
+
+func isArchiveStorageClass(class string) bool {
+	for _, archiveClass := range s3ArchiveStorageClasses {
+		if strings.ToUpper(class) == archiveClass {
+			return true
+		}
+	}
+	return false
+}
+
+func (s S3Store) getJsonPutOptions() minio.PutObjectOptions {
+	return minio.PutObjectOptions{ContentType: "application/json", StorageClass: s.getStorageClass("manifest")}
+}
+
+func (s S3Store) getFilePutOptions(file manifest.FileInfo) minio.PutObjectOptions {
+	opts := minio.PutObjectOptions{StorageClass: s.getStorageClass(file.Kind)}
+	if (*s.configs).Tags {
+		opts.UserTags = map[string]string{
+			"game-id": strconv.FormatInt(file.Game.Id, 10),
+			"kind":    file.Kind,
+		}
+		if file.Game.Slug != "" {
+			opts.UserTags["game-slug"] = file.Game.Slug
+		}
+	}
+	return opts
+}
+
+func (s S3Store) getImagePutOptions() minio.PutObjectOptions {
+	opts := minio.PutObjectOptions{StorageClass: s.getStorageClass("image")}
+	if (*s.configs).Tags {
+		opts.UserTags = map[string]string{"kind": "image"}
+	}
+	return opts
+}
+
+//Requests the restore of archived objects that were not restored yet.
+//Returns a RestorePendingError until the restored copy is available.
+func (s S3Store) ensureRestored(key string, info minio.ObjectInfo) error {
+	configs := *s.configs
+
+	if !isArchiveStorageClass(info.StorageClass) {
+		return nil
+	}
+	if info.Restore != nil && (!info.Restore.OngoingRestore) {
+		return nil
+	}
+
+	if info.Restore == nil {
+		days := configs.RestoreDays
+		if days <= 0 {
+			days = 7
+		}
+		tier := minio.TierStandard
+		if configs.RestoreTier != "" {
+			tier = minio.TierType(configs.RestoreTier)
+		}
+
+		req := minio.RestoreRequest{}
+		req.SetDays(days)
+		req.SetGlacierJobParameters(minio.GlacierJobParameters{Tier: tier})
+		err := s.client.RestoreObject(context.Background(), configs.Bucket, key, "", req)
+		if err != nil && minio.ToErrorResponse(err).Code != "RestoreAlreadyInProgress" {
+			msg := fmt.Sprintf("ensureRestored(key=%s) -> Error occured while requesting the restore of the object: %s", key, err.Error())
+			return errors.New(msg)
+		}
+		s.logger.Info(fmt.Sprintf("Requested the restore of archived object %s for %d days", key, days))
+	}
+
+	return &RestorePendingError{Key: key}
+}
@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"errors"
+	"gogcli/manifest"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+)
+
+func TestS3StoreGetKey(t *testing.T) {
+	expectations := map[string]string{
+		"":          "1/installers/setup.exe",
+		"gog":       "gog/1/installers/setup.exe",
+		"/gog/":     "gog/1/installers/setup.exe",
+		"gog/games": "gog/games/1/installers/setup.exe",
+	}
+	for prefix, expected := range expectations {
+		s := S3Store{configs: &S3Configs{Prefix: prefix}}
+		if key := s.getKey("1/installers/setup.exe"); key != expected {
+			t.Errorf("Expected the prefix %q to give the key %s and got %s", prefix, expected, key)
+		}
+	}
+}
+
+func TestS3StoreGetFilePutOptions(t *testing.T) {
+	file := manifest.FileInfo{Game: manifest.GameInfo{Id: 1, Slug: "first_game"}, Kind: "extra", Name: "manual.pdf"}
+
+	s := S3Store{configs: &S3Configs{StorageClasses: map[string]string{"extra": "GLACIER"}}}
+	opts := s.getFilePutOptions(file)
+	if opts.StorageClass != "GLACIER" || len(opts.UserTags) != 0 {
+		t.Errorf("Expected the storage class of the kind of file without tags and got %s with tags %v", opts.StorageClass, opts.UserTags)
+	}
+	if opts = s.getFilePutOptions(manifest.FileInfo{Game: file.Game, Kind: "installer", Name: "setup.exe"}); opts.StorageClass != "" {
+		t.Errorf("Expected kinds of files without a storage class to use the default one and got %s", opts.StorageClass)
+	}
+
+	s = S3Store{configs: &S3Configs{Tags: true}}
+	opts = s.getFilePutOptions(file)
+	if opts.UserTags["game-id"] != "1" || opts.UserTags["kind"] != "extra" || opts.UserTags["game-slug"] != "first_game" {
+		t.Errorf("Expected the file to be tagged with its game and kind and got %v", opts.UserTags)
+	}
+	opts = s.getFilePutOptions(manifest.FileInfo{Game: manifest.GameInfo{Id: 2}, Kind: "installer", Name: "setup.exe"})
+	if _, ok := opts.UserTags["game-slug"]; ok || len(opts.UserTags) != 2 {
+		t.Errorf("Expected files of games without slug not to be tagged with a slug and got %v", opts.UserTags)
+	}
+}
+
+func TestS3StoreEnsureRestored(t *testing.T) {
+	restoreRequests := 0
+	s := newTestS3Store(t, S3Configs{RestoreDays: 3, RestoreTier: "Bulk"}, func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := r.URL.Query()["restore"]; !ok || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), "<Days>3</Days>") || !strings.Contains(string(body), "<Tier>Bulk</Tier>") {
+			t.Errorf("Expected the restore to be requested with the configured days and tier and got %s", string(body))
+		}
+		restoreRequests++
+		if restoreRequests > 1 {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>RestoreAlreadyInProgress</Code><Message>Object restore is already in progress</Message></Error>`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := s.ensureRestored("1/extras/manual.pdf", minio.ObjectInfo{StorageClass: "STANDARD"})
+	if err != nil || restoreRequests != 0 {
+		t.Errorf("Expected objects that are not archived to be downloadable right away and got %v", err)
+	}
+	err = s.ensureRestored("1/extras/manual.pdf", minio.ObjectInfo{StorageClass: "GLACIER", Restore: &minio.RestoreInfo{OngoingRestore: false}})
+	if err != nil || restoreRequests != 0 {
+		t.Errorf("Expected restored objects to be downloadable right away and got %v", err)
+	}
+
+	var pendingErr *RestorePendingError
+	for i := 1; i <= 2; i++ {
+		err = s.ensureRestored("1/extras/manual.pdf", minio.ObjectInfo{StorageClass: "DEEP_ARCHIVE"})
+		if !errors.As(err, &pendingErr) || (*pendingErr).Key != "1/extras/manual.pdf" || restoreRequests != i {
+			t.Errorf("Expected the restore of the archived object to be requested and to be pending and got %v", err)
+		}
+	}
+	if !pendingErr.Unrecoverable() {
+		t.Errorf("Expected pending restores not to be retried right away")
+	}
+
+	err = s.ensureRestored("1/extras/manual.pdf", minio.ObjectInfo{StorageClass: "GLACIER", Restore: &minio.RestoreInfo{OngoingRestore: true}})
+	if !errors.As(err, &pendingErr) || restoreRequests != 2 {
+		t.Errorf("Expected an ongoing restore to be pending without being requested again and got %v", err)
+	}
+}
+
+func TestS3StoreEnsureRestoredRequestError(t *testing.T) {
+	s := newTestS3Store(t, S3Configs{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+	})
+
+	var pendingErr *RestorePendingError
+	err := s.ensureRestored("1/extras/manual.pdf", minio.ObjectInfo{StorageClass: "GLACIER"})
+	if err == nil || errors.As(err, &pendingErr) {
+		t.Errorf("Expected a failed restore request to be an error other than a pending restore and got %v", err)
+	}
+}
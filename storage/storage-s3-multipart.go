@@ -0,0 +1,448 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/manifest"
+	"hash"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+const defaultS3PartSize = int64(64 * 1024 * 1024)
+
+//S3 allows at most 10000 parts per upload
+const maxS3Parts = int64(10000)
+
+type s3UploadPart struct {
+	Number int
+	ETag   string
+	Size   int64
+}
+
+//State of a multipart upload, kept until the upload completes.
+//The state of the md5 hash of the uploaded parts is kept so that the checksum of the file doesn't require reading it back.
+type s3Upload struct {
+	Key      string
+	UploadId string
+	Size     int64
+	PartSize int64
+	Parts    []s3UploadPart
+	Md5State []byte
+}
+
+//Uploads in progress by key of their file, stored in uploads.json next to the actions
+type s3Uploads map[string]s3Upload
+
+func (u *s3Upload) getOffset() int64 {
+	offset := int64(0)
+	for _, part := range (*u).Parts {
+		offset += part.Size
+	}
+	return offset
+}
+
+//Returns the hash of the parts uploaded so far
+func (u *s3Upload) getHash() (hash.Hash, error) {
+	h := md5.New()
+	if len((*u).Md5State) > 0 {
+		err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary((*u).Md5State)
+		if err != nil {
+			msg := fmt.Sprintf("getHash() -> Recorded checksum state of upload %s is corrupted: %s", (*u).Key, err.Error())
+			return nil, errors.New(msg)
+		}
+	}
+	return h, nil
+}
+
+//Returns why the upload cannot be resumed for a file of the given size given the etags of the parts s3 has,
+//or an empty string if it can
+func (u *s3Upload) getResumeProblem(size int64, uploaded map[int]string) string {
+	if (*u).Size != size {
+		return fmt.Sprintf("upload is for a file of size %d", (*u).Size)
+	}
+
+	//A part recorded on completion that s3 doesn't have means the upload can't be trusted anymore
+	for _, part := range (*u).Parts {
+		if etag, ok := uploaded[part.Number]; !ok || etag != strings.Trim(part.ETag, "\"") {
+			return fmt.Sprintf("upload is missing part %d", part.Number)
+		}
+	}
+
+	return ""
+}
+
+func getS3FileKey(file manifest.FileInfo) (string, error) {
+	var dir string
+	if file.Kind == "installer" {
+		dir = "installers"
+	} else if file.Kind == "extra" {
+		dir = "extras"
+	} else if file.Kind == "depot" {
+		dir = "depots"
+	} else {
+		return "", errors.New("Unknown kind of file")
+	}
+
+	arr := []string{strconv.FormatInt(file.Game.Id, 10), dir, file.Name}
+	return strings.Join(arr, "/"), nil
+}
+
+//The configured part size is increased for files that would otherwise need more parts than s3 allows
+func (s S3Store) getPartSize(size int64) int64 {
+	partSize := defaultS3PartSize
+	if (*s.configs).PartSize > 0 {
+		partSize = (*s.configs).PartSize * 1024 * 1024
+	}
+
+	if size > partSize*maxS3Parts {
+		mib := int64(1024 * 1024)
+		partSize = ((size/maxS3Parts)/mib + 1) * mib
+	}
+	return partSize
+}
+
+func (s S3Store) getCore() minio.Core {
+	return minio.Core{Client: s.client}
+}
+
+func (s S3Store) loadUploads() (s3Uploads, error) {
+	configs := *s.configs
+	uploads := s3Uploads{}
+
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey("uploads.json"), minio.StatObjectOptions{})
+	if err != nil {
+		errResponse := minio.ToErrorResponse(err)
+		if errResponse.Code == "NoSuchKey" {
+			return uploads, nil
+		}
+		return uploads, err
+	}
+
+	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, s.getKey("uploads.json"), minio.GetObjectOptions{})
+	if err != nil {
+		return uploads, err
+	}
+	defer objPtr.Close()
+
+	bs, err := ioutil.ReadAll(objPtr)
+	if err != nil {
+		return uploads, err
+	}
+
+	err = json.Unmarshal(bs, &uploads)
+	if err != nil {
+		msg := fmt.Sprintf("loadUploads() -> Uploads state is corrupted: %s", err.Error())
+		return uploads, errors.New(msg)
+	}
+	return uploads, nil
+}
+
+//The uploads file is removed once no upload is left in progress
+func (s S3Store) storeUploads(uploads s3Uploads) error {
+	configs := *s.configs
+
+	if len(uploads) == 0 {
+		err := s.client.RemoveObject(context.Background(), configs.Bucket, s.getKey("uploads.json"), minio.RemoveObjectOptions{})
+		if err != nil && minio.ToErrorResponse(err).Code != "NoSuchKey" {
+			return err
+		}
+		return nil
+	}
+
+	output, err := json.Marshal(uploads)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey("uploads.json"), bytes.NewReader(output), int64(len(output)), s.getJsonPutOptions())
+	return err
+}
+
+//Files are uploaded concurrently so changes to the uploads file are serialized
+func (s S3Store) updateUploads(fn func(uploads s3Uploads)) error {
+	(*s.uploadsMutex).Lock()
+	defer (*s.uploadsMutex).Unlock()
+
+	uploads, err := s.loadUploads()
+	if err != nil {
+		return err
+	}
+
+	fn(uploads)
+	return s.storeUploads(uploads)
+}
+
+func (s S3Store) loadUpload(fPath string) (*s3Upload, bool, error) {
+	(*s.uploadsMutex).Lock()
+	defer (*s.uploadsMutex).Unlock()
+
+	uploads, err := s.loadUploads()
+	if err != nil {
+		return nil, false, err
+	}
+
+	upload, ok := uploads[fPath]
+	if !ok {
+		return nil, false, nil
+	}
+	return &upload, true, nil
+}
+
+func (s S3Store) storeUpload(upload *s3Upload) error {
+	return s.updateUploads(func(uploads s3Uploads) {
+		uploads[(*upload).Key] = *upload
+	})
+}
+
+func (s S3Store) removeUpload(fPath string) error {
+	return s.updateUploads(func(uploads s3Uploads) {
+		delete(uploads, fPath)
+	})
+}
+
+//Aborts the upload on s3 and forgets about it. An upload s3 doesn't know anymore is not an error.
+func (s S3Store) abortUpload(upload *s3Upload) error {
+	configs := *s.configs
+
+	err := s.getCore().AbortMultipartUpload(context.Background(), configs.Bucket, s.getKey((*upload).Key), (*upload).UploadId)
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+		return err
+	}
+
+	return s.removeUpload((*upload).Key)
+}
+
+func (s S3Store) uploadMultipartFile(source io.ReadCloser, file manifest.FileInfo, fPath string, partSize int64) (string, error) {
+	configs := *s.configs
+	fn := fmt.Sprintf("uploadMultipartFile(source=..., gameId=%d, kind=%s, name=%s, ...)", file.Game.Id, file.Kind, file.Name)
+
+	previous, exists, err := s.loadUpload(fPath)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		err = s.abortUpload(previous)
+		if err != nil {
+			msg := fmt.Sprintf("%s -> Error occured while aborting previous upload: %s", fn, err.Error())
+			return "", errors.New(msg)
+		}
+	}
+
+	uploadId, err := s.getCore().NewMultipartUpload(context.Background(), configs.Bucket, s.getKey(fPath), s.getFilePutOptions(file))
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Error occured while starting the upload: %s", fn, err.Error())
+		return "", errors.New(msg)
+	}
+
+	upload := s3Upload{
+		Key:      fPath,
+		UploadId: uploadId,
+		Size:     file.Size,
+		PartSize: partSize,
+		Parts:    []s3UploadPart{},
+	}
+	err = s.storeUpload(&upload)
+	if err != nil {
+		return "", err
+	}
+
+	return s.uploadParts(source, &upload)
+}
+
+//Uploads the parts after the ones already completed and records each one before moving on to the next.
+//Returns the checksum of the whole file.
+func (s S3Store) uploadParts(source io.ReadCloser, upload *s3Upload) (string, error) {
+	configs := *s.configs
+	core := s.getCore()
+	fn := fmt.Sprintf("uploadParts(source=..., upload={Key=%s, ...})", (*upload).Key)
+
+	h, err := upload.getHash()
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, (*upload).PartSize)
+	offset := upload.getOffset()
+	for offset < (*upload).Size {
+		partSize := (*upload).PartSize
+		if (*upload).Size-offset < partSize {
+			partSize = (*upload).Size - offset
+		}
+
+		_, err := io.ReadFull(source, buf[:partSize])
+		if err != nil {
+			msg := fmt.Sprintf("%s -> Error occured while reading the source at offset %d: %s", fn, offset, err.Error())
+			return "", errors.New(msg)
+		}
+
+		partChecksum := md5.Sum(buf[:partSize])
+		number := len((*upload).Parts) + 1
+		part, err := core.PutObjectPart(context.Background(), configs.Bucket, s.getKey((*upload).Key), (*upload).UploadId, number, bytes.NewReader(buf[:partSize]), partSize, base64.StdEncoding.EncodeToString(partChecksum[:]), "", nil)
+		if err != nil {
+			msg := fmt.Sprintf("%s -> Error occured while uploading part %d: %s", fn, number, err.Error())
+			return "", errors.New(msg)
+		}
+
+		h.Write(buf[:partSize])
+		(*upload).Md5State, err = h.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+		(*upload).Parts = append((*upload).Parts, s3UploadPart{Number: number, ETag: part.ETag, Size: partSize})
+		err = s.storeUpload(upload)
+		if err != nil {
+			return "", err
+		}
+		offset += partSize
+		s.logger.Debug(fmt.Sprintf("%s -> Uploaded part %d (%d/%d bytes)", fn, number, offset, (*upload).Size))
+	}
+
+	parts := make([]minio.CompletePart, len((*upload).Parts))
+	for idx, part := range (*upload).Parts {
+		parts[idx] = minio.CompletePart{PartNumber: part.Number, ETag: part.ETag}
+	}
+	_, err = core.CompleteMultipartUpload(context.Background(), configs.Bucket, s.getKey((*upload).Key), (*upload).UploadId, parts, minio.PutObjectOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Error occured while completing the upload: %s", fn, err.Error())
+		return "", errors.New(msg)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), s.removeUpload((*upload).Key)
+}
+
+//Returns the etags of the parts s3 has for the upload by part number
+func (s S3Store) listUploadedParts(upload *s3Upload) (map[int]string, error) {
+	configs := *s.configs
+	uploaded := map[int]string{}
+
+	marker := 0
+	for true {
+		result, err := s.getCore().ListObjectParts(context.Background(), configs.Bucket, s.getKey((*upload).Key), (*upload).UploadId, marker, 1000)
+		if err != nil {
+			return uploaded, err
+		}
+
+		for _, part := range result.ObjectParts {
+			uploaded[part.PartNumber] = strings.Trim(part.ETag, "\"")
+		}
+
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextPartNumberMarker
+	}
+
+	return uploaded, nil
+}
+
+//Returns the number of bytes of the file already uploaded by an interrupted upload.
+//Uploads for a file of a different size or that s3 doesn't know anymore are discarded.
+func (s S3Store) GetUploadOffset(file manifest.FileInfo) (int64, error) {
+	fn := fmt.Sprintf("GetUploadOffset(gameId=%d, kind=%s, name=%s)", file.Game.Id, file.Kind, file.Name)
+
+	fPath, err := getS3FileKey(file)
+	if err != nil {
+		return 0, err
+	}
+
+	upload, exists, err := s.loadUpload(fPath)
+	if err != nil || (!exists) {
+		return 0, err
+	}
+
+	uploaded := map[int]string{}
+	if (*upload).Size == file.Size {
+		uploaded, err = s.listUploadedParts(upload)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchUpload" {
+				s.logger.Debug(fmt.Sprintf("%s -> Discarding upload that no longer exists", fn))
+				return 0, s.removeUpload(fPath)
+			}
+			return 0, err
+		}
+	}
+
+	problem := upload.getResumeProblem(file.Size, uploaded)
+	if problem != "" {
+		s.logger.Debug(fmt.Sprintf("%s -> Discarding upload as the %s", fn, problem))
+		return 0, s.abortUpload(upload)
+	}
+
+	s.logger.Debug(fmt.Sprintf("%s -> Upload can resume at offset %d", fn, upload.getOffset()))
+	return upload.getOffset(), nil
+}
+
+func (s S3Store) ResumeUploadFile(source io.ReadCloser, file manifest.FileInfo, offset int64) (string, error) {
+	fn := fmt.Sprintf("ResumeUploadFile(source=..., gameId=%d, kind=%s, name=%s, offset=%d)", file.Game.Id, file.Kind, file.Name, offset)
+
+	fPath, err := getS3FileKey(file)
+	if err != nil {
+		return "", err
+	}
+
+	upload, exists, err := s.loadUpload(fPath)
+	if err != nil {
+		return "", err
+	}
+	if (!exists) || upload.getOffset() != offset {
+		msg := fmt.Sprintf("%s -> No upload to resume at this offset", fn)
+		return "", errors.New(msg)
+	}
+
+	checksum, err := s.uploadParts(source, upload)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, err = s.verifyUploadedFile(file, fPath, checksum)
+	if err != nil {
+		return "", err
+	}
+
+	s.logger.Debug(fmt.Sprintf("%s -> Uploaded file", fn))
+	return checksum, nil
+}
+
+//Aborts all the incomplete multipart uploads under the prefix of the store, including those whose state was never recorded
+func (s S3Store) RemoveIncompleteUploads() error {
+	configs := *s.configs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	aborted := 0
+	for info := range s.client.ListIncompleteUploads(ctx, configs.Bucket, s.getKey(""), true) {
+		if info.Err != nil {
+			return info.Err
+		}
+
+		err := s.getCore().AbortMultipartUpload(context.Background(), configs.Bucket, info.Key, info.UploadID)
+		if err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+			return err
+		}
+		aborted++
+	}
+
+	err := s.updateUploads(func(uploads s3Uploads) {
+		for key, _ := range uploads {
+			delete(uploads, key)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Debug(fmt.Sprintf("RemoveIncompleteUploads() -> Aborted %d incomplete uploads", aborted))
+	return nil
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"gogcli/manifest"
+	"testing"
+)
+
+func TestS3StoreGetPartSize(t *testing.T) {
+	mib := int64(1024 * 1024)
+	tests := []struct {
+		configured int64
+		size       int64
+		expected   int64
+	}{
+		{0, 10 * mib, 64 * mib},
+		{8, 10 * mib, 8 * mib},
+		{8, 8 * mib * maxS3Parts, 8 * mib},
+		{8, 8*mib*maxS3Parts + 1, 9 * mib},
+		{0, 500 * 1024 * mib, 64 * mib},
+		{0, 1000 * 1024 * mib, 103 * mib},
+		{5, 100 * 1024 * mib, 11 * mib},
+	}
+
+	for _, test := range tests {
+		s := S3Store{configs: &S3Configs{PartSize: test.configured}}
+		partSize := s.getPartSize(test.size)
+		if partSize != test.expected {
+			t.Errorf("Expected a part size of %d for a file of %d bytes with a configured part size of %d MiB and got %d", test.expected, test.size, test.configured, partSize)
+		}
+		if (test.size+partSize-1)/partSize > maxS3Parts {
+			t.Errorf("Part size of %d for a file of %d bytes needs more parts than s3 allows", partSize, test.size)
+		}
+	}
+}
+
+func TestS3UploadResumeState(t *testing.T) {
+	content := []byte("0123456789abcdefghij")
+	upload := s3Upload{Key: "1/installers/setup.exe", UploadId: "upload", Size: int64(len(content)), PartSize: 8, Parts: []s3UploadPart{}}
+
+	h, err := upload.getHash()
+	if err != nil {
+		t.Fatalf("Getting the hash of a new upload failed: %s", err.Error())
+	}
+	h.Write(content[:8])
+	upload.Md5State, _ = h.(encoding.BinaryMarshaler).MarshalBinary()
+	upload.Parts = append(upload.Parts, s3UploadPart{Number: 1, ETag: "\"etag1\"", Size: 8})
+
+	//The state goes through the uploads file before being resumed
+	output, _ := json.Marshal(s3Uploads{upload.Key: upload})
+	var uploads s3Uploads
+	err = json.Unmarshal(output, &uploads)
+	if err != nil {
+		t.Fatalf("Could not read back the uploads state: %s", err.Error())
+	}
+	resumed, ok := uploads[upload.Key]
+	if !ok || resumed.getOffset() != 8 || resumed.UploadId != "upload" {
+		t.Fatalf("Expected the upload to resume at offset 8 and got %v", resumed)
+	}
+
+	h, err = resumed.getHash()
+	if err != nil {
+		t.Fatalf("Getting the hash of a resumed upload failed: %s", err.Error())
+	}
+	h.Write(content[8:])
+	expected := md5.Sum(content)
+	if hex.EncodeToString(h.Sum(nil)) != hex.EncodeToString(expected[:]) {
+		t.Errorf("Expected the resumed hash to be the checksum of the whole file")
+	}
+
+	resumed.Md5State = []byte("corrupted")
+	_, err = resumed.getHash()
+	if err == nil {
+		t.Errorf("Expected a corrupted checksum state to be an error")
+	}
+}
+
+func TestS3UploadGetResumeProblem(t *testing.T) {
+	upload := s3Upload{
+		Size:     20,
+		PartSize: 8,
+		Parts:    []s3UploadPart{s3UploadPart{Number: 1, ETag: "\"etag1\"", Size: 8}, s3UploadPart{Number: 2, ETag: "etag2", Size: 8}},
+	}
+
+	if problem := upload.getResumeProblem(20, map[int]string{1: "etag1", 2: "etag2", 3: "etag3"}); problem != "" {
+		t.Errorf("Expected an upload with all its parts on s3 to be resumable and got: %s", problem)
+	}
+	if problem := upload.getResumeProblem(21, map[int]string{1: "etag1", 2: "etag2"}); problem == "" {
+		t.Errorf("Expected an upload for a file of another size not to be resumable")
+	}
+	if problem := upload.getResumeProblem(20, map[int]string{1: "etag1"}); problem == "" {
+		t.Errorf("Expected an upload missing a part on s3 not to be resumable")
+	}
+	if problem := upload.getResumeProblem(20, map[int]string{1: "etag1", 2: "other"}); problem == "" {
+		t.Errorf("Expected an upload with a part s3 has another version of not to be resumable")
+	}
+}
+
+func TestGetS3FileKey(t *testing.T) {
+	key, err := getS3FileKey(manifest.FileInfo{Game: manifest.GameInfo{Id: 12}, Kind: "extra", Name: "manual.pdf"})
+	if err != nil || key != "12/extras/manual.pdf" {
+		t.Errorf("Unexpected key %s for an extra", key)
+	}
+
+	_, err = getS3FileKey(manifest.FileInfo{Game: manifest.GameInfo{Id: 12}, Kind: "image", Name: "logo.png"})
+	if err == nil {
+		t.Errorf("Expected an unknown kind of file to be an error")
+	}
+}
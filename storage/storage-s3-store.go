@@ -16,26 +16,39 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 )
 
+//PartSize is in MiB and defaults to 64.
+//StorageClasses map the installer, extra, depot, image and manifest (for the json files) kinds of objects to a storage class.
+//RestoreDays and RestoreTier apply to restores of archived objects and default to 7 and Standard.
 type S3Configs struct {
-	Endpoint  string
-	Region    string
-	Bucket    string
-	Tls       bool
-	AccessKey string
-	SecretKey string
+	Endpoint       string
+	Region         string
+	Bucket         string
+	Tls            bool
+	AccessKey      string
+	SecretKey      string
+	PartSize       int64
+	Prefix         string
+	StorageClasses map[string]string
+	Tags           bool
+	RestoreDays    int
+	RestoreTier    string
 }
 
 type S3Store struct {
-	client  *minio.Client
-	configs *S3Configs
-	logger  *logging.Logger
+	client       *minio.Client
+	configs      *S3Configs
+	logger       *logging.Logger
+	uploadsMutex *sync.Mutex
 }
 
 func GetS3StoreFromConfigFile(path string, logSource *logging.Source, tag string) (S3Store, error) {
@@ -43,12 +56,12 @@ func GetS3StoreFromConfigFile(path string, logSource *logging.Source, tag string
 
 	bs, err := ioutil.ReadFile(path)
 	if err != nil {
-		return S3Store{nil, nil, nil}, err
+		return S3Store{nil, nil, nil, nil}, err
 	}
 
 	err = json.Unmarshal(bs, &configs)
 	if err != nil {
-		return S3Store{nil, nil, nil}, err
+		return S3Store{nil, nil, nil, nil}, err
 	}
 
 	return getS3Store(&configs, logSource, tag)
@@ -57,7 +70,7 @@ func GetS3StoreFromConfigFile(path string, logSource *logging.Source, tag string
 func GetS3StoreFromSource(s Source, logSource *logging.Source, tag string) (S3Store, error) {
 	if s.Type != "s3" {
 		msg := fmt.Sprintf("Cannot load S3 store from source of type %s", s.Type)
-		return S3Store{nil, nil, nil}, errors.New(msg)
+		return S3Store{nil, nil, nil, nil}, errors.New(msg)
 	}
 	return getS3Store(&(s.S3Params), logSource, tag)
 }
@@ -78,13 +91,14 @@ func getS3Store(configs *S3Configs, logSource *logging.Source, tag string) (S3St
 
 	if err != nil {
 		msg := fmt.Sprintf("GetS3Store(endpoint=%s, ...) -> Error connecting to the s3 store: %s", (*configs).Endpoint, err.Error())
-		return S3Store{nil, nil, nil}, errors.New(msg)
+		return S3Store{nil, nil, nil, nil}, errors.New(msg)
 	}
 
 	return S3Store{
-		client:  client,
-		configs: configs,
-		logger:  logSource.CreateLogger(os.Stdout, logPrefix, log.Lmsgprefix),
+		client:       client,
+		configs:      configs,
+		logger:       logSource.CreateLogger(os.Stdout, logPrefix, log.Lmsgprefix),
+		uploadsMutex: &sync.Mutex{},
 	}, nil
 }
 
@@ -95,17 +109,18 @@ func (s S3Store) GetGameIds() ([]int64, error) {
 	configs := *s.configs
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	gameFileRegex := regexp.MustCompile(`^(?P<id>\d+)/(?P<kind>(?:installers)|(?:extras))/(?P<file>.+)$`)
+	gameFileRegex := regexp.MustCompile(`^(?P<id>\d+)/(?P<kind>(?:installers)|(?:extras)|(?:depots))/(?P<file>.+)$`)
 
 	objChan := s.client.ListObjects(ctx, configs.Bucket, minio.ListObjectsOptions{
 		Recursive: true,
+		Prefix: s.getKey(""),
 	})
 	for obj := range objChan {
 		if obj.Err != nil {
 			return gameIds, obj.Err
 		}
 
-		match := gameFileRegex.FindStringSubmatch(obj.Key)
+		match := gameFileRegex.FindStringSubmatch(strings.TrimPrefix(obj.Key, s.getKey("")))
 		if len(match) == 0 {
 			continue	
 		}
@@ -132,23 +147,36 @@ func (s S3Store) GetGameFiles(GameId int64) ([]manifest.FileInfo, error) {
 	configs := *s.configs
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	gameFileRegex := regexp.MustCompile(`^(?P<id>\d+)/(?P<kind>(?:installers)|(?:extras))/(?P<file>.+)$`)
+	gameFileRegex := regexp.MustCompile(`^(?P<id>\d+)/(?P<kind>(?:installers)|(?:extras)|(?:depots))/(?P<file>.+)$`)
 
 	objChan := s.client.ListObjects(ctx, configs.Bucket, minio.ListObjectsOptions{
 		Recursive: true,
-		Prefix: fmt.Sprintf("%d/", GameId),
+		Prefix: s.getKey(fmt.Sprintf("%d/", GameId)),
 	})
 	for obj := range objChan {
 		if obj.Err != nil {
 			return fileInfos, obj.Err
 		}
 
-		match := gameFileRegex.FindStringSubmatch(obj.Key)
+		match := gameFileRegex.FindStringSubmatch(strings.TrimPrefix(obj.Key, s.getKey("")))
+		if match == nil {
+			continue
+		}
+
 		if match[2] == "installers" {
 			fileInfo := manifest.FileInfo{
 				Game: gameInfo, 
 				Name: match[3], 
 				Kind: "installer",
+				Size: obj.Size,
+			}
+			fileInfos = append(fileInfos, fileInfo)
+		} else if match[2] == "depots" {
+			fileInfo := manifest.FileInfo{
+				Game: gameInfo, 
+				Name: match[3], 
+				Kind: "depot",
+				Size: obj.Size,
 			}
 			fileInfos = append(fileInfos, fileInfo)
 		} else {
@@ -156,6 +184,7 @@ func (s S3Store) GetGameFiles(GameId int64) ([]manifest.FileInfo, error) {
 				Game: gameInfo, 
 				Name: match[3], 
 				Kind: "extra",
+				Size: obj.Size,
 			}
 			fileInfos = append(fileInfos, fileInfo)
 		}
@@ -183,7 +212,7 @@ func (s S3Store) GenerateSource() *Source {
 
 func (s S3Store) GetPrintableSummary() (string, error) {
 	configs := *s.configs
-	return fmt.Sprintf("S3Store{endpoint: %s, region: %s, bucket: %s}", configs.Endpoint, configs.Region, configs.Bucket), nil
+	return fmt.Sprintf("S3Store{endpoint: %s, region: %s, bucket: %s, prefix: %s}", configs.Endpoint, configs.Region, configs.Bucket, configs.Prefix), nil
 }
 
 func (s S3Store) Exists() (bool, error) {
@@ -218,7 +247,7 @@ func (s S3Store) Initialize() error {
 
 func (s S3Store) HasManifest() (bool, error) {
 	configs := *s.configs
-	_, err := s.client.StatObject(context.Background(), configs.Bucket, "manifest.json", minio.StatObjectOptions{})
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey("manifest.json"), minio.StatObjectOptions{})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "NoSuchKey" {
@@ -236,7 +265,7 @@ func (s S3Store) HasManifest() (bool, error) {
 
 func (s S3Store) HasMetadata() (bool, error) {
 	configs := *s.configs
-	_, err := s.client.StatObject(context.Background(), configs.Bucket, "metadata.json", minio.StatObjectOptions{})
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey("metadata.json"), minio.StatObjectOptions{})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "NoSuchKey" {
@@ -254,7 +283,7 @@ func (s S3Store) HasMetadata() (bool, error) {
 
 func (s S3Store) HasActions() (bool, error) {
 	configs := *s.configs
-	_, err := s.client.StatObject(context.Background(), configs.Bucket, "actions.json", minio.StatObjectOptions{})
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey("actions.json"), minio.StatObjectOptions{})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "NoSuchKey" {
@@ -272,7 +301,7 @@ func (s S3Store) HasActions() (bool, error) {
 
 func (s S3Store) HasSource() (bool, error) {
 	configs := *s.configs
-	_, err := s.client.StatObject(context.Background(), configs.Bucket, "source.json", minio.StatObjectOptions{})
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey("source.json"), minio.StatObjectOptions{})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code == "NoSuchKey" {
@@ -303,7 +332,7 @@ func (s S3Store) StoreManifest(m *manifest.Manifest) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	_, err = s.client.PutObject(context.Background(), configs.Bucket, "manifest.json", bytes.NewReader(output), int64(len(output)), minio.PutObjectOptions{ContentType: "application/json"})
+	_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey("manifest.json"), bytes.NewReader(output), int64(len(output)), s.getJsonPutOptions())
 	if err == nil {
 		s.logger.Debug(fmt.Sprintf("StoreManifest(...) -> Stored manifest with %d games", len((*m).Games)))
 	}
@@ -325,7 +354,7 @@ func (s S3Store) StoreMetadata(m *metadata.Metadata) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	_, err = s.client.PutObject(context.Background(), configs.Bucket, "metadata.json", bytes.NewReader(output), int64(len(output)), minio.PutObjectOptions{ContentType: "application/json"})
+	_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey("metadata.json"), bytes.NewReader(output), int64(len(output)), s.getJsonPutOptions())
 	if err == nil {
 		s.logger.Debug(fmt.Sprintf("StoreMetadata(...) -> Stored metadata with %d games", len((*m).Games)))
 	}
@@ -347,7 +376,7 @@ func (s S3Store) StoreActions(a *manifest.GameActions) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	_, err = s.client.PutObject(context.Background(), configs.Bucket, "actions.json", bytes.NewReader(output), int64(len(output)), minio.PutObjectOptions{ContentType: "application/json"})
+	_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey("actions.json"), bytes.NewReader(output), int64(len(output)), s.getJsonPutOptions())
 	if err == nil {
 		s.logger.Debug(fmt.Sprintf("StoreActions(...) -> Stored actions on %d games", len(*a)))
 	}
@@ -369,7 +398,7 @@ func (s S3Store) StoreSource(o *Source) error {
 	json.Indent(&buf, output, "", "  ")
 	output = buf.Bytes()
 
-	_, err = s.client.PutObject(context.Background(), configs.Bucket, "source.json", bytes.NewReader(output), int64(len(output)), minio.PutObjectOptions{ContentType: "application/json"})
+	_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey("source.json"), bytes.NewReader(output), int64(len(output)), s.getJsonPutOptions())
 	if err == nil {
 		s.logger.Debug(fmt.Sprintf("StoreSource(...) -> Stored source of type %s", o.Type))
 	}
@@ -380,7 +409,7 @@ func (s S3Store) LoadManifest() (*manifest.Manifest, error) {
 	var m manifest.Manifest
 	configs := *s.configs
 
-	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, "manifest.json", minio.GetObjectOptions{})
+	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, s.getKey("manifest.json"), minio.GetObjectOptions{})
 	if err != nil {
 		return &m, err
 	}
@@ -403,7 +432,7 @@ func (s S3Store) LoadMetadata() (*metadata.Metadata, error) {
 	var m metadata.Metadata
 	configs := *s.configs
 
-	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, "metadata.json", minio.GetObjectOptions{})
+	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, s.getKey("metadata.json"), minio.GetObjectOptions{})
 	if err != nil {
 		return &m, err
 	}
@@ -426,7 +455,7 @@ func (s S3Store) LoadActions() (*manifest.GameActions, error) {
 	var a *manifest.GameActions
 	configs := *s.configs
 
-	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, "actions.json", minio.GetObjectOptions{})
+	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, s.getKey("actions.json"), minio.GetObjectOptions{})
 	if err != nil {
 		return a, err
 	}
@@ -449,7 +478,7 @@ func (s S3Store) LoadSource() (*Source, error) {
 	var o *Source
 	configs := *s.configs
 
-	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, "source.json", minio.GetObjectOptions{})
+	objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, s.getKey("source.json"), minio.GetObjectOptions{})
 	if err != nil {
 		return o, err
 	}
@@ -477,7 +506,7 @@ func (s S3Store) RemoveActions() error {
 	}
 
 	if has {
-		err = s.client.RemoveObject(context.Background(), configs.Bucket, "actions.json", minio.RemoveObjectOptions{})
+		err = s.client.RemoveObject(context.Background(), configs.Bucket, s.getKey("actions.json"), minio.RemoveObjectOptions{})
 	}
 	if err == nil {
 		s.logger.Debug("RemoveActions(...) -> Removed actions file")
@@ -494,7 +523,7 @@ func (s S3Store) RemoveSource() error {
 	}
 
 	if has {
-		err = s.client.RemoveObject(context.Background(), configs.Bucket, "source.json", minio.RemoveObjectOptions{})
+		err = s.client.RemoveObject(context.Background(), configs.Bucket, s.getKey("source.json"), minio.RemoveObjectOptions{})
 	}
 	if err == nil {
 		s.logger.Debug("RemoveSource(...) -> Removed source file")
@@ -502,6 +531,101 @@ func (s S3Store) RemoveSource() error {
 	return err
 }
 
+//S3 objects cannot be appended to, so each journal entry is its own object, named to be listed in order
+func (s S3Store) AppendJournal(entry manifest.JournalEntry) error {
+	configs := *s.configs
+
+	output, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("journal/%020d.json", time.Now().UnixNano())
+	_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey(key), bytes.NewReader(output), int64(len(output)), s.getJsonPutOptions())
+	if err != nil {
+		msg := fmt.Sprintf("AppendJournal(gameId=%d) -> Error occured while appending to the journal: %s", entry.Game.Id, err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("AppendJournal(gameId=%d) -> Appended entry to the journal", entry.Game.Id))
+	return nil
+}
+
+func (s S3Store) getJournalKeys() ([]string, error) {
+	keys := []string{}
+	configs := *s.configs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	objChan := s.client.ListObjects(ctx, configs.Bucket, minio.ListObjectsOptions{
+		Recursive: true,
+		Prefix:    s.getKey("journal/"),
+	})
+	for obj := range objChan {
+		if obj.Err != nil {
+			return keys, obj.Err
+		}
+		keys = append(keys, obj.Key)
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s S3Store) LoadJournal() ([]manifest.JournalEntry, error) {
+	entries := []manifest.JournalEntry{}
+	configs := *s.configs
+
+	keys, err := s.getJournalKeys()
+	if err != nil {
+		msg := fmt.Sprintf("LoadJournal() -> Error occured while listing the journal entries: %s", err.Error())
+		return entries, errors.New(msg)
+	}
+
+	for _, key := range keys {
+		objPtr, err := s.client.GetObject(context.Background(), configs.Bucket, key, minio.GetObjectOptions{})
+		if err != nil {
+			return entries, err
+		}
+
+		bs, err := ioutil.ReadAll(objPtr)
+		objPtr.Close()
+		if err != nil {
+			return entries, err
+		}
+
+		var entry manifest.JournalEntry
+		err = json.Unmarshal(bs, &entry)
+		if err != nil {
+			msg := fmt.Sprintf("LoadJournal() -> Journal entry %s is corrupted: %s", key, err.Error())
+			return entries, errors.New(msg)
+		}
+		entries = append(entries, entry)
+	}
+
+	s.logger.Debug(fmt.Sprintf("LoadJournal() -> Loaded %d journal entries", len(entries)))
+	return entries, nil
+}
+
+func (s S3Store) RemoveJournal() error {
+	configs := *s.configs
+
+	keys, err := s.getJournalKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err = s.client.RemoveObject(context.Background(), configs.Bucket, key, minio.RemoveObjectOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	s.logger.Debug(fmt.Sprintf("RemoveJournal() -> Removed %d journal entries", len(keys)))
+	return nil
+}
+
 func (s S3Store) AddGame(game manifest.GameInfo) error {
 	s.logger.Debug(fmt.Sprintf("AddGame(game={Id=%d, ...}) -> No-op as s3 store doesn't have a real directory structure", game.Id))
 	return nil
@@ -512,40 +636,71 @@ func (s S3Store) RemoveGame(game manifest.GameInfo) error {
 	return nil
 }
 
+//Files larger than the part size are uploaded in parts that are recorded as they complete, see storage-s3-multipart.go
 func (s S3Store) UploadFile(source io.ReadCloser, file manifest.FileInfo) (string, error) {
 	configs := *s.configs
 
-	var fPath string
-	if file.Kind == "installer" {
-		arr := []string{strconv.FormatInt(file.Game.Id, 10), "installers", file.Name}
-		fPath = strings.Join(arr, "/")
-	} else if file.Kind == "extra" {
-		arr := []string{strconv.FormatInt(file.Game.Id, 10), "extras", file.Name}
-		fPath = strings.Join(arr, "/")
+	fPath, err := getS3FileKey(file)
+	if err != nil {
+		return "", err
+	}
+
+	var checksum string
+	partSize := s.getPartSize(file.Size)
+	if file.Size > partSize {
+		checksum, err = s.uploadMultipartFile(source, file, fPath, partSize)
 	} else {
-		return "", errors.New("Unknown kind of file")
+		h := md5.New()
+		_, err = s.client.PutObject(context.Background(), configs.Bucket, s.getKey(fPath), io.TeeReader(source, h), file.Size, s.getFilePutOptions(file))
+		checksum = hex.EncodeToString(h.Sum(nil))
+	}
+	if err != nil {
+		return "", err
 	}
 
-	_, err := s.client.PutObject(context.Background(), configs.Bucket, fPath, source, file.Size, minio.PutObjectOptions{})
+	checksum, err = s.verifyUploadedFile(file, fPath, checksum)
 	if err != nil {
 		return "", err
 	}
 
-	downloadHandle, size, downErr := s.DownloadFile(file)
-	if downErr != nil {
-		return "", downErr
+	s.logger.Debug(fmt.Sprintf("UploadFile(source=..., gameId=%d, kind=%s, name=%s) -> Uploaded file", file.Game.Id, file.Kind, file.Name))
+	return checksum, nil
+}
+
+//Uploaded files are downloaded back to compute their checksum, except for archived ones which cannot be downloaded
+//and keep the checksum computed during the upload
+func (s S3Store) verifyUploadedFile(file manifest.FileInfo, fPath string, uploadChecksum string) (string, error) {
+	configs := *s.configs
+
+	var size int64
+	checksum := uploadChecksum
+	if isArchiveStorageClass(s.getStorageClass(file.Kind)) {
+		fi, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey(fPath), minio.StatObjectOptions{})
+		if err != nil {
+			return "", err
+		}
+		size = fi.Size
+	} else {
+		downloadHandle, downloadSize, downErr := s.DownloadFile(file)
+		if downErr != nil {
+			return "", downErr
+		}
+		defer downloadHandle.Close()
+		h := md5.New()
+		_, err := io.Copy(h, downloadHandle)
+		if err != nil {
+			msg := fmt.Sprintf("verifyUploadedFile(gameId=%d, kind=%s, name=%s) -> Error occured while downloading the uploaded file: %s", file.Game.Id, file.Kind, file.Name, err.Error())
+			return "", errors.New(msg)
+		}
+		checksum = hex.EncodeToString(h.Sum(nil))
+		size = downloadSize
 	}
-	defer downloadHandle.Close()
-	h := md5.New()
-	io.Copy(h, downloadHandle)
-	checksum := hex.EncodeToString(h.Sum(nil))
 
 	if size != file.Size {
 		msg := fmt.Sprintf("Object %s has a size of %d which doesn't match expected size of %d", fPath, size, file.Size)
 		return "", errors.New(msg)
 	}
 
-	s.logger.Debug(fmt.Sprintf("UploadFile(source=..., gameId=%d, kind=%s, name=%s) -> Uploaded file", file.Game.Id, file.Kind, file.Name))
 	return checksum, nil
 }
 
@@ -559,18 +714,21 @@ func (s S3Store) RemoveFile(file manifest.FileInfo) error {
 	} else if file.Kind == "extra" {
 		arr := []string{strconv.FormatInt(file.Game.Id, 10), "extras", file.Name}
 		oPath = strings.Join(arr, "/")
+	} else if file.Kind == "depot" {
+		arr := []string{strconv.FormatInt(file.Game.Id, 10), "depots", file.Name}
+		oPath = strings.Join(arr, "/")
 	} else {
 		return errors.New("Unknown kind of file")
 	}
 
-	_, err := s.client.StatObject(context.Background(), configs.Bucket, oPath, minio.StatObjectOptions{})
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey(oPath), minio.StatObjectOptions{})
 	if err != nil {
 		errResponse := minio.ToErrorResponse(err)
 		if errResponse.Code != "NoSuchKey" {
 			return err
 		}
 	} else {
-		err := s.client.RemoveObject(context.Background(), configs.Bucket, oPath, minio.RemoveObjectOptions{})
+		err := s.client.RemoveObject(context.Background(), configs.Bucket, s.getKey(oPath), minio.RemoveObjectOptions{})
 		if err != nil {
 			return err
 		}
@@ -590,19 +748,27 @@ func (s S3Store) DownloadFile(file manifest.FileInfo) (io.ReadCloser, int64, err
 	} else if file.Kind == "extra" {
 		arr := []string{strconv.FormatInt(file.Game.Id, 10), "extras", file.Name}
 		fPath = strings.Join(arr, "/")
+	} else if file.Kind == "depot" {
+		arr := []string{strconv.FormatInt(file.Game.Id, 10), "depots", file.Name}
+		fPath = strings.Join(arr, "/")
 	} else {
 		msg := fmt.Sprintf("DownloadFile(gameId=%d, kind=%s, name=%s) -> Unknown kind of file", file.Game.Id, file.Kind, file.Name)
 		return nil, 0, errors.New(msg)
 	}
 
-	fi, err := s.client.StatObject(context.Background(), configs.Bucket, fPath, minio.StatObjectOptions{})
+	fi, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey(fPath), minio.StatObjectOptions{})
 	if err != nil {
 		msg := fmt.Sprintf("DownloadFile(gameId=%d, kind=%s, name=%s) -> Error occured while retrieving file size: %s", file.Game.Id, file.Kind, file.Name, err.Error())
 		return nil, 0, errors.New(msg)
 	}
 	size := fi.Size
 
-	downloadHandle, openErr := s.client.GetObject(context.Background(), configs.Bucket, fPath, minio.GetObjectOptions{})
+	err = s.ensureRestored(s.getKey(fPath), fi)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	downloadHandle, openErr := s.client.GetObject(context.Background(), configs.Bucket, s.getKey(fPath), minio.GetObjectOptions{})
 	if openErr != nil {
 		msg := fmt.Sprintf("DownloadFile(gameId=%d, kind=%s, name=%s) -> Error occured while opening file for download: %s", file.Game.Id, file.Kind, file.Name, openErr.Error())
 		return nil, 0, errors.New(msg)
@@ -612,17 +778,114 @@ func (s S3Store) DownloadFile(file manifest.FileInfo) (io.ReadCloser, int64, err
 	return downloadHandle, size, nil
 }
 
-//TODO
+func (s S3Store) GetFileLink(file manifest.FileInfo) (string, error) {
+	configs := *s.configs
+
+	var fPath string
+	if file.Kind == "installer" {
+		arr := []string{strconv.FormatInt(file.Game.Id, 10), "installers", file.Name}
+		fPath = strings.Join(arr, "/")
+	} else if file.Kind == "extra" {
+		arr := []string{strconv.FormatInt(file.Game.Id, 10), "extras", file.Name}
+		fPath = strings.Join(arr, "/")
+	} else if file.Kind == "depot" {
+		arr := []string{strconv.FormatInt(file.Game.Id, 10), "depots", file.Name}
+		fPath = strings.Join(arr, "/")
+	} else {
+		msg := fmt.Sprintf("GetFileLink(gameId=%d, kind=%s, name=%s) -> Unknown kind of file", file.Game.Id, file.Kind, file.Name)
+		return "", errors.New(msg)
+	}
+
+	link := s.client.EndpointURL()
+	link.Path = strings.Join([]string{"", configs.Bucket, s.getKey(fPath)}, "/")
+	return link.String(), nil
+}
+
 func (s S3Store) UploadImage(source io.ReadCloser, image metadata.GameMetadataImage) (string, error) {
-	return "", nil 
+	configs := *s.configs
+	oPath := strings.Join([]string{"images", image.Tag, image.Name}, "/")
+
+	size := image.Size
+	if size <= 0 {
+		size = -1
+	}
+
+	h := md5.New()
+	_, err := s.client.PutObject(context.Background(), configs.Bucket, s.getKey(oPath), io.TeeReader(source, h), size, s.getImagePutOptions())
+	if err != nil {
+		return "", err
+	}
+
+	//Archived images cannot be downloaded back
+	if isArchiveStorageClass(s.getStorageClass("image")) {
+		s.logger.Debug(fmt.Sprintf("UploadImage(source=..., tag=%s, name=%s) -> Uploaded image", image.Tag, image.Name))
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	downloadHandle, downloadSize, downErr := s.DownloadImage(image)
+	if downErr != nil {
+		return "", downErr
+	}
+	defer downloadHandle.Close()
+	h = md5.New()
+	_, err = io.Copy(h, downloadHandle)
+	if err != nil {
+		msg := fmt.Sprintf("UploadImage(source=..., tag=%s, name=%s) -> Error occured while downloading the uploaded image: %s", image.Tag, image.Name, err.Error())
+		return "", errors.New(msg)
+	}
+	checksum := hex.EncodeToString(h.Sum(nil))
+
+	if image.Size > 0 && downloadSize != image.Size {
+		msg := fmt.Sprintf("Object %s has a size of %d which doesn't match expected size of %d", oPath, downloadSize, image.Size)
+		return "", errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("UploadImage(source=..., tag=%s, name=%s) -> Uploaded image", image.Tag, image.Name))
+	return checksum, nil
 }
 
-//TODO
 func (s S3Store) RemoveImage(image metadata.GameMetadataImage) error {
+	configs := *s.configs
+	oPath := strings.Join([]string{"images", image.Tag, image.Name}, "/")
+
+	_, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey(oPath), minio.StatObjectOptions{})
+	if err != nil {
+		errResponse := minio.ToErrorResponse(err)
+		if errResponse.Code != "NoSuchKey" {
+			return err
+		}
+	} else {
+		err := s.client.RemoveObject(context.Background(), configs.Bucket, s.getKey(oPath), minio.RemoveObjectOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	s.logger.Debug(fmt.Sprintf("RemoveImage(tag=%s, name=%s) -> Removed image", image.Tag, image.Name))
 	return nil
 }
 
-//TODO
 func (s S3Store) DownloadImage(image metadata.GameMetadataImage) (io.ReadCloser, int64, error) {
-	return nil, 0, nil
-}
\ No newline at end of file
+	configs := *s.configs
+	oPath := strings.Join([]string{"images", image.Tag, image.Name}, "/")
+
+	fi, err := s.client.StatObject(context.Background(), configs.Bucket, s.getKey(oPath), minio.StatObjectOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("DownloadImage(tag=%s, name=%s) -> Error occured while retrieving image size: %s", image.Tag, image.Name, err.Error())
+		return nil, 0, errors.New(msg)
+	}
+
+	err = s.ensureRestored(s.getKey(oPath), fi)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	downloadHandle, openErr := s.client.GetObject(context.Background(), configs.Bucket, s.getKey(oPath), minio.GetObjectOptions{})
+	if openErr != nil {
+		msg := fmt.Sprintf("DownloadImage(tag=%s, name=%s) -> Error occured while opening image for download: %s", image.Tag, image.Name, openErr.Error())
+		return nil, 0, errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("DownloadImage(tag=%s, name=%s) -> Fetched image download handle", image.Tag, image.Name))
+	return downloadHandle, fi.Size, nil
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestS3StoreGetGameFiles(t *testing.T) {
+	s := newTestS3Store(t, S3Configs{Prefix: "gog"}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("prefix") != "gog/1/" {
+			t.Errorf("Expected the objects of the game to be listed under the prefix and got %s", r.URL.Query().Get("prefix"))
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+<Name>games</Name><Prefix>gog/1/</Prefix><KeyCount>4</KeyCount><MaxKeys>1000</MaxKeys><IsTruncated>false</IsTruncated>
+<Contents><Key>gog/1/installers/setup.exe</Key><Size>10</Size></Contents>
+<Contents><Key>gog/1/extras/manual.pdf</Key><Size>5</Size></Contents>
+<Contents><Key>gog/1/depots/depot.tar</Key><Size>20</Size></Contents>
+<Contents><Key>gog/1/notes.txt</Key><Size>2</Size></Contents>
+</ListBucketResult>`))
+	})
+
+	files, err := s.GetGameFiles(1)
+	if err != nil {
+		t.Fatalf("Listing the files of the game failed: %s", err.Error())
+	}
+	if len(files) != 3 {
+		t.Fatalf("Expected the objects outside of the file directories to be skipped and got %v", files)
+	}
+	kinds := map[string]string{"setup.exe": "installer", "manual.pdf": "extra", "depot.tar": "depot"}
+	for _, file := range files {
+		if kinds[file.Name] != file.Kind || file.Game.Id != 1 {
+			t.Errorf("Expected %s to be listed as a file of kind %s of the game and got %v", file.Name, kinds[file.Name], file)
+		}
+	}
+}
@@ -0,0 +1,697 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"gogcli/metadata"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	_ "modernc.org/sqlite"
+)
+
+const sqliteDbName = "gogcli.db"
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS documents (
+	name TEXT PRIMARY KEY,
+	data TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS manifest_games (
+	id            INTEGER PRIMARY KEY,
+	position      INTEGER NOT NULL,
+	title         TEXT NOT NULL,
+	titles        TEXT NOT NULL,
+	verified_size INTEGER NOT NULL,
+	data          TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS manifest_games_position ON manifest_games(position);
+CREATE INDEX IF NOT EXISTS manifest_games_title ON manifest_games(title);
+CREATE TABLE IF NOT EXISTS metadata_games (
+	id       INTEGER PRIMARY KEY,
+	position INTEGER NOT NULL,
+	title    TEXT NOT NULL,
+	data     TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS metadata_games_position ON metadata_games(position);
+CREATE TABLE IF NOT EXISTS actions (
+	game_id INTEGER PRIMARY KEY,
+	data    TEXT NOT NULL
+);
+`
+
+//Everything in the manifest but its games, which are stored in their own rows
+type sqliteManifestHeader struct {
+	EstimatedSize  string
+	VerifiedSize   int64
+	Filter         *manifest.ManifestFilter
+	ProtectedFiles manifest.ProtectedManifestFiles
+}
+
+type sqliteDb struct {
+	db   *sql.DB
+	err  error
+	once sync.Once
+}
+
+//Storage keeping its files on the file system like the fs storage, but its manifest, metadata and actions in a sqlite database
+type SqliteStore struct {
+	FileSystem
+	logger *logging.Logger
+	index  *sqliteDb
+}
+
+func GetSqliteStoreFromSource(s Source, logSource *logging.Source, tag string) (SqliteStore, error) {
+	if s.Type != "sqlite" {
+		msg := fmt.Sprintf("Cannot load sqlite store from source of type %s", s.Type)
+		return SqliteStore{}, errors.New(msg)
+	}
+	return GetSqliteStore(s.FsPath, logSource, tag), nil
+}
+
+func GetSqliteStore(path string, logSource *logging.Source, tag string) SqliteStore {
+	var logPrefix string
+	if tag == "" {
+		logPrefix = "[sqlite] "
+	} else {
+		logPrefix = fmt.Sprintf("[sqlite-%s] ", tag)
+	}
+	return SqliteStore{
+		FileSystem: GetFileSystem(path, logSource, tag),
+		logger:     logSource.CreateLogger(os.Stdout, logPrefix, log.Lmsgprefix),
+		index:      &sqliteDb{},
+	}
+}
+
+func (s SqliteStore) getDbPath() string {
+	return path.Join(s.Path, sqliteDbName)
+}
+
+func (s SqliteStore) hasDb() (bool, error) {
+	_, err := os.Stat(s.getDbPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+//The database is opened and its schema created on first use, as the storage directory may not exist before
+func (s SqliteStore) getDb() (*sql.DB, error) {
+	(*s.index).once.Do(func() {
+		db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)", s.getDbPath()))
+		if err != nil {
+			(*s.index).err = errors.New(fmt.Sprintf("getDb() -> Error opening the database: %s", err.Error()))
+			return
+		}
+		db.SetMaxOpenConns(1)
+
+		_, err = db.Exec(sqliteSchema)
+		if err != nil {
+			db.Close()
+			(*s.index).err = errors.New(fmt.Sprintf("getDb() -> Error creating the database schema: %s", err.Error()))
+			return
+		}
+
+		(*s.index).db = db
+	})
+	return (*s.index).db, (*s.index).err
+}
+
+//The database is opened again if the store is used after it is closed
+func (s SqliteStore) Close() error {
+	db := (*s.index).db
+	if db == nil {
+		return nil
+	}
+
+	*s.index = sqliteDb{}
+	err := db.Close()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Close() -> Error closing the database: %s", err.Error()))
+	}
+	return nil
+}
+
+//Only sqlite stores keep something open between calls
+func CloseStorage(s Storage) error {
+	if sq, ok := s.(SqliteStore); ok {
+		return sq.Close()
+	}
+	return nil
+}
+
+func (s SqliteStore) hasDocument(fn string, name string) (bool, error) {
+	has, err := s.hasDb()
+	if err != nil || (!has) {
+		return false, err
+	}
+
+	db, err := s.getDb()
+	if err != nil {
+		return false, err
+	}
+
+	var count int
+	err = db.QueryRow("SELECT COUNT(*) FROM documents WHERE name = ?", name).Scan(&count)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> The following error occured while ascertaining %s's existance: %s", fn, name, err.Error())
+		return false, errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("%s -> %d %s document found", fn, count, name))
+	return count > 0, nil
+}
+
+func storeDocument(tx *sql.Tx, name string, doc interface{}) error {
+	output, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO documents(name, data) VALUES(?, ?) ON CONFLICT(name) DO UPDATE SET data = excluded.data", name, string(output))
+	return err
+}
+
+func (s SqliteStore) loadDocument(name string, doc interface{}) error {
+	db, err := s.getDb()
+	if err != nil {
+		return err
+	}
+
+	var data string
+	err = db.QueryRow("SELECT data FROM documents WHERE name = ?", name).Scan(&data)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			msg := fmt.Sprintf("Storage does not have a %s", name)
+			return errors.New(msg)
+		}
+		return err
+	}
+
+	return json.Unmarshal([]byte(data), doc)
+}
+
+//Runs the function in a transaction which is committed if it succeeds and rolled back otherwise
+func (s SqliteStore) withTransaction(fn func(tx *sql.Tx) error) error {
+	db, err := s.getDb()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = fn(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func getGameTitles(game *manifest.ManifestGame) string {
+	titles := []string{strings.ToLower((*game).Title)}
+	for _, dlc := range (*game).Dlcs {
+		titles = append(titles, strings.ToLower(dlc.Title))
+	}
+	return strings.Join(titles, "\n")
+}
+
+func storeManifestGame(tx *sql.Tx, game *manifest.ManifestGame, position int) error {
+	output, err := json.Marshal(game)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		"INSERT INTO manifest_games(id, position, title, titles, verified_size, data) VALUES(?, ?, ?, ?, ?, ?) ON CONFLICT(id) DO UPDATE SET position = excluded.position, title = excluded.title, titles = excluded.titles, verified_size = excluded.verified_size, data = excluded.data",
+		(*game).Id,
+		position,
+		(*game).Title,
+		getGameTitles(game),
+		(*game).VerifiedSize,
+		string(output),
+	)
+	return err
+}
+
+func getManifestHeader(m *manifest.Manifest) sqliteManifestHeader {
+	return sqliteManifestHeader{
+		EstimatedSize:  (*m).EstimatedSize,
+		VerifiedSize:   (*m).VerifiedSize,
+		Filter:         &(*m).Filter,
+		ProtectedFiles: (*m).ProtectedFiles,
+	}
+}
+
+func (s SqliteStore) GenerateSource() *Source {
+	src := Source{Type: "sqlite", FsPath: s.Path}
+	return &src
+}
+
+func (s SqliteStore) GetPrintableSummary() (string, error) {
+	return fmt.Sprintf("SqliteStore{Path: %s}", s.Path), nil
+}
+
+func (s SqliteStore) Initialize() error {
+	err := s.FileSystem.Initialize()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getDb()
+	if err != nil {
+		msg := fmt.Sprintf("Initialize() -> Failed to create the database: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("Initialize() -> Database %s created", s.getDbPath()))
+	return nil
+}
+
+func (s SqliteStore) HasManifest() (bool, error) {
+	return s.hasDocument("HasManifest()", "manifest")
+}
+
+func (s SqliteStore) HasMetadata() (bool, error) {
+	return s.hasDocument("HasMetadata()", "metadata")
+}
+
+func (s SqliteStore) HasActions() (bool, error) {
+	return s.hasDocument("HasActions()", "actions")
+}
+
+func (s SqliteStore) HasSource() (bool, error) {
+	return s.hasDocument("HasSource()", "source")
+}
+
+func (s SqliteStore) StoreManifest(m *manifest.Manifest) error {
+	err := s.withTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM manifest_games")
+		if err != nil {
+			return err
+		}
+
+		for idx, _ := range (*m).Games {
+			err = storeManifestGame(tx, &(*m).Games[idx], idx)
+			if err != nil {
+				return err
+			}
+		}
+
+		return storeDocument(tx, "manifest", getManifestHeader(m))
+	})
+
+	if err != nil {
+		msg := fmt.Sprintf("StoreManifest(...) -> Error storing the manifest: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("StoreManifest(...) -> Stored manifest with %d games", len((*m).Games)))
+	return nil
+}
+
+//Only updates the row of the game, which is what makes updates after each file cheap on large manifests
+func (s SqliteStore) StoreManifestGame(m *manifest.Manifest, gameId int64) error {
+	err := s.withTransaction(func(tx *sql.Tx) error {
+		found := false
+		for idx, _ := range (*m).Games {
+			if (*m).Games[idx].Id == gameId {
+				found = true
+				err := storeManifestGame(tx, &(*m).Games[idx], idx)
+				if err != nil {
+					return err
+				}
+				break
+			}
+		}
+
+		if !found {
+			_, err := tx.Exec("DELETE FROM manifest_games WHERE id = ?", gameId)
+			if err != nil {
+				return err
+			}
+		}
+
+		return storeDocument(tx, "manifest", getManifestHeader(m))
+	})
+
+	if err != nil {
+		msg := fmt.Sprintf("StoreManifestGame(..., gameId=%d) -> Error storing the game: %s", gameId, err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("StoreManifestGame(..., gameId=%d) -> Stored game", gameId))
+	return nil
+}
+
+func (s SqliteStore) StoreMetadata(m *metadata.Metadata) error {
+	err := s.withTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM metadata_games")
+		if err != nil {
+			return err
+		}
+
+		for idx, _ := range (*m).Games {
+			output, err := json.Marshal((*m).Games[idx])
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(
+				"INSERT INTO metadata_games(id, position, title, data) VALUES(?, ?, ?, ?)",
+				(*m).Games[idx].Id,
+				idx,
+				(*m).Games[idx].Title,
+				string(output),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		header := *m
+		header.Games = nil
+		return storeDocument(tx, "metadata", header)
+	})
+
+	if err != nil {
+		msg := fmt.Sprintf("StoreMetadata(...) -> Error storing the metadata: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("StoreMetadata(...) -> Stored metadata with %d games", len((*m).Games)))
+	return nil
+}
+
+func storeGameAction(tx *sql.Tx, a *manifest.GameActions, gameId int64) error {
+	action, ok := (*a)[gameId]
+	if !ok {
+		_, err := tx.Exec("DELETE FROM actions WHERE game_id = ?", gameId)
+		return err
+	}
+
+	output, err := json.Marshal(action)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("INSERT INTO actions(game_id, data) VALUES(?, ?) ON CONFLICT(game_id) DO UPDATE SET data = excluded.data", gameId, string(output))
+	return err
+}
+
+func (s SqliteStore) StoreActions(a *manifest.GameActions) error {
+	err := s.withTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM actions")
+		if err != nil {
+			return err
+		}
+
+		for _, gameId := range a.GetGameIds() {
+			err = storeGameAction(tx, a, gameId)
+			if err != nil {
+				return err
+			}
+		}
+
+		return storeDocument(tx, "actions", struct{}{})
+	})
+
+	if err != nil {
+		msg := fmt.Sprintf("StoreActions(...) -> Error storing the actions: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("StoreActions(...) -> Stored actions on %d games", len(*a)))
+	return nil
+}
+
+//Only updates the actions of the game, removing them if the game has none left
+func (s SqliteStore) StoreGameActions(a *manifest.GameActions, gameId int64) error {
+	err := s.withTransaction(func(tx *sql.Tx) error {
+		err := storeGameAction(tx, a, gameId)
+		if err != nil {
+			return err
+		}
+
+		return storeDocument(tx, "actions", struct{}{})
+	})
+
+	if err != nil {
+		msg := fmt.Sprintf("StoreGameActions(..., gameId=%d) -> Error storing the actions: %s", gameId, err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("StoreGameActions(..., gameId=%d) -> Stored actions", gameId))
+	return nil
+}
+
+func (s SqliteStore) StoreSource(src *Source) error {
+	err := s.withTransaction(func(tx *sql.Tx) error {
+		return storeDocument(tx, "source", src)
+	})
+
+	if err != nil {
+		msg := fmt.Sprintf("StoreSource(...) -> Error storing the source: %s", err.Error())
+		return errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("StoreSource(...) -> Stored source of type %s", src.Type))
+	return nil
+}
+
+func (s SqliteStore) loadManifestGames(query string, args ...interface{}) (*manifest.Manifest, error) {
+	var m manifest.Manifest
+	header := sqliteManifestHeader{Filter: &m.Filter}
+	err := s.loadDocument("manifest", &header)
+	if err != nil {
+		return &m, err
+	}
+	m.EstimatedSize = header.EstimatedSize
+	m.VerifiedSize = header.VerifiedSize
+	m.ProtectedFiles = header.ProtectedFiles
+
+	db, err := s.getDb()
+	if err != nil {
+		return &m, err
+	}
+
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return &m, err
+	}
+	defer rows.Close()
+
+	m.Games = []manifest.ManifestGame{}
+	for rows.Next() {
+		var data string
+		err = rows.Scan(&data)
+		if err != nil {
+			return &m, err
+		}
+
+		var game manifest.ManifestGame
+		err = json.Unmarshal([]byte(data), &game)
+		if err != nil {
+			return &m, err
+		}
+		m.Games = append(m.Games, game)
+	}
+
+	return &m, rows.Err()
+}
+
+func (s SqliteStore) LoadManifest() (*manifest.Manifest, error) {
+	m, err := s.loadManifestGames("SELECT data FROM manifest_games ORDER BY position")
+	if err != nil {
+		msg := fmt.Sprintf("LoadManifest() -> Error loading the manifest: %s", err.Error())
+		return m, errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("LoadManifest() -> Loaded manifest with %d games", len((*m).Games)))
+	return m, nil
+}
+
+//Only loads the games whose title or dlc titles contain one of the terms, without decoding the others
+func (s SqliteStore) SearchManifest(titleTerms []string) (*manifest.Manifest, error) {
+	if len(titleTerms) == 0 {
+		return s.LoadManifest()
+	}
+
+	conditions := make([]string, len(titleTerms))
+	args := make([]interface{}, len(titleTerms))
+	escaper := strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_")
+	for idx, term := range titleTerms {
+		conditions[idx] = "titles LIKE ? ESCAPE '\\'"
+		args[idx] = "%" + escaper.Replace(strings.ToLower(term)) + "%"
+	}
+
+	query := fmt.Sprintf("SELECT data FROM manifest_games WHERE %s ORDER BY position", strings.Join(conditions, " OR "))
+	m, err := s.loadManifestGames(query, args...)
+	if err != nil {
+		msg := fmt.Sprintf("SearchManifest(titleTerms=%v) -> Error searching the manifest: %s", titleTerms, err.Error())
+		return m, errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("SearchManifest(titleTerms=%v) -> Found %d games", titleTerms, len((*m).Games)))
+	return m, nil
+}
+
+func (s SqliteStore) LoadMetadata() (*metadata.Metadata, error) {
+	var m metadata.Metadata
+	fn := "LoadMetadata()"
+
+	err := s.loadDocument("metadata", &m)
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Error loading the metadata: %s", fn, err.Error())
+		return &m, errors.New(msg)
+	}
+
+	db, err := s.getDb()
+	if err != nil {
+		return &m, err
+	}
+
+	rows, err := db.Query("SELECT data FROM metadata_games ORDER BY position")
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Error loading the metadata games: %s", fn, err.Error())
+		return &m, errors.New(msg)
+	}
+	defer rows.Close()
+
+	m.Games = []metadata.MetadataGame{}
+	for rows.Next() {
+		var data string
+		err = rows.Scan(&data)
+		if err != nil {
+			return &m, err
+		}
+
+		var game metadata.MetadataGame
+		err = json.Unmarshal([]byte(data), &game)
+		if err != nil {
+			return &m, err
+		}
+		m.Games = append(m.Games, game)
+	}
+
+	if rows.Err() != nil {
+		return &m, rows.Err()
+	}
+
+	s.logger.Debug(fmt.Sprintf("%s -> Loaded metadata with %d games", fn, len(m.Games)))
+	return &m, nil
+}
+
+func (s SqliteStore) LoadActions() (*manifest.GameActions, error) {
+	a := manifest.GameActions{}
+	fn := "LoadActions()"
+
+	has, err := s.HasActions()
+	if err != nil {
+		return &a, err
+	}
+	if !has {
+		return &a, errors.New(fmt.Sprintf("%s -> Storage does not have actions", fn))
+	}
+
+	db, err := s.getDb()
+	if err != nil {
+		return &a, err
+	}
+
+	rows, err := db.Query("SELECT game_id, data FROM actions")
+	if err != nil {
+		msg := fmt.Sprintf("%s -> Error loading the actions: %s", fn, err.Error())
+		return &a, errors.New(msg)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var gameId int64
+		var data string
+		err = rows.Scan(&gameId, &data)
+		if err != nil {
+			return &a, err
+		}
+
+		var action manifest.GameAction
+		err = json.Unmarshal([]byte(data), &action)
+		if err != nil {
+			return &a, err
+		}
+		a[gameId] = action
+	}
+
+	if rows.Err() != nil {
+		return &a, rows.Err()
+	}
+
+	s.logger.Debug(fmt.Sprintf("%s -> Loaded actions on %d games", fn, len(a)))
+	return &a, nil
+}
+
+func (s SqliteStore) LoadSource() (*Source, error) {
+	var src *Source
+
+	err := s.loadDocument("source", &src)
+	if err != nil {
+		msg := fmt.Sprintf("LoadSource() -> Error loading the source: %s", err.Error())
+		return src, errors.New(msg)
+	}
+
+	s.logger.Debug(fmt.Sprintf("LoadSource() -> Loaded source of type %s", (*src).Type))
+	return src, nil
+}
+
+func (s SqliteStore) RemoveActions() error {
+	has, err := s.HasActions()
+	if err != nil || (!has) {
+		return err
+	}
+
+	err = s.withTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM actions")
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec("DELETE FROM documents WHERE name = 'actions'")
+		return err
+	})
+
+	if err == nil {
+		s.logger.Debug("RemoveActions(...) -> Removed actions")
+	}
+	return err
+}
+
+func (s SqliteStore) RemoveSource() error {
+	has, err := s.HasSource()
+	if err != nil || (!has) {
+		return err
+	}
+
+	err = s.withTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec("DELETE FROM documents WHERE name = 'source'")
+		return err
+	})
+
+	if err == nil {
+		s.logger.Debug("RemoveSource(...) -> Removed source")
+	}
+	return err
+}
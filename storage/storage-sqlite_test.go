@@ -0,0 +1,297 @@
+package storage
+
+import (
+	"gogcli/logging"
+	"gogcli/manifest"
+	"gogcli/metadata"
+	"testing"
+)
+
+func TestSqliteStoreManifest(t *testing.T) {
+	s := newTestSqliteStore(t)
+
+	has, err := s.HasManifest()
+	if err != nil || has {
+		t.Errorf("Expected a new storage to have no manifest")
+	}
+
+	m := getTestStorageManifest()
+	(*m).ProtectedFiles.AddGameFile(manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "extra", Name: "first_manual.pdf"})
+	err = s.StoreManifest(m)
+	if err != nil {
+		t.Fatalf("Storing the manifest failed: %s", err.Error())
+	}
+
+	loaded, err := s.LoadManifest()
+	if err != nil {
+		t.Fatalf("Loading the manifest failed: %s", err.Error())
+	}
+	if len((*loaded).Games) != 2 || (*loaded).Games[0].Id != 1 || (*loaded).Games[1].Id != 2 {
+		t.Fatalf("Expected the games to be loaded in order")
+	}
+	if (*loaded).VerifiedSize != (*m).VerifiedSize || (!(*loaded).Filter.Installers) || len((*loaded).ProtectedFiles) != 1 {
+		t.Errorf("Expected the manifest header to be loaded back")
+	}
+	if len((*loaded).Games[0].Installers) != 2 || (*loaded).Games[0].Installers[1].Checksum != (*m).Games[0].Installers[1].Checksum {
+		t.Errorf("Expected the files of the games to be loaded back")
+	}
+
+	(*m).Games = (*m).Games[:1]
+	err = s.StoreManifest(m)
+	if err != nil {
+		t.Fatalf("Storing the trimmed manifest failed: %s", err.Error())
+	}
+	loaded, _ = s.LoadManifest()
+	if len((*loaded).Games) != 1 {
+		t.Errorf("Expected storing a manifest to remove the games it no longer has")
+	}
+}
+
+func TestSqliteStoreManifestGame(t *testing.T) {
+	s := newTestSqliteStore(t)
+	m := getTestStorageManifest()
+	s.StoreManifest(m)
+
+	(*m).Games[1].Title = "Second Game Renamed"
+	(*m).VerifiedSize = 42
+	err := s.StoreManifestGame(m, 2)
+	if err != nil {
+		t.Fatalf("Storing a game failed: %s", err.Error())
+	}
+
+	loaded, _ := s.LoadManifest()
+	if (*loaded).Games[1].Title != "Second Game Renamed" || (*loaded).VerifiedSize != 42 {
+		t.Errorf("Expected the game and the manifest header to be updated")
+	}
+
+	(*m).Games = (*m).Games[1:]
+	err = s.StoreManifestGame(m, 1)
+	if err != nil {
+		t.Fatalf("Storing a removed game failed: %s", err.Error())
+	}
+
+	loaded, _ = s.LoadManifest()
+	if len((*loaded).Games) != 1 || (*loaded).Games[0].Id != 2 {
+		t.Errorf("Expected the game missing from the manifest to be removed from the index")
+	}
+}
+
+func TestSqliteStoreSearchManifest(t *testing.T) {
+	s := newTestSqliteStore(t)
+	m := getTestStorageManifest()
+	(*m).Games[1].Dlcs = []manifest.ManifestGameDlc{manifest.ManifestGameDlc{Title: "100% Expansion"}}
+	s.StoreManifest(m)
+
+	tests := []struct {
+		terms    []string
+		expected []int64
+	}{
+		{[]string{"first"}, []int64{1}},
+		{[]string{"GAME"}, []int64{1, 2}},
+		{[]string{"expansion"}, []int64{2}},
+		{[]string{"100%"}, []int64{2}},
+		{[]string{"%"}, []int64{2}},
+		{[]string{"_"}, []int64{}},
+		{[]string{"first", "expansion"}, []int64{1, 2}},
+		{[]string{}, []int64{1, 2}},
+	}
+
+	for _, test := range tests {
+		found, err := s.SearchManifest(test.terms)
+		if err != nil {
+			t.Errorf("Searching for %v failed: %s", test.terms, err.Error())
+			continue
+		}
+
+		ids := []int64{}
+		for _, game := range (*found).Games {
+			ids = append(ids, game.Id)
+		}
+		if len(ids) != len(test.expected) {
+			t.Errorf("Searching for %v returned %v instead of %v", test.terms, ids, test.expected)
+			continue
+		}
+		for idx, _ := range ids {
+			if ids[idx] != test.expected[idx] {
+				t.Errorf("Searching for %v returned %v instead of %v", test.terms, ids, test.expected)
+			}
+		}
+	}
+}
+
+func TestSqliteStoreActions(t *testing.T) {
+	s := newTestSqliteStore(t)
+
+	_, err := s.LoadActions()
+	if err == nil {
+		t.Errorf("Expected loading missing actions to be an error")
+	}
+
+	a := manifest.GameActions{
+		1: manifest.GameAction{Id: 1, Title: "First Game", Action: "add", InstallerActions: map[string]manifest.FileAction{"setup_first.exe": manifest.FileAction{Name: "setup_first.exe", Kind: "installer", Action: "add"}}},
+		2: manifest.GameAction{Id: 2, Title: "Second Game", Action: "remove"},
+	}
+	err = s.StoreActions(&a)
+	if err != nil {
+		t.Fatalf("Storing the actions failed: %s", err.Error())
+	}
+
+	loaded, err := s.LoadActions()
+	if err != nil || len(*loaded) != 2 || (*loaded)[1].InstallerActions["setup_first.exe"].Action != "add" {
+		t.Fatalf("Expected the actions to be loaded back")
+	}
+
+	delete(a, 2)
+	err = s.StoreGameActions(&a, 2)
+	if err != nil {
+		t.Fatalf("Storing the actions of a game failed: %s", err.Error())
+	}
+	loaded, _ = s.LoadActions()
+	if _, ok := (*loaded)[2]; ok || len(*loaded) != 1 {
+		t.Errorf("Expected the actions of the completed game to be removed")
+	}
+
+	err = s.RemoveActions()
+	if err != nil {
+		t.Fatalf("Removing the actions failed: %s", err.Error())
+	}
+	has, _ := s.HasActions()
+	if has {
+		t.Errorf("Expected the actions to be removed")
+	}
+
+	empty := manifest.GameActions{}
+	s.StoreActions(&empty)
+	has, _ = s.HasActions()
+	loaded, err = s.LoadActions()
+	if (!has) || err != nil || len(*loaded) != 0 {
+		t.Errorf("Expected empty actions to be stored")
+	}
+}
+
+func TestSqliteStoreSourceAndMetadata(t *testing.T) {
+	s := newTestSqliteStore(t)
+
+	err := s.StoreSource(&Source{Type: "gog"})
+	if err != nil {
+		t.Fatalf("Storing the source failed: %s", err.Error())
+	}
+	src, err := s.LoadSource()
+	if err != nil || (*src).Type != "gog" {
+		t.Errorf("Expected the source to be loaded back")
+	}
+	s.RemoveSource()
+	has, _ := s.HasSource()
+	if has {
+		t.Errorf("Expected the source to be removed")
+	}
+
+	meta := metadata.Metadata{
+		Games: []metadata.MetadataGame{
+			metadata.MetadataGame{Id: 2, Title: "Second Game", Category: "Strategy"},
+			metadata.MetadataGame{Id: 1, Title: "First Game"},
+		},
+		SkipImages: []string{"screenshot"},
+	}
+	err = s.StoreMetadata(&meta)
+	if err != nil {
+		t.Fatalf("Storing the metadata failed: %s", err.Error())
+	}
+	loaded, err := s.LoadMetadata()
+	if err != nil || len((*loaded).Games) != 2 || (*loaded).Games[0].Category != "Strategy" || len((*loaded).SkipImages) != 1 {
+		t.Errorf("Expected the metadata to be loaded back in order")
+	}
+}
+
+func TestSqliteStoreFiles(t *testing.T) {
+	s := newTestSqliteStore(t)
+	m := getTestStorageManifest()
+	populateTestStorage(t, s, m)
+
+	files := getTestFiles(t, m)
+	for _, file := range files {
+		if readTestFile(t, s, file) != testFileContents[file.Name] {
+			t.Errorf("Expected file %s to be downloaded back", file.Name)
+		}
+	}
+
+	ids, err := s.GetGameIds()
+	if err != nil || len(ids) != 2 {
+		t.Errorf("Expected the database not to be listed as a game and got %v", ids)
+	}
+
+	err = s.RemoveFile(files[0])
+	if err != nil {
+		t.Fatalf("Removing a file failed: %s", err.Error())
+	}
+	gameFiles, _ := s.GetGameFiles(1)
+	if len(gameFiles) != 2 {
+		t.Errorf("Expected the removed file not to be listed anymore")
+	}
+
+	err = s.RemoveGame(files[0].Game)
+	if err != nil {
+		t.Fatalf("Removing a game failed: %s", err.Error())
+	}
+	ids, _ = s.GetGameIds()
+	if len(ids) != 1 || ids[0] != 2 {
+		t.Errorf("Expected the removed game not to be listed anymore and got %v", ids)
+	}
+
+	has, _ := s.HasManifest()
+	if !has {
+		t.Errorf("Expected removing files to leave the index untouched")
+	}
+}
+
+func TestSqliteStoreReopen(t *testing.T) {
+	s := newTestSqliteStore(t)
+	m := getTestStorageManifest()
+	s.StoreManifest(m)
+	a := manifest.GameActions{1: manifest.GameAction{Id: 1, Action: "add"}}
+	s.StoreActions(&a)
+
+	reopened := GetSqliteStore(s.Path, logging.CreateSource("error"), "")
+	loaded, err := reopened.LoadManifest()
+	if err != nil || len((*loaded).Games) != 2 {
+		t.Errorf("Expected a new instance of the storage to load the same manifest")
+	}
+	actions, err := reopened.LoadActions()
+	if err != nil || len(*actions) != 1 {
+		t.Errorf("Expected a new instance of the storage to load the same actions")
+	}
+
+	source, err := GetSqliteStoreFromSource(*s.GenerateSource(), logging.CreateSource("error"), "")
+	if err != nil || source.Path != s.Path {
+		t.Errorf("Expected the storage to be loadable from its source")
+	}
+	_, err = GetSqliteStoreFromSource(Source{Type: "fs"}, logging.CreateSource("error"), "")
+	if err == nil {
+		t.Errorf("Expected a source of another type to be an error")
+	}
+}
+
+func TestSqliteStoreClose(t *testing.T) {
+	s := newTestSqliteStore(t)
+	err := s.StoreManifest(getTestStorageManifest())
+	if err != nil {
+		t.Fatalf("Storing the manifest failed: %s", err.Error())
+	}
+
+	err = CloseStorage(s)
+	if err != nil || (*s.index).db != nil {
+		t.Errorf("Expected the database to be closed and got %v", err)
+	}
+	if err = s.Close(); err != nil {
+		t.Errorf("Expected closing a closed store to do nothing and got %s", err.Error())
+	}
+
+	loaded, err := s.LoadManifest()
+	if err != nil || len((*loaded).Games) != 2 {
+		t.Errorf("Expected the database to be opened again when the store is used after it is closed and got %v", err)
+	}
+
+	if err = CloseStorage(newTestFileSystem(t)); err != nil {
+		t.Errorf("Expected closing a file system storage to do nothing and got %s", err.Error())
+	}
+}
@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"gogcli/logging"
+	"gogcli/manifest"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//Content of the files of the test manifest, keyed by file name
+var testFileContents = map[string]string{
+	"setup_first.exe":  "first game windows installer",
+	"first.sh":         "first game linux installer",
+	"first_manual.pdf": "first game manual",
+	"setup_second.exe": "second game installer",
+}
+
+func getTestChecksum(content string) string {
+	h := md5.New()
+	h.Write([]byte(content))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func getTestInstaller(name string, os string) manifest.ManifestGameInstaller {
+	return manifest.ManifestGameInstaller{
+		Name:         name,
+		Title:        name,
+		Os:           os,
+		Languages:    []string{"english"},
+		Url:          "/downloads/" + name,
+		Checksum:     getTestChecksum(testFileContents[name]),
+		VerifiedSize: int64(len(testFileContents[name])),
+	}
+}
+
+func getTestStorageManifest() *manifest.Manifest {
+	m := manifest.Manifest{
+		Games: []manifest.ManifestGame{
+			manifest.ManifestGame{
+				Id:    1,
+				Slug:  "first_game",
+				Title: "First Game",
+				Installers: []manifest.ManifestGameInstaller{
+					getTestInstaller("setup_first.exe", "windows"),
+					getTestInstaller("first.sh", "linux"),
+				},
+				Extras: []manifest.ManifestGameExtra{
+					manifest.ManifestGameExtra{
+						Name:         "first_manual.pdf",
+						Title:        "Manual",
+						Type:         "manuals",
+						Url:          "/downloads/first_manual.pdf",
+						Checksum:     getTestChecksum(testFileContents["first_manual.pdf"]),
+						VerifiedSize: int64(len(testFileContents["first_manual.pdf"])),
+					},
+				},
+			},
+			manifest.ManifestGame{
+				Id:    2,
+				Slug:  "second_game",
+				Title: "Second Game",
+				Installers: []manifest.ManifestGameInstaller{
+					getTestInstaller("setup_second.exe", "windows"),
+				},
+			},
+		},
+		Filter: manifest.ManifestFilter{Installers: true, Extras: true},
+	}
+	m.Finalize()
+	return &m
+}
+
+func getTestFiles(t *testing.T, m *manifest.Manifest) []manifest.FileInfo {
+	files := []manifest.FileInfo{}
+	iterator := manifest.NewManifestFileInterator(m)
+	for len((*m).Games) > 0 && iterator.HasMore() {
+		file, err := iterator.Next()
+		if err != nil {
+			t.Fatalf("Could not iterate over the files of the test manifest: %s", err.Error())
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+func newTestFileSystem(t *testing.T) FileSystem {
+	fs := GetFileSystem(filepath.Join(t.TempDir(), "games"), logging.CreateSource("error"), "")
+	err := fs.Initialize()
+	if err != nil {
+		t.Fatalf("Could not initialize the file system storage: %s", err.Error())
+	}
+	return fs
+}
+
+func newTestSqliteStore(t *testing.T) SqliteStore {
+	s := GetSqliteStore(filepath.Join(t.TempDir(), "games"), logging.CreateSource("error"), "")
+	err := s.Initialize()
+	if err != nil {
+		t.Fatalf("Could not initialize the sqlite storage: %s", err.Error())
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+//The content can differ from the one of the file in the manifest to simulate corrupted files
+func uploadTestFile(t *testing.T, s Storage, file manifest.FileInfo, content string) {
+	file.Size = int64(len(content))
+	err := s.AddGame(file.Game)
+	if err != nil {
+		t.Fatalf("Could not add game %d: %s", file.Game.Id, err.Error())
+	}
+
+	_, err = s.UploadFile(ioutil.NopCloser(bytes.NewReader([]byte(content))), file)
+	if err != nil {
+		t.Fatalf("Could not upload file %s: %s", file.Name, err.Error())
+	}
+}
+
+//Stores the manifest in the storage along with all its files, as a fully applied manifest would be
+func populateTestStorage(t *testing.T, s Storage, m *manifest.Manifest) {
+	for _, file := range getTestFiles(t, m) {
+		uploadTestFile(t, s, file, testFileContents[file.Name])
+	}
+
+	err := s.StoreManifest(m)
+	if err != nil {
+		t.Fatalf("Could not store the manifest: %s", err.Error())
+	}
+}
+
+func readTestFile(t *testing.T, s Storage, file manifest.FileInfo) string {
+	handle, _, err := s.DownloadFile(file)
+	if err != nil {
+		t.Fatalf("Could not download file %s: %s", file.Name, err.Error())
+	}
+	defer handle.Close()
+
+	content, err := ioutil.ReadAll(handle)
+	if err != nil {
+		t.Fatalf("Could not read file %s: %s", file.Name, err.Error())
+	}
+	return string(content)
+}
+
+//Journals the upload of the file, as an execution interrupted before storing its checksum in the manifest leaves it
+func appendTestJournalEntry(t *testing.T, s Storage, file manifest.FileInfo) manifest.JournalEntry {
+	fileAction := manifest.FileAction{Name: file.Name, Kind: file.Kind, Action: "add"}
+	entry := manifest.JournalEntry{
+		Game:         file.Game,
+		FileAction:   &fileAction,
+		FileSize:     int64(len(testFileContents[file.Name])),
+		FileChecksum: getTestChecksum(testFileContents[file.Name]),
+	}
+	js, _ := getJournalStorage(s)
+	err := js.AppendJournal(entry)
+	if err != nil {
+		t.Fatalf("Could not append to the journal: %s", err.Error())
+	}
+	return entry
+}
+
+//S3 store whose requests are served by the handler
+func newTestS3Store(t *testing.T, configs S3Configs, handler http.HandlerFunc) S3Store {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	configs.Endpoint = strings.TrimPrefix(server.URL, "http://")
+	configs.Region = "us-east-1"
+	configs.Bucket = "games"
+	s, err := getS3Store(&configs, logging.CreateSource("error"), "")
+	if err != nil {
+		t.Fatalf("Could not create the s3 store: %s", err.Error())
+	}
+	return s
+}
@@ -1,5 +1,9 @@
 package storage
 
+import (
+	"os"
+)
+
 func min(x int, y int) int {
 	if x < y {
 		return x
@@ -15,4 +19,28 @@ func contains(list []int64, elem int64) bool {
 	}
 	
 	return false
-}
\ No newline at end of file
+}
+
+//Writes to a temporary file renamed over the destination so that a crash never leaves a partially written file behind
+func writeFileAtomically(fPath string, data []byte, perm os.FileMode) error {
+	tmpPath := fPath + ".tmp"
+	dest, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	_, err = dest.Write(data)
+	if err == nil {
+		err = dest.Sync()
+	}
+	closeErr := dest.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, fPath)
+}
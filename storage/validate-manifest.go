@@ -43,10 +43,22 @@ func validateFile(info manifest.FileInfo, s Storage, verifyChecksum bool, errCha
 }
 
 func ValidateManifest(s Storage, concurrency int, verifyChecksum bool) []error {
+	return ValidateManifestWithCancel(s, concurrency, verifyChecksum, nil)
+}
+
+//Stops starting new file validations once cancel is closed
+func ValidateManifestWithCancel(s Storage, concurrency int, verifyChecksum bool, cancel <-chan struct{}) []error {
 	jobsRunning := 0
+	cancelled := false
 	errChan := make(chan error)
 
 	errs := make([]error, 0)
+	err := RecoverJournal(s)
+	if err != nil {
+		errs = append(errs, err)
+		return errs
+	}
+
 	has, err := s.HasManifest()
 	if err != nil {
 		msg := fmt.Sprintf("ValidateManifest(...) -> Error checking manifest existance: %s", err.Error())
@@ -59,7 +71,7 @@ func ValidateManifest(s Storage, concurrency int, verifyChecksum bool) []error {
 	}
 
 	m, loadErr := s.LoadManifest()
-	if err != nil {
+	if loadErr != nil {
 		msg := fmt.Sprintf("ValidateManifest(...) -> Error occured while loading the manifest: %s", loadErr.Error())
 		errs = append(errs, errors.New(msg))
 		return errs
@@ -67,16 +79,26 @@ func ValidateManifest(s Storage, concurrency int, verifyChecksum bool) []error {
 
 	iterator := manifest.NewManifestFileInterator(m)
 	for true {
-		if jobsRunning > 0 && ((!iterator.HasMore()) || concurrency <= 0) {
+		if (!cancelled) && cancel != nil {
+			select {
+			case <-cancel:
+				cancelled = true
+				errs = append(errs, errors.New("ValidateManifest(...) -> Validation was cancelled"))
+			default:
+			}
+		}
+
+		if jobsRunning > 0 && ((!iterator.HasMore()) || cancelled || concurrency <= 0) {
 			err := <-errChan
 			if err != nil {
 				errs = append(errs, err)
 			}
 			jobsRunning--
-		} else if !iterator.HasMore() {
+			concurrency++
+		} else if (!iterator.HasMore()) || cancelled {
 			break
 		}
-		if iterator.HasMore() {
+		if iterator.HasMore() && (!cancelled) && concurrency > 0 {
 			file, err := iterator.Next()
 			if err != nil {
 				errs = append(errs, err)
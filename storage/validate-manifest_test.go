@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"gogcli/manifest"
+	"testing"
+)
+
+func TestValidateManifest(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestStorage(t, s, getTestStorageManifest())
+
+	errs := ValidateManifest(s, 2, true)
+	if len(errs) != 0 {
+		t.Errorf("Expected the files of the storage to be valid and got %v", errs)
+	}
+
+	corrupted := []byte(testFileContents["first.sh"])
+	corrupted[0] = 'F'
+	uploadTestFile(t, s, manifest.FileInfo{Game: manifest.GameInfo{Id: 1}, Kind: "installer", Name: "first.sh"}, string(corrupted))
+	errs = ValidateManifest(s, 2, true)
+	if len(errs) != 1 {
+		t.Errorf("Expected the corrupted file to fail its validation and got %v", errs)
+	}
+}
+
+func TestValidateManifestRecoversJournal(t *testing.T) {
+	s := newTestFileSystem(t)
+	populateTestStorage(t, s, getTestStorageManifest())
+
+	m := getTestStorageManifest()
+	(*m).Games[1].Installers[0].Checksum = ""
+	s.StoreManifest(m)
+	appendTestJournalEntry(t, s, manifest.FileInfo{Game: manifest.GameInfo{Id: 2}, Kind: "installer", Name: "setup_second.exe"})
+
+	errs := ValidateManifest(s, 2, true)
+	if len(errs) != 0 {
+		t.Errorf("Expected the checksum of the journal to be used for the validation and got %v", errs)
+	}
+	entries, _ := s.LoadJournal()
+	if len(entries) != 0 {
+		t.Errorf("Expected the journal to be cleared once recovered")
+	}
+}